@@ -99,14 +99,18 @@ import (
 	samplemodtypes "github.com/datachainlab/cross/simapp/samplemod/types"
 	cross "github.com/datachainlab/cross/x/core"
 	crossatomic "github.com/datachainlab/cross/x/core/atomic"
+	crossatomicclient "github.com/datachainlab/cross/x/core/atomic/client"
 	atomickeeper "github.com/datachainlab/cross/x/core/atomic/keeper"
 	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	crossclient "github.com/datachainlab/cross/x/core/client"
 	contractkeeper "github.com/datachainlab/cross/x/core/contract/keeper"
 	crosskeeper "github.com/datachainlab/cross/x/core/keeper"
 	"github.com/datachainlab/cross/x/core/router"
+	crossstore "github.com/datachainlab/cross/x/core/store"
 	crossstorekeeper "github.com/datachainlab/cross/x/core/store/keeper"
 	crossstoretypes "github.com/datachainlab/cross/x/core/store/types"
 	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcckeeper "github.com/datachainlab/cross/x/core/xcc/keeper"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 	"github.com/datachainlab/cross/x/packets"
 
@@ -134,6 +138,7 @@ var (
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+			crossatomicclient.ResolveTxProposalHandler, crossclient.RegisterChainChannelProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -145,6 +150,7 @@ var (
 		transfer.AppModuleBasic{},
 		cross.AppModuleBasic{},
 		crossatomic.AppModuleBasic{},
+		crossstore.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		samplemod.AppModuleBasic{},
 	)
@@ -327,18 +333,6 @@ func NewSimApp(
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 
-	// register the proposal types
-	govRouter := govtypes.NewRouter()
-	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
-	app.GovKeeper = govkeeper.NewKeeper(
-		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter,
-	)
-
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
@@ -353,7 +347,10 @@ func NewSimApp(
 	samplemodModule := samplemod.NewAppModule(app.SamplemodKeeper)
 
 	// Setup a cross module
-	app.XCCResolver = xcctypes.NewChannelInfoResolver(app.IBCKeeper.ChannelKeeper)
+	app.XCCResolver = xcckeeper.NewKeeper(
+		appCodec, crosstypes.NewPrefixStoreKey(keys[crosstypes.StoreKey], crosstypes.XCCKeyPrefix),
+		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ConnectionKeeper, app.IBCKeeper.ClientKeeper,
+	)
 	cmgr := contractkeeper.NewContractManager(
 		appCodec,
 		crosstypes.NewPrefixStoreKey(keys[crosstypes.StoreKey], crosstypes.ContractManagerPrefix),
@@ -363,8 +360,11 @@ func NewSimApp(
 	)
 	app.AtomicKeeper = atomickeeper.NewKeeper(
 		appCodec, crosstypes.NewPrefixStoreKey(keys[crosstypes.StoreKey], crosstypes.AtomicKeyPrefix),
+		app.GetSubspace(crosstypes.ModuleName),
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper, scopedCrossKeeper,
 		cmgr, app.XCCResolver, packets.NewNOPPacketMiddleware(),
+		// NOTE: no admin is configured, so a stuck transaction can be resolved only by the governance
+		"",
 	)
 	crossAtomicModule := crossatomic.NewAppModule(appCodec, app.AtomicKeeper)
 
@@ -376,6 +376,7 @@ func NewSimApp(
 		appCodec,
 		crosstypes.NewPrefixStoreKey(keys[crosstypes.StoreKey], crosstypes.InitiatorKeyPrefix),
 		crosstypes.NewPrefixStoreKey(keys[crosstypes.StoreKey], crosstypes.AuthKeyPrefix),
+		app.GetSubspace(crosstypes.ModuleName),
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		scopedCrossKeeper,
 		packets.NewNOPPacketMiddleware(),
@@ -385,6 +386,20 @@ func NewSimApp(
 	)
 	crossModule := cross.NewAppModule(appCodec, app.CrossKeeper)
 
+	// register the proposal types
+	govRouter := govtypes.NewRouter()
+	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(atomictypes.RouterKey, crossatomic.NewProposalHandler(app.AtomicKeeper)).
+		AddRoute(xcctypes.RouterKey, cross.NewProposalHandler(app.CrossKeeper))
+	app.GovKeeper = govkeeper.NewKeeper(
+		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
+		&stakingKeeper, govRouter,
+	)
+
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// note replicate if you do not need to test core IBC or light clients.
 	mockModule := ibcmock.NewAppModule(scopedIBCMockKeeper, &app.IBCKeeper.PortKeeper)
@@ -433,6 +448,7 @@ func NewSimApp(
 		transferModule,
 		crossModule,
 		crossAtomicModule,
+		crossstore.NewAppModule(xstore),
 		samplemodModule,
 	)
 
@@ -444,7 +460,7 @@ func NewSimApp(
 		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, crosstypes.ModuleName, atomictypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -454,7 +470,7 @@ func NewSimApp(
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
-		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName, samplemodtypes.ModuleName, crosstypes.ModuleName, atomictypes.ModuleName,
+		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName, samplemodtypes.ModuleName, crossstoretypes.ModuleName, crosstypes.ModuleName, atomictypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -741,6 +757,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(crosstypes.ModuleName)
 
 	return paramsKeeper
 }
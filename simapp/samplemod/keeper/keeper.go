@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 
@@ -46,6 +47,8 @@ func (k Keeper) HandleContractCall(goCtx context.Context, signers []authtypes.Ac
 		return &txtypes.ContractCallResult{}, nil
 	case "counter":
 		return k.HandleCounter(ctx, signers, req)
+	case "decrement-counter":
+		return k.HandleDecrementCounter(ctx, signers, req)
 	case "external-call":
 		return k.HandleExternalCall(ctx, req)
 	case "fail":
@@ -55,6 +58,61 @@ func (k Keeper) HandleContractCall(goCtx context.Context, signers []authtypes.Ac
 	}
 }
 
+// OnCommit is called by ContractModule after the transaction is committed
+func (k Keeper) OnCommit(goCtx context.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex, result *txtypes.ContractCallResult) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.incrementFinalizedCount(ctx, committedKey)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommit,
+			sdk.NewAttribute(types.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(types.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+		),
+	)
+	return nil
+}
+
+// OnAbort is called by ContractModule after the transaction is aborted
+func (k Keeper) OnAbort(goCtx context.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.incrementFinalizedCount(ctx, abortedKey)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAbort,
+			sdk.NewAttribute(types.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(types.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+		),
+	)
+	return nil
+}
+
+var (
+	committedKey = []byte("committed")
+	abortedKey   = []byte("aborted")
+)
+
+// GetCommittedCount returns the number of the committed transactions
+func (k Keeper) GetCommittedCount(ctx sdk.Context) uint64 {
+	return k.getFinalizedCount(ctx, committedKey)
+}
+
+// GetAbortedCount returns the number of the aborted transactions
+func (k Keeper) GetAbortedCount(ctx sdk.Context) uint64 {
+	return k.getFinalizedCount(ctx, abortedKey)
+}
+
+func (k Keeper) getFinalizedCount(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) incrementFinalizedCount(ctx sdk.Context, key []byte) {
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(k.getFinalizedCount(ctx, key)+1))
+}
+
 var counterKey = []byte("counter")
 
 func (k Keeper) HandleCounter(ctx sdk.Context, signers []authtypes.Account, req types.ContractCallRequest) (*txtypes.ContractCallResult, error) {
@@ -65,6 +123,17 @@ func (k Keeper) HandleCounter(ctx sdk.Context, signers []authtypes.Account, req
 	return &txtypes.ContractCallResult{Data: bz}, nil
 }
 
+// HandleDecrementCounter reverts the increment by HandleCounter, so it can be used as the compensation of the counter
+func (k Keeper) HandleDecrementCounter(ctx sdk.Context, signers []authtypes.Account, req types.ContractCallRequest) (*txtypes.ContractCallResult, error) {
+	account := signers[0]
+	v := k.getCounter(ctx, account.Id)
+	if v == 0 {
+		return nil, errors.New("the counter is already zero")
+	}
+	bz := k.setCounter(ctx, account.Id, v-1)
+	return &txtypes.ContractCallResult{Data: bz}, nil
+}
+
 func (k Keeper) getCounter(ctx sdk.Context, account authtypes.AccountID) uint64 {
 	var count uint64
 	v := k.xstore.Prefix(account).Get(ctx, counterKey)
@@ -82,26 +151,32 @@ func (k Keeper) setCounter(ctx sdk.Context, account authtypes.AccountID, value u
 	return bz
 }
 
+// HandleExternalCall calls "counter" of the account on another chain with the args: account, channelID, [channelID...]
+// If multiple channel IDs are given, the callee is the chain that is reached through the channels.
 func (k Keeper) HandleExternalCall(ctx sdk.Context, req types.ContractCallRequest) (*txtypes.ContractCallResult, error) {
-	if len(req.Args) != 2 {
-		return nil, fmt.Errorf("the number of arguments must be 2")
+	if len(req.Args) < 2 {
+		return nil, fmt.Errorf("the number of arguments must be at least 2")
 	}
 
 	acc, err := authtypes.NewAccountFromHexString(req.Args[0])
 	if err != nil {
 		return nil, err
 	}
-	channelID := req.Args[1]
+	var hops []xcctypes.ChannelInfo
+	for _, channelID := range req.Args[1:] {
+		hops = append(hops, xcctypes.ChannelInfo{Port: crosstypes.PortID, Channel: channelID})
+	}
+	var xcc xcctypes.XCC = &hops[0]
+	if len(hops) > 1 {
+		xcc = xcctypes.NewChannelPath(hops...)
+	}
 
 	r := types.NewContractCallRequest("counter")
 	callInfo := txtypes.ContractCallInfo(k.m.MustMarshalJSON(&r))
 
 	ret := k.exContractCaller.Call(
 		ctx,
-		&xcctypes.ChannelInfo{
-			Port:    crosstypes.PortID,
-			Channel: channelID,
-		},
+		xcc,
 		callInfo,
 		[]authtypes.Account{*acc},
 	)
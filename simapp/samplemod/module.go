@@ -11,6 +11,7 @@ import (
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	contracttypes "github.com/datachainlab/cross/x/core/contract/types"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -26,9 +27,9 @@ import (
 )
 
 var (
-	_ module.AppModule             = AppModule{}
-	_ module.AppModuleBasic        = AppModuleBasic{}
-	_ contracttypes.ContractModule = AppModule{}
+	_ module.AppModule                      = AppModule{}
+	_ module.AppModuleBasic                 = AppModuleBasic{}
+	_ contracttypes.ContractModuleWithHooks = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -162,3 +163,13 @@ func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Valid
 func (am AppModule) OnContractCall(ctx context.Context, signers []authtypes.Account, callInfo txtypes.ContractCallInfo) (*txtypes.ContractCallResult, error) {
 	return am.contractHandler(ctx, signers, callInfo)
 }
+
+// OnCommit implements contracttypes.ContractModuleWithHooks
+func (am AppModule) OnCommit(ctx context.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex, result *txtypes.ContractCallResult) error {
+	return am.keeper.OnCommit(ctx, txID, txIndex, result)
+}
+
+// OnAbort implements contracttypes.ContractModuleWithHooks
+func (am AppModule) OnAbort(ctx context.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex) error {
+	return am.keeper.OnAbort(ctx, txID, txIndex)
+}
@@ -0,0 +1,10 @@
+package types
+
+// samplemod module event types
+const (
+	EventTypeCommit = "samplemod_commit"
+	EventTypeAbort  = "samplemod_abort"
+
+	AttributeKeyTxID    = "tx_id"
+	AttributeKeyTxIndex = "tx_index"
+)
@@ -8,6 +8,24 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewResolveTxCmd(),
+		NewQueryDecisionCmd(),
+	)
+
+	return txCmd
+}
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group bridge queries under a subcommand
@@ -21,6 +39,10 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCoordinatorState(),
+		GetTxFinalization(),
+		GetCoordinatorStates(),
+		GetContractTransactionState(),
+		GetContractTransactionStates(),
 	)
 
 	return queryCmd
@@ -3,6 +3,9 @@ package cli
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -38,3 +41,174 @@ func GetCoordinatorState() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetTxFinalization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "tx-finalization [TxID: hex encoding]",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			q := types.NewQueryClient(clientCtx)
+			res, err := q.TxFinalization(
+				context.Background(),
+				&types.QueryTxFinalizationRequest{TxId: txID},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagPhase    = "phase"
+	flagDecision = "decision"
+	flagStatus   = "status"
+)
+
+func GetCoordinatorStates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "coordinator-states",
+		Short: "List the coordinator states, optionally filtered by the phase and decision",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			phase, err := readEnumFlag(cmd, flagPhase, "COORDINATOR_PHASE_", types.CoordinatorPhase_value)
+			if err != nil {
+				return err
+			}
+			decision, err := readEnumFlag(cmd, flagDecision, "COORDINATOR_DECISION_", types.CoordinatorDecision_value)
+			if err != nil {
+				return err
+			}
+			q := types.NewQueryClient(clientCtx)
+			res, err := q.CoordinatorStates(
+				context.Background(),
+				&types.QueryCoordinatorStatesRequest{
+					Pagination: pageReq,
+					Phase:      types.CoordinatorPhase(phase),
+					Decision:   types.CoordinatorDecision(decision),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagPhase, "", "filter by the phase (prepare|commit|completed)")
+	cmd.Flags().String(flagDecision, "", "filter by the decision (commit|abort)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "coordinator-states")
+	return cmd
+}
+
+func GetContractTransactionState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "contract-transaction-state [TxID: hex encoding] [TxIndex]",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			txIndex, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			q := types.NewQueryClient(clientCtx)
+			res, err := q.ContractTransactionState(
+				context.Background(),
+				&types.QueryContractTransactionStateRequest{TxId: txID, TxIndex: uint32(txIndex)},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.ContractTransactionState)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetContractTransactionStates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-transaction-states",
+		Short: "List the contract transaction states, optionally filtered by the status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			status, err := readEnumFlag(cmd, flagStatus, "CONTRACT_TRANSACTION_STATUS_", types.ContractTransactionStatus_value)
+			if err != nil {
+				return err
+			}
+			q := types.NewQueryClient(clientCtx)
+			res, err := q.ContractTransactionStates(
+				context.Background(),
+				&types.QueryContractTransactionStatesRequest{
+					Pagination: pageReq,
+					Status:     types.ContractTransactionStatus(status),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagStatus, "", "filter by the status (prepare|commit|abort)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-transaction-states")
+	return cmd
+}
+
+// readEnumFlag parses an enum value from a flag. The value can be either a full enum name or a name without the prefix.
+// If the flag is empty, it returns 0, which means no filter.
+func readEnumFlag(cmd *cobra.Command, name string, prefix string, values map[string]int32) (int32, error) {
+	v, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return 0, err
+	}
+	if v == "" {
+		return 0, nil
+	}
+	v = strings.ToUpper(v)
+	if !strings.HasPrefix(v, prefix) {
+		v = prefix + v
+	}
+	i, ok := values[v]
+	if !ok {
+		return 0, fmt.Errorf("invalid value for --%v: %v", name, v)
+	}
+	return i, nil
+}
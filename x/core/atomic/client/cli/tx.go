@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/spf13/cobra"
+
+	"github.com/datachainlab/cross/x/core/atomic/types"
+	authcli "github.com/datachainlab/cross/x/core/auth/client/cli"
+	txcli "github.com/datachainlab/cross/x/core/tx/client/cli"
+)
+
+// NewResolveTxCmd returns the command to force the coordinator to abort a stuck transaction
+func NewResolveTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-tx [tx-id]",
+		Short: "Force the coordinator to abort a transaction that is stuck in the prepare phase",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgResolveTx(clientCtx.GetFromAddress(), txID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewQueryDecisionCmd returns the command to ask the coordinator for the decision of a prepared transaction
+func NewQueryDecisionCmd() *cobra.Command {
+	const flagTimeoutHeightOffset = "timeout-height-offset"
+
+	cmd := &cobra.Command{
+		Use:   "query-decision [tx-id] [tx-index]",
+		Short: "Ask the coordinator for the decision of a prepared transaction via the coordinator channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			txIndex, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			offset, err := cmd.Flags().GetUint64(flagTimeoutHeightOffset)
+			if err != nil {
+				return err
+			}
+			h, height, err := authcli.QueryTendermintHeader(clientCtx)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, timeoutTimestamp, err := txcli.QueryDefaultTimeout(clientCtx, h, height)
+			if err != nil {
+				return err
+			}
+			if offset > 0 {
+				timeoutHeight = clienttypes.NewHeight(clienttypes.ParseChainID(h.Header.ChainID), uint64(height)+offset)
+			}
+			msg := types.NewMsgQueryDecision(
+				clientCtx.GetFromAddress(),
+				txID,
+				uint32(txIndex),
+				timeoutHeight,
+				timeoutTimestamp,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint64(flagTimeoutHeightOffset, 0, "timeout height of the packet relative to the current block height. If 0, the default of the cross parameters is used")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
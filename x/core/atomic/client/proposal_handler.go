@@ -0,0 +1,25 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/datachainlab/cross/x/core/atomic/client/cli"
+)
+
+// ResolveTxProposalHandler is the proposal handler for ResolveTxProposal
+var ResolveTxProposalHandler = govclient.NewProposalHandler(cli.NewSubmitResolveTxProposalCmd, resolveTxProposalRESTHandler)
+
+// NOTE: the cross modules don't support the legacy REST endpoints, so the handler always returns an error
+func resolveTxProposalRESTHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cross_resolve_tx",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusNotImplemented, "the legacy REST endpoint isn't supported, use the CLI or gRPC instead")
+		},
+	}
+}
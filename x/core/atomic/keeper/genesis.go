@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/datachainlab/cross/x/core/atomic/types"
+)
+
+// allPages is a page request that includes all entries
+var allPages = &query.PageRequest{Limit: math.MaxUint64}
+
+// InitGenesis initializes the states of coordinators, contract transactions and their call results
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	if err := gs.Validate(); err != nil {
+		return err
+	}
+	for _, cs := range gs.CoordinatorStates {
+		k.baseKeeper.SetCoordinatorState(ctx, cs.TxId, cs.CoordinatorState)
+	}
+	for _, s := range gs.ContractTransactionStates {
+		k.baseKeeper.SetContractTransactionState(ctx, s.TxId, s.TxIndex, s.ContractTransactionState)
+		// rebuild the termination index of the three-phase commit
+		if st := s.ContractTransactionState; st.TimeoutTimestamp > 0 &&
+			(st.Status == types.CONTRACT_TRANSACTION_STATUS_PREPARE || st.Status == types.CONTRACT_TRANSACTION_STATUS_PRE_COMMIT) {
+			k.baseKeeper.SetTxTermination(ctx, st.TimeoutTimestamp, s.TxId, s.TxIndex)
+		}
+	}
+	for _, r := range gs.ContractCallResults {
+		k.cm.SetContractCallResult(ctx, r)
+	}
+	return nil
+}
+
+// ExportGenesis exports the states of coordinators, contract transactions and their call results
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	coordinatorStates, _, err := k.baseKeeper.GetCoordinatorStates(ctx, allPages, nil)
+	if err != nil {
+		return nil, err
+	}
+	contractTransactionStates, _, err := k.baseKeeper.GetContractTransactionStates(ctx, allPages, nil)
+	if err != nil {
+		return nil, err
+	}
+	contractCallResults, err := k.cm.GetContractCallResults(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewGenesisState(coordinatorStates, contractTransactionStates, contractCallResults), nil
+}
@@ -17,3 +17,50 @@ func (q Keeper) CoordinatorState(c context.Context, req *types.QueryCoordinatorS
 	}
 	return &types.QueryCoordinatorStateResponse{CoodinatorState: *cs}, nil
 }
+
+func (q Keeper) TxFinalization(c context.Context, req *types.QueryTxFinalizationRequest) (*types.QueryTxFinalizationResponse, error) {
+	cs, found := q.baseKeeper.GetCoordinatorState(sdk.UnwrapSDKContext(c), req.TxId)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", req.TxId)
+	}
+	return &types.QueryTxFinalizationResponse{
+		Completed:      cs.IsCompleted(),
+		Phase:          cs.Phase,
+		Decision:       cs.Decision,
+		CommitFailures: cs.CommitFailures,
+	}, nil
+}
+
+func (q Keeper) CoordinatorStates(c context.Context, req *types.QueryCoordinatorStatesRequest) (*types.QueryCoordinatorStatesResponse, error) {
+	states, pageRes, err := q.baseKeeper.GetCoordinatorStates(sdk.UnwrapSDKContext(c), req.Pagination, func(cs *types.CoordinatorState) bool {
+		if req.Phase != types.COORDINATOR_PHASE_UNKNOWN && cs.Phase != req.Phase {
+			return false
+		}
+		if req.Decision != types.COORDINATOR_DECISION_UNKNOWN && cs.Decision != req.Decision {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCoordinatorStatesResponse{CoordinatorStates: states, Pagination: pageRes}, nil
+}
+
+func (q Keeper) ContractTransactionState(c context.Context, req *types.QueryContractTransactionStateRequest) (*types.QueryContractTransactionStateResponse, error) {
+	txState, found := q.baseKeeper.GetContractTransactionState(sdk.UnwrapSDKContext(c), req.TxId, req.TxIndex)
+	if !found {
+		return nil, fmt.Errorf("(txID, txIndex) = ('%x', '%v') not found", req.TxId, req.TxIndex)
+	}
+	return &types.QueryContractTransactionStateResponse{ContractTransactionState: *txState}, nil
+}
+
+func (q Keeper) ContractTransactionStates(c context.Context, req *types.QueryContractTransactionStatesRequest) (*types.QueryContractTransactionStatesResponse, error) {
+	states, pageRes, err := q.baseKeeper.GetContractTransactionStates(sdk.UnwrapSDKContext(c), req.Pagination, func(txState *types.ContractTransactionState) bool {
+		return req.Status == types.CONTRACT_TRANSACTION_STATUS_UNKNOWN || txState.Status == req.Status
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractTransactionStatesResponse{ContractTransactionStates: states, Pagination: pageRes}, nil
+}
@@ -7,9 +7,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	basekeeper "github.com/datachainlab/cross/x/core/atomic/protocol/base/keeper"
+	sagakeeper "github.com/datachainlab/cross/x/core/atomic/protocol/saga/keeper"
 	simplekeeper "github.com/datachainlab/cross/x/core/atomic/protocol/simple/keeper"
+	threepckeeper "github.com/datachainlab/cross/x/core/atomic/protocol/threepc/keeper"
 	tpckeeper "github.com/datachainlab/cross/x/core/atomic/protocol/tpc/keeper"
 	"github.com/datachainlab/cross/x/core/atomic/types"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
@@ -20,33 +23,48 @@ import (
 var _ txtypes.TxRunner = (*Keeper)(nil)
 
 type Keeper struct {
-	baseKeeper   basekeeper.Keeper
-	simpleKeeper simplekeeper.Keeper
-	tpcKeeper    tpckeeper.Keeper
+	baseKeeper    basekeeper.Keeper
+	simpleKeeper  simplekeeper.Keeper
+	tpcKeeper     tpckeeper.Keeper
+	threePCKeeper threepckeeper.Keeper
+	sagaKeeper    sagakeeper.Keeper
+	cm            txtypes.ContractManager
 
 	packetMiddleware packets.PacketMiddleware
 	packetSender     packets.PacketSender
+
+	// authority is the admin address that can resolve a stuck transaction manually with MsgResolveTx.
+	// If it is empty, a stuck transaction can be resolved only by the governance with ResolveTxProposal.
+	authority string
 }
 
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
 	cm txtypes.ContractManager,
 	xccResolver xcctypes.XCCResolver,
 	packetMiddleware packets.PacketMiddleware,
+	authority string,
 ) Keeper {
-	baseKeeper := basekeeper.NewKeeper(cdc, storeKey, channelKeeper, portKeeper, scopedKeeper)
+	baseKeeper := basekeeper.NewKeeper(cdc, storeKey, paramSpace, channelKeeper, portKeeper, scopedKeeper)
 	simpleKeeper := simplekeeper.NewKeeper(cdc, cm, xccResolver, baseKeeper)
 	tpcKeeper := tpckeeper.NewKeeper(cdc, cm, xccResolver, baseKeeper)
+	threePCKeeper := threepckeeper.NewKeeper(cdc, cm, xccResolver, baseKeeper)
+	sagaKeeper := sagakeeper.NewKeeper(cdc, cm, xccResolver, baseKeeper)
 	return Keeper{
 		baseKeeper:       baseKeeper,
 		simpleKeeper:     simpleKeeper,
 		tpcKeeper:        tpcKeeper,
+		threePCKeeper:    threePCKeeper,
+		sagaKeeper:       sagaKeeper,
+		cm:               cm,
 		packetSender:     packets.NewBasicPacketSender(channelKeeper),
 		packetMiddleware: packetMiddleware,
+		authority:        authority,
 	}
 }
 
@@ -64,6 +82,16 @@ func (k Keeper) RunTx(ctx sdk.Context, tx txtypes.Tx, ps packets.PacketSender) e
 		if err != nil {
 			return sdkerrors.Wrap(types.ErrFailedInitiateTx, err.Error())
 		}
+	case txtypes.COMMIT_PROTOCOL_3PC:
+		err := k.threePCKeeper.SendPrepare(ctx, ps, tx.Id, tx.ContractTransactions, tx.TimeoutHeight, tx.TimeoutTimestamp)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrFailedInitiateTx, err.Error())
+		}
+	case txtypes.COMMIT_PROTOCOL_SAGA:
+		err := k.sagaKeeper.StartSaga(ctx, ps, tx.Id, tx.ContractTransactions, tx.TimeoutHeight, tx.TimeoutTimestamp)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrFailedInitiateTx, err.Error())
+		}
 	default:
 		return fmt.Errorf("unknown commit protocol '%v'", tx.CommitProtocol)
 	}
@@ -78,3 +106,16 @@ func (k Keeper) SimpleKeeper() simplekeeper.Keeper {
 func (k Keeper) TPCKeeper() tpckeeper.Keeper {
 	return k.tpcKeeper
 }
+
+func (k Keeper) ThreePCKeeper() threepckeeper.Keeper {
+	return k.threePCKeeper
+}
+
+func (k Keeper) SagaKeeper() sagakeeper.Keeper {
+	return k.sagaKeeper
+}
+
+// GetAuthority returns the admin address that can resolve a stuck transaction manually
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
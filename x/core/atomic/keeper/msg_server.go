@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/datachainlab/cross/x/core/atomic/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// ResolveTx forces the coordinator to abort a transaction that is stuck in the prepare phase
+func (k Keeper) ResolveTx(goCtx context.Context, msg *types.MsgResolveTx) (*types.MsgResolveTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.authority == "" || msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority is '%v', but got '%v'", k.authority, msg.Authority)
+	}
+	ctx, ps, err := k.packetMiddleware.HandleMsg(ctx, msg, k.packetSender)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.resolveTx(ctx, ps, msg.TxId); err != nil {
+		return nil, err
+	}
+	return &types.MsgResolveTxResponse{}, nil
+}
+
+// HandleResolveTxProposal forces the coordinator to abort a transaction that is stuck in the prepare phase on behalf of the governance
+func (k Keeper) HandleResolveTxProposal(ctx sdk.Context, p *types.ResolveTxProposal) error {
+	return k.resolveTx(ctx, k.packetSender, p.TxId)
+}
+
+func (k Keeper) resolveTx(ctx sdk.Context, ps packets.PacketSender, txID crosstypes.TxID) error {
+	cs, found := k.baseKeeper.GetCoordinatorState(ctx, txID)
+	if !found {
+		return fmt.Errorf("txID '%x' not found", txID)
+	}
+
+	switch cs.Type {
+	case txtypes.COMMIT_PROTOCOL_TPC:
+		return k.tpcKeeper.ResolveTx(ctx, ps, txID)
+	default:
+		// NOTE: the participant of the simple commit may have already committed the tx, so the coordinator cannot abort it
+		return fmt.Errorf("commit protocol '%v' doesn't support the manual resolution", cs.Type)
+	}
+}
+
+// QueryDecision sends a packet to ask the coordinator for the decision of a prepared transaction
+func (k Keeper) QueryDecision(goCtx context.Context, msg *types.MsgQueryDecision) (*types.MsgQueryDecisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, ps, err := k.packetMiddleware.HandleMsg(ctx, msg, k.packetSender)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.tpcKeeper.SendQueryDecision(ctx, ps, msg.TxId, msg.TxIndex, msg.TimeoutHeight, msg.TimeoutTimestamp); err != nil {
+		return nil, err
+	}
+	return &types.MsgQueryDecisionResponse{}, nil
+}
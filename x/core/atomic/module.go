@@ -3,6 +3,7 @@ package atomic
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -15,8 +16,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/datachainlab/cross/x/core/atomic/client/cli"
 	"github.com/datachainlab/cross/x/core/atomic/keeper"
+	"github.com/datachainlab/cross/x/core/atomic/protocol/saga"
+	sagatypes "github.com/datachainlab/cross/x/core/atomic/protocol/saga/types"
 	"github.com/datachainlab/cross/x/core/atomic/protocol/simple"
 	simpletypes "github.com/datachainlab/cross/x/core/atomic/protocol/simple/types"
+	"github.com/datachainlab/cross/x/core/atomic/protocol/threepc"
+	threepctypes "github.com/datachainlab/cross/x/core/atomic/protocol/threepc/types"
 	"github.com/datachainlab/cross/x/core/atomic/protocol/tpc"
 	tpctypes "github.com/datachainlab/cross/x/core/atomic/protocol/tpc/types"
 	"github.com/datachainlab/cross/x/core/atomic/types"
@@ -48,18 +53,25 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(*codec.LegacyAmino) {}
 
 // RegisterInterfaces registers module concrete types into protobuf Any.
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
 	simpletypes.RegisterInterfaces(registry)
 	tpctypes.RegisterInterfaces(registry)
+	threepctypes.RegisterInterfaces(registry)
+	sagatypes.RegisterInterfaces(registry)
 }
 
 // DefaultGenesis returns the capability module's default genesis state.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return nil
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
 // ValidateGenesis performs genesis state validation for the capability module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-	return nil
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return genState.Validate()
 }
 
 // RegisterRESTRoutes registers the capability module's REST service handlers.
@@ -72,7 +84,7 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 
 // GetTxCmd returns the capability module's root tx command.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns the capability module's root query command.
@@ -103,7 +115,7 @@ func (am AppModule) Name() string {
 
 // Route returns the capability module's message routing key.
 func (am AppModule) Route() sdk.Route {
-	return sdk.Route{}
+	return sdk.NewRoute(types.RouterKey, NewHandler(am.keeper))
 }
 
 // QuerierRoute returns the capability module's query routing key.
@@ -121,17 +133,27 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 }
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
-	return nil
+	var genState types.GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+	if err := am.keeper.InitGenesis(ctx, genState); err != nil {
+		panic(err)
+	}
+	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the capability module's exported genesis state as raw JSON bytes.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return nil
+	genState, err := am.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return cdc.MustMarshalJSON(genState)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -141,8 +163,9 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
-// returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// terminates the three-phase commit txs that have passed their deadline and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ThreePCKeeper().TerminateTxs(ctx, types.MaxTerminatedTxsPerBlock)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -151,6 +174,12 @@ func (am AppModule) RegisterPacketRoutes(rtr router.Router) {
 	simpleHandler := simple.NewPacketHandler(am.cdc, am.keeper.SimpleKeeper(), packets.NewNOPPacketMiddleware())
 	rtr.AddRoute(simpletypes.PacketType, simpleHandler)
 
-	tpcHandler := tpc.NewPacketHandler(am.cdc, am.keeper.TPCKeeper())
+	tpcHandler := tpc.NewPacketHandler(am.cdc, am.keeper.TPCKeeper(), packets.NewNOPPacketMiddleware())
 	rtr.AddRoute(tpctypes.PacketType, tpcHandler)
+
+	threePCHandler := threepc.NewPacketHandler(am.cdc, am.keeper.ThreePCKeeper(), packets.NewNOPPacketMiddleware())
+	rtr.AddRoute(threepctypes.PacketType, threePCHandler)
+
+	sagaHandler := saga.NewPacketHandler(am.cdc, am.keeper.SagaKeeper(), packets.NewNOPPacketMiddleware())
+	rtr.AddRoute(sagatypes.PacketType, sagaHandler)
 }
@@ -0,0 +1,22 @@
+package atomic
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/datachainlab/cross/x/core/atomic/keeper"
+	"github.com/datachainlab/cross/x/core/atomic/types"
+)
+
+// NewProposalHandler returns a governance handler for the cross-atomic module proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ResolveTxProposal:
+			return k.HandleResolveTxProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
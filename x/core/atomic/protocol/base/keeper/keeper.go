@@ -1,16 +1,23 @@
 package keeper
 
 import (
+	"math"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	"github.com/datachainlab/cross/x/core/atomic/protocol/base/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
 	"github.com/datachainlab/cross/x/packets"
 )
 
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey sdk.StoreKey
+	cdc        codec.Codec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 
 	channelKeeper types.ChannelKeeper
 	packets.PacketSendKeeper
@@ -19,14 +26,20 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(txtypes.ParamKeyTable())
+	}
 	psk := packets.NewPacketSendKeeper(cdc, channelKeeper, portKeeper, scopedKeeper)
 	return Keeper{
 		cdc:              cdc,
 		storeKey:         storeKey,
+		paramSpace:       paramSpace,
 		PacketSendKeeper: psk,
 		channelKeeper:    channelKeeper,
 	}
@@ -35,3 +48,21 @@ func NewKeeper(
 func (k Keeper) ChannelKeeper() types.ChannelKeeper {
 	return k.channelKeeper
 }
+
+// GetParams returns the total set of the cross parameters.
+func (k Keeper) GetParams(ctx sdk.Context) txtypes.Params {
+	return txtypes.LoadParams(ctx, k.paramSpace)
+}
+
+// PacketTimeout returns the timeout of the packets that are sent to the participants of a tx with a given timeout timestamp.
+// The timeout timestamp of a tx is in seconds, while the one of a packet is in nanoseconds.
+// The timeout height of a tx is the height of the coordinator chain, so it is checked only when the tx is started
+// and never used as the timeout height of a packet, which is compared with the height of the counterparty chain.
+// If the timeout timestamp is 0, the packets never time out.
+func PacketTimeout(ctx sdk.Context, timeoutTimestamp uint64) (clienttypes.Height, uint64) {
+	if timeoutTimestamp == 0 {
+		// NOTE: a packet must have either a timeout height or a timeout timestamp
+		return clienttypes.NewHeight(clienttypes.ParseChainID(ctx.ChainID()), math.MaxUint64), 0
+	}
+	return clienttypes.ZeroHeight(), timeoutTimestamp * uint64(time.Second)
+}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/datachainlab/cross/x/core/atomic/types"
 	crosstypes "github.com/datachainlab/cross/x/core/types"
+	"github.com/datachainlab/cross/x/utils"
 )
 
 // SetCoordinatorState sets the store to a CoordinatorState
@@ -26,6 +28,33 @@ func (k Keeper) GetCoordinatorState(ctx sdk.Context, txID crosstypes.TxID) (*typ
 	return &cs, true
 }
 
+// GetCoordinatorStates returns a page of CoordinatorStates that match a given filter
+// If filter is nil, all states are returned
+func (k Keeper) GetCoordinatorStates(ctx sdk.Context, pageReq *query.PageRequest, filter func(*types.CoordinatorState) bool) ([]types.IdentifiedCoordinatorState, *query.PageResponse, error) {
+	var states []types.IdentifiedCoordinatorState
+	store := prefix.NewStore(k.store(ctx), types.KeyPrefixBytes(types.KeyCoordinatorStatePrefix))
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		var cs types.CoordinatorState
+		if err := k.cdc.Unmarshal(value, &cs); err != nil {
+			return false, err
+		}
+		if filter != nil && !filter(&cs) {
+			return false, nil
+		}
+		if accumulate {
+			states = append(states, types.IdentifiedCoordinatorState{
+				TxId:             append(crosstypes.TxID{}, key...),
+				CoordinatorState: cs,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return states, pageRes, nil
+}
+
 // TODO use channelInfo to create a key
 // SetContractTransactionState sets the store to a ContractTransactionState
 func (k Keeper) SetContractTransactionState(ctx sdk.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex, txState types.ContractTransactionState) {
@@ -44,6 +73,37 @@ func (k Keeper) GetContractTransactionState(ctx sdk.Context, txID crosstypes.TxI
 	return &txState, true
 }
 
+// GetContractTransactionStates returns a page of ContractTransactionStates that match a given filter
+// If filter is nil, all states are returned
+func (k Keeper) GetContractTransactionStates(ctx sdk.Context, pageReq *query.PageRequest, filter func(*types.ContractTransactionState) bool) ([]types.IdentifiedContractTransactionState, *query.PageResponse, error) {
+	var states []types.IdentifiedContractTransactionState
+	store := prefix.NewStore(k.store(ctx), types.KeyPrefixBytes(types.KeyContractTransactionStatePrefix))
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		if len(key) < 4 {
+			return false, fmt.Errorf("invalid key length: %v", len(key))
+		}
+		var txState types.ContractTransactionState
+		if err := k.cdc.Unmarshal(value, &txState); err != nil {
+			return false, err
+		}
+		if filter != nil && !filter(&txState) {
+			return false, nil
+		}
+		if accumulate {
+			states = append(states, types.IdentifiedContractTransactionState{
+				TxId:                     append(crosstypes.TxID{}, key[:len(key)-4]...),
+				TxIndex:                  utils.BigEndianToUint32(key[len(key)-4:]),
+				ContractTransactionState: txState,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return states, pageRes, nil
+}
+
 // EnsureContractTransactionStatus ensures that the status of the tx equals a given status
 func (k Keeper) EnsureContractTransactionStatus(ctx sdk.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex, status types.ContractTransactionStatus) (*types.ContractTransactionState, error) {
 	txState, found := k.GetContractTransactionState(ctx, txID, txIndex)
@@ -68,6 +128,35 @@ func (k Keeper) UpdateContractTransactionStatus(ctx sdk.Context, txID crosstypes
 	return nil
 }
 
+// SetTxTermination adds the contract transaction to the termination index
+func (k Keeper) SetTxTermination(ctx sdk.Context, timeoutTimestamp uint64, txID crosstypes.TxID, txIndex crosstypes.TxIndex) {
+	k.store(ctx).Set(types.KeyTxTermination(timeoutTimestamp, txID, txIndex), []byte{1})
+}
+
+// DeleteTxTermination removes the contract transaction from the termination index
+func (k Keeper) DeleteTxTermination(ctx sdk.Context, timeoutTimestamp uint64, txID crosstypes.TxID, txIndex crosstypes.TxIndex) {
+	k.store(ctx).Delete(types.KeyTxTermination(timeoutTimestamp, txID, txIndex))
+}
+
+// GetTxTerminations returns at most `limit` entries of the termination index whose deadline is less than or equal to a given timestamp
+func (k Keeper) GetTxTerminations(ctx sdk.Context, timestamp uint64, limit int) []types.TxTermination {
+	store := prefix.NewStore(k.store(ctx), types.KeyPrefixBytes(types.KeyTxTerminationPrefix))
+	// end is exclusive, so the entries whose deadline equals to `timestamp` are included
+	it := store.Iterator(nil, sdk.Uint64ToBigEndian(timestamp+1))
+	defer it.Close()
+
+	var entries []types.TxTermination
+	for ; it.Valid() && len(entries) < limit; it.Next() {
+		key := it.Key()
+		entries = append(entries, types.TxTermination{
+			TimeoutTimestamp: sdk.BigEndianToUint64(key[:8]),
+			TxID:             append(crosstypes.TxID{}, key[8:len(key)-4]...),
+			TxIndex:          utils.BigEndianToUint32(key[len(key)-4:]),
+		})
+	}
+	return entries
+}
+
 func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
 	switch storeKey := k.storeKey.(type) {
 	case *crosstypes.PrefixStoreKey:
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	basekeeper "github.com/datachainlab/cross/x/core/atomic/protocol/base/keeper"
+	"github.com/datachainlab/cross/x/core/atomic/protocol/saga/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+// sendCompensate calls the compensation of the completed step on our chain or sends it to the participant.
+// It returns true if the coordinator has to wait for the acknowledgement.
+func (k Keeper) sendCompensate(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	cs *atomictypes.CoordinatorState,
+) (bool, error) {
+	step := &cs.Saga.Steps[txIndex]
+	tx, ok := step.Tx.CompensatingTransaction()
+	if !ok {
+		// the step has no compensation, so it remains committed
+		step.Status = atomictypes.SAGA_STEP_STATUS_NOT_COMPENSABLE
+		return false, nil
+	}
+
+	ci := cs.Channels[txIndex]
+	isSelf, err := k.isSelf(ctx, step.Tx)
+	if err != nil {
+		return false, err
+	}
+	if isSelf {
+		if _, err := k.commitImmediately(ctx, txID, txIndex, tx); err != nil {
+			k.Logger(ctx).Error("failed to compensate a step", "txIndex", txIndex, "error", err.Error())
+			failCompensation(ctx, txID, txIndex, cs, err.Error())
+		} else {
+			if err := k.UpdateContractTransactionStatus(ctx, txID, txIndex, atomictypes.CONTRACT_TRANSACTION_STATUS_COMPENSATED); err != nil {
+				return false, err
+			}
+			step.Status = atomictypes.SAGA_STEP_STATUS_COMPENSATED
+		}
+		return false, nil
+	}
+
+	c, found := k.ChannelKeeper().GetChannel(ctx, ci.Port, ci.Channel)
+	if !found {
+		return false, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, ci.Channel)
+	}
+	// the step has already been committed, so the compensation is never timed out
+	timeoutHeight, timeoutTimestamp := basekeeper.PacketTimeout(ctx, 0)
+	if err := k.SendPacket(
+		ctx,
+		packetSender,
+		types.NewPacketDataCompensate(txID, txIndex, tx),
+		ci.Port, ci.Channel,
+		c.Counterparty.PortId, c.Counterparty.ChannelId,
+		timeoutHeight, timeoutTimestamp,
+	); err != nil {
+		return false, err
+	}
+	step.Status = atomictypes.SAGA_STEP_STATUS_COMPENSATING
+	return true, nil
+}
+
+// ReceivePacketCompensate commits the compensating transaction of the committed step immediately
+// caller is participant
+func (k Keeper) ReceivePacketCompensate(
+	ctx sdk.Context,
+	destPort,
+	destChannel string,
+	data types.PacketDataCompensate,
+) (*txtypes.ContractCallResult, *types.PacketAcknowledgementCompensate, error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+
+	ci := &xcctypes.ChannelInfo{Port: destPort, Channel: destChannel}
+	txState, err := k.EnsureContractTransactionStatus(ctx, data.TxId, data.TxIndex, atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT)
+	if err != nil {
+		return nil, nil, err
+	} else if !txState.CoordinatorChannel.Equal(ci) {
+		return nil, nil, fmt.Errorf("expected CoordinatorChannel is %v, but got %v", txState.CoordinatorChannel, ci)
+	}
+
+	res, err := k.commitImmediately(ctx, data.TxId, data.TxIndex, data.Tx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to compensate a step", "error", err.Error())
+		return nil, types.NewPacketAcknowledgementCompensate(types.COMMIT_STATUS_FAILED, err.Error()), nil
+	}
+	if err := k.UpdateContractTransactionStatus(ctx, data.TxId, data.TxIndex, atomictypes.CONTRACT_TRANSACTION_STATUS_COMPENSATED); err != nil {
+		return nil, nil, err
+	}
+	return res, types.NewPacketAcknowledgementCompensate(types.COMMIT_STATUS_OK, ""), nil
+}
+
+// HandlePacketAcknowledgementCompensate handles an acknowledgement of the compensate packet and proceeds to the compensation of the previous step.
+// A failure of the compensation is recorded in the coordinator state, but it doesn't stop compensating the other steps.
+// caller is coordinator
+func (k Keeper) HandlePacketAcknowledgementCompensate(
+	ctx sdk.Context,
+	ack types.PacketAcknowledgementCompensate,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	if err := ack.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	cs, err := k.getPendingStep(ctx, txID, txIndex, atomictypes.COORDINATOR_PHASE_COMPENSATE, atomictypes.SAGA_STEP_STATUS_COMPENSATING)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ack.Status {
+	case types.COMMIT_STATUS_OK:
+		cs.Saga.Steps[txIndex].Status = atomictypes.SAGA_STEP_STATUS_COMPENSATED
+	case types.COMMIT_STATUS_FAILED:
+		failCompensation(ctx, txID, txIndex, cs, ack.ErrorMessage)
+	default:
+		return nil, fmt.Errorf("unknown commit status '%v'", ack.Status)
+	}
+
+	if err := k.proceed(ctx, ps, txID, cs); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// HandlePacketTimeoutCompensate handles a timeout of the compensate packet.
+// caller is coordinator
+func (k Keeper) HandlePacketTimeoutCompensate(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypePacketTimeout,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+		),
+	)
+	return k.HandlePacketAcknowledgementCompensate(
+		ctx,
+		*types.NewPacketAcknowledgementCompensate(types.COMMIT_STATUS_FAILED, "the compensate packet has timed out"),
+		txID, txIndex, ps,
+	)
+}
+
+// HandlePacketErrorAcknowledgementCompensate handles an error acknowledgement of the compensate packet.
+// The participant failed to handle the packet, so it is treated as COMMIT_STATUS_FAILED.
+// caller is coordinator
+func (k Keeper) HandlePacketErrorAcknowledgementCompensate(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	errMsg string,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeErrorACK,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, errMsg),
+		),
+	)
+	return k.HandlePacketAcknowledgementCompensate(
+		ctx,
+		*types.NewPacketAcknowledgementCompensate(types.COMMIT_STATUS_FAILED, errMsg),
+		txID, txIndex, ps,
+	)
+}
+
+// failCompensation records a failure of the compensation.
+// The step must be compensated manually.
+func failCompensation(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	cs *atomictypes.CoordinatorState,
+	errMsg string,
+) {
+	step := &cs.Saga.Steps[txIndex]
+	step.Status = atomictypes.SAGA_STEP_STATUS_COMPENSATION_FAILED
+	step.ErrorMessage = errMsg
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeCompensationFailed,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, errMsg),
+		),
+	)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	basekeeper "github.com/datachainlab/cross/x/core/atomic/protocol/base/keeper"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+)
+
+const (
+	TypeName = "saga"
+)
+
+// Keeper implements the saga protocol.
+//
+// Unlike the lock-based protocols, each contract transaction is committed immediately in order of txIndex,
+// so no locks are held while the tx is in progress.
+// If a step fails, the coordinator calls the compensations of the completed steps in reverse order.
+// A compensation is called with the signers of its step, who have signed it as a part of the tx.
+//
+// NOTE: the other txs may observe the intermediate states of the saga, so the contracts must tolerate the eventual consistency.
+type Keeper struct {
+	cdc codec.Codec
+
+	cm          txtypes.ContractManager
+	xccResolver xcctypes.XCCResolver
+
+	basekeeper.Keeper
+}
+
+func NewKeeper(
+	cdc codec.Codec,
+	cm txtypes.ContractManager,
+	xccResolver xcctypes.XCCResolver,
+	baseKeeper basekeeper.Keeper,
+) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		cm:          cm,
+		xccResolver: xccResolver,
+		Keeper:      baseKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("cross/core/atomic/%s", TypeName))
+}
+
+// commitImmediately commits a given contract transaction with a cache context, so that its state changes are discarded if it fails
+func (k Keeper) commitImmediately(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	tx txtypes.ResolvedContractTransaction,
+) (*txtypes.ContractCallResult, error) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	res, err := k.cm.CommitImmediately(cacheCtx, txID, txIndex, tx)
+	if err != nil {
+		return nil, err
+	}
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return res, nil
+}
+
+// isSelf returns a boolean whether a given contract transaction runs on our chain
+func (k Keeper) isSelf(ctx sdk.Context, tx txtypes.ResolvedContractTransaction) (bool, error) {
+	xcc, err := tx.GetCrossChainChannel(k.cdc)
+	if err != nil {
+		return false, err
+	}
+	return k.xccResolver.IsSelfCrossChainChannel(ctx, xcc), nil
+}
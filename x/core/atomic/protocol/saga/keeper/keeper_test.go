@@ -0,0 +1,311 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/stretchr/testify/suite"
+
+	samplemodtypes "github.com/datachainlab/cross/simapp/samplemod/types"
+	"github.com/datachainlab/cross/x/core/atomic/protocol/saga/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
+	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+	ibctesting "github.com/datachainlab/cross/x/ibc/testing"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	// participants maps the channel of chainA to the participant chain
+	participants map[string]*ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.participants = make(map[string]*ibctesting.TestChain)
+}
+
+func (suite *KeeperTestSuite) TestSaga() {
+	var cases = []struct {
+		name                string
+		calls               [3]string
+		compensations       [3]string
+		expectedPackets     int
+		expectedDecision    atomictypes.CoordinatorDecision
+		expectedAbortReason atomictypes.AbortReason
+		expectedSteps       [3]atomictypes.SagaStepStatus
+		expectedTxStatuses  [3]atomictypes.ContractTransactionStatus
+		expectedCounters    [3]uint64
+	}{
+		{
+			"commit",
+			[3]string{"counter", "counter", "counter"},
+			[3]string{"decrement-counter", "decrement-counter", "decrement-counter"},
+			2,
+			atomictypes.COORDINATOR_DECISION_COMMIT,
+			atomictypes.ABORT_REASON_UNKNOWN,
+			[3]atomictypes.SagaStepStatus{atomictypes.SAGA_STEP_STATUS_COMPLETED, atomictypes.SAGA_STEP_STATUS_COMPLETED, atomictypes.SAGA_STEP_STATUS_COMPLETED},
+			[3]atomictypes.ContractTransactionStatus{atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT},
+			[3]uint64{1, 1, 1},
+		},
+		{
+			"compensate",
+			[3]string{"counter", "counter", "fail"},
+			[3]string{"decrement-counter", "decrement-counter", "decrement-counter"},
+			3,
+			atomictypes.COORDINATOR_DECISION_ABORT,
+			atomictypes.ABORT_REASON_STEP_FAILED,
+			[3]atomictypes.SagaStepStatus{atomictypes.SAGA_STEP_STATUS_COMPENSATED, atomictypes.SAGA_STEP_STATUS_COMPENSATED, atomictypes.SAGA_STEP_STATUS_FAILED},
+			[3]atomictypes.ContractTransactionStatus{atomictypes.CONTRACT_TRANSACTION_STATUS_COMPENSATED, atomictypes.CONTRACT_TRANSACTION_STATUS_COMPENSATED, atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT},
+			[3]uint64{0, 0, 0},
+		},
+		{
+			"compensation failed",
+			[3]string{"counter", "counter", "fail"},
+			[3]string{"", "fail", "decrement-counter"},
+			3,
+			atomictypes.COORDINATOR_DECISION_ABORT,
+			atomictypes.ABORT_REASON_STEP_FAILED,
+			[3]atomictypes.SagaStepStatus{atomictypes.SAGA_STEP_STATUS_NOT_COMPENSABLE, atomictypes.SAGA_STEP_STATUS_COMPENSATION_FAILED, atomictypes.SAGA_STEP_STATUS_FAILED},
+			[3]atomictypes.ContractTransactionStatus{atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT},
+			[3]uint64{1, 1, 0},
+		},
+		{
+			"local step failed",
+			[3]string{"fail", "counter", "counter"},
+			[3]string{"decrement-counter", "decrement-counter", "decrement-counter"},
+			0,
+			atomictypes.COORDINATOR_DECISION_ABORT,
+			atomictypes.ABORT_REASON_STEP_FAILED,
+			[3]atomictypes.SagaStepStatus{atomictypes.SAGA_STEP_STATUS_FAILED, atomictypes.SAGA_STEP_STATUS_UNKNOWN, atomictypes.SAGA_STEP_STATUS_UNKNOWN},
+			[3]atomictypes.ContractTransactionStatus{atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, atomictypes.CONTRACT_TRANSACTION_STATUS_UNKNOWN, atomictypes.CONTRACT_TRANSACTION_STATUS_UNKNOWN},
+			[3]uint64{0, 0, 0},
+		},
+	}
+
+	for i, c := range cases {
+		suite.Run(c.name, func() {
+			suite.SetupTest()
+			txs := suite.setupTransactions(c.calls, c.compensations)
+			txID := []byte(fmt.Sprintf("txid-%v", i))
+
+			// A commits the local step and sends the next step to B
+			pending := suite.startSaga(txID, txs)
+
+			// relay the packets until the saga is completed. The coordinator sends at most one packet at a time.
+			var sent int
+			for len(pending) > 0 {
+				suite.Require().Equal(1, len(pending))
+				sent++
+				pending = suite.relay(pending[0])
+			}
+			suite.Require().Equal(c.expectedPackets, sent)
+
+			cs, found := suite.chainA.App.AtomicKeeper.SagaKeeper().GetCoordinatorState(suite.chainA.GetContext(), txID)
+			suite.Require().True(found)
+			suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+			suite.Require().Equal(c.expectedDecision, cs.Decision)
+			suite.Require().Equal(c.expectedAbortReason, cs.AbortReason)
+			suite.Require().NotNil(cs.Saga)
+			for i, step := range cs.Saga.Steps {
+				suite.Require().Equal(c.expectedSteps[i], step.Status, i)
+			}
+
+			for i, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB, suite.chainC} {
+				// the compensation undoes the increment of the counter
+				res, err := chain.App.SamplemodKeeper.Counter(
+					sdk.WrapSDKContext(chain.GetContext()),
+					&samplemodtypes.QueryCounterRequest{Account: authtypes.AccountID(chain.SenderAccount.GetAddress())},
+				)
+				suite.Require().NoError(err)
+				suite.Require().Equal(c.expectedCounters[i], res.Value, i)
+
+				txState, found := chain.App.AtomicKeeper.SagaKeeper().GetContractTransactionState(chain.GetContext(), txID, crosstypes.TxIndex(i))
+				if c.expectedTxStatuses[i] == atomictypes.CONTRACT_TRANSACTION_STATUS_UNKNOWN {
+					suite.Require().False(found, i)
+					continue
+				}
+				suite.Require().True(found, i)
+				suite.Require().Equal(c.expectedTxStatuses[i], txState.Status, i)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStepTimeout() {
+	txs := suite.setupTransactions([3]string{"counter", "counter", "counter"}, [3]string{"nop", "nop", "nop"})
+	txID := []byte("txid-timeout")
+	kA := suite.chainA.App.AtomicKeeper.SagaKeeper()
+
+	timeoutTimestamp := suite.timeoutTimestamp()
+	pending := suite.startSaga(txID, txs)
+	suite.Require().Equal(1, len(pending))
+
+	// the step packet has the timeout timestamp of the tx in nanoseconds, but not the timeout height of the coordinator chain
+	p := pending[0]
+	suite.Require().True(p.GetTimeoutHeight().IsZero())
+	suite.Require().Equal(timeoutTimestamp*uint64(time.Second), p.GetTimeoutTimestamp())
+
+	// the step packet to B times out, so A compensates the local step
+	ps := suite.newCapturePacketSender()
+	_, err := kA.HandlePacketTimeoutStep(suite.chainA.GetContext(), p.GetSourcePort(), p.GetSourceChannel(), txID, 1, ps)
+	suite.Require().NoError(err)
+	suite.Require().Empty(ps.Packets())
+	suite.chainA.NextBlock()
+
+	cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+	suite.Require().Equal(atomictypes.ABORT_REASON_TIMEOUT, cs.AbortReason)
+	suite.Require().Equal(atomictypes.SAGA_STEP_STATUS_COMPENSATED, cs.Saga.Steps[0].Status)
+	suite.Require().Equal(atomictypes.SAGA_STEP_STATUS_FAILED, cs.Saga.Steps[1].Status)
+	suite.Require().Equal(atomictypes.SAGA_STEP_STATUS_UNKNOWN, cs.Saga.Steps[2].Status)
+
+	// the step packet that has already been handled must be rejected
+	_, err = kA.HandlePacketTimeoutStep(suite.chainA.GetContext(), p.GetSourcePort(), p.GetSourceChannel(), txID, 1, ps)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestStartSagaParams() {
+	txs := suite.setupTransactions([3]string{"counter", "counter", "counter"}, [3]string{"nop", "nop", "nop"})
+	ctx := suite.chainA.GetContext()
+	params := suite.chainA.App.CrossKeeper.GetParams(ctx)
+	params.AllowedCommitProtocols = []txtypes.CommitProtocol{txtypes.COMMIT_PROTOCOL_TPC}
+	suite.chainA.App.CrossKeeper.SetParams(ctx, params)
+
+	err := suite.chainA.App.AtomicKeeper.SagaKeeper().StartSaga(
+		ctx, suite.newCapturePacketSender(), []byte("txid-params"), txs,
+		clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100), 0,
+	)
+	suite.Require().Error(err)
+}
+
+// setupTransactions creates the channels A-B and A-C, and returns the resolved contract transactions that call a given function on A, B and C in order.
+// An empty compensation indicates that the step has no compensation.
+func (suite *KeeperTestSuite) setupTransactions(calls [3]string, compensations [3]string) []txtypes.ResolvedContractTransaction {
+	chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+	suite.participants[chB.Channel.ID] = suite.chainB
+	chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
+	suite.participants[chC.Channel.ID] = suite.chainC
+
+	xccSelf, err := xcctypes.PackCrossChainChannel(
+		suite.chainA.App.XCCResolver.GetSelfCrossChainChannel(suite.chainA.GetContext()),
+	)
+	suite.Require().NoError(err)
+
+	ctxs := []initiatortypes.ContractTransaction{
+		{
+			CrossChainChannel: xccSelf,
+			Signers: []authtypes.Account{
+				authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+			},
+		},
+		chB.ContractTransaction(nil),
+		chC.ContractTransaction(nil),
+	}
+	for i, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB, suite.chainC} {
+		ctxs[i].CallInfo = samplemodtypes.NewContractCallRequest(calls[i]).ContractCallInfo(chain.App.AppCodec())
+		if compensations[i] != "" {
+			ctxs[i].CompensationCallInfo = samplemodtypes.NewContractCallRequest(compensations[i]).ContractCallInfo(chain.App.AppCodec())
+		}
+	}
+
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(suite.chainA.GetContext(), ctxs)
+	suite.Require().NoError(err)
+	return txs
+}
+
+// capturePacketSender is a packet sender that captures the sent packets
+type capturePacketSender interface {
+	packets.PacketSender
+	Packets() []packets.OutgoingPacket
+}
+
+func (suite *KeeperTestSuite) newCapturePacketSender() capturePacketSender {
+	return ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+}
+
+// timeoutTimestamp returns the timeout timestamp (in seconds) of the txs in the tests
+func (suite *KeeperTestSuite) timeoutTimestamp() uint64 {
+	return uint64(suite.chainA.CurrentHeader.Time.Unix()) + 1000
+}
+
+func (suite *KeeperTestSuite) startSaga(txID crosstypes.TxID, txs []txtypes.ResolvedContractTransaction) []packets.OutgoingPacket {
+	ps := suite.newCapturePacketSender()
+	suite.Require().NoError(
+		suite.chainA.App.AtomicKeeper.SagaKeeper().StartSaga(
+			suite.chainA.GetContext(),
+			ps,
+			txID,
+			txs,
+			clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+			suite.timeoutTimestamp(),
+		),
+	)
+	suite.chainA.NextBlock()
+	return ps.Packets()
+}
+
+// relay delivers a packet sent by A to the participant and returns the packets that A sends after handling its acknowledgement
+func (suite *KeeperTestSuite) relay(p packets.OutgoingPacket) []packets.OutgoingPacket {
+	chain, ok := suite.participants[p.GetSourceChannel()]
+	suite.Require().True(ok)
+	k := chain.App.AtomicKeeper.SagaKeeper()
+	kA := suite.chainA.App.AtomicKeeper.SagaKeeper()
+
+	ps := suite.newCapturePacketSender()
+	switch data := suite.parsePacket(chain.App.AppCodec(), p).(type) {
+	case *types.PacketDataStep:
+		_, ack, err := k.ReceivePacketStep(chain.GetContext(), p.GetDestPort(), p.GetDestChannel(), *data)
+		suite.Require().NoError(err)
+		chain.NextBlock()
+		_, err = kA.HandlePacketAcknowledgementStep(suite.chainA.GetContext(), p.GetSourcePort(), p.GetSourceChannel(), *ack, data.TxId, data.TxIndex, ps)
+		suite.Require().NoError(err)
+	case *types.PacketDataCompensate:
+		_, ack, err := k.ReceivePacketCompensate(chain.GetContext(), p.GetDestPort(), p.GetDestChannel(), *data)
+		suite.Require().NoError(err)
+		chain.NextBlock()
+		_, err = kA.HandlePacketAcknowledgementCompensate(suite.chainA.GetContext(), *ack, data.TxId, data.TxIndex, ps)
+		suite.Require().NoError(err)
+	default:
+		suite.FailNow(fmt.Sprintf("unexpected packet type: %T", data))
+	}
+	suite.chainA.NextBlock()
+	return ps.Packets()
+}
+
+func (suite *KeeperTestSuite) parsePacket(cdc codec.Codec, p packets.OutgoingPacket) packets.PacketDataPayload {
+	ip, err := packets.UnmarshalIncomingPacket(suite.chainA.App.AppCodec(), p)
+	suite.Require().NoError(err)
+	var payload packets.PacketDataPayload
+	if err := cdc.UnpackAny(ip.PacketData().GetPayload(), &payload); err != nil {
+		panic(err)
+	}
+	return payload
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
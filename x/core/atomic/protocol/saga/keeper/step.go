@@ -0,0 +1,393 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	basekeeper "github.com/datachainlab/cross/x/core/atomic/protocol/base/keeper"
+	"github.com/datachainlab/cross/x/core/atomic/protocol/saga/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+// StartSaga starts a saga flow.
+// The steps on our chain are committed immediately until the coordinator sends a step packet to another chain.
+// caller is coordinator
+func (k Keeper) StartSaga(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	txID crosstypes.TxID,
+	transactions []txtypes.ResolvedContractTransaction,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	params := k.GetParams(ctx)
+	if !params.IsAllowedCommitProtocol(txtypes.COMMIT_PROTOCOL_SAGA) {
+		return fmt.Errorf("the commit protocol '%v' is not allowed", txtypes.COMMIT_PROTOCOL_SAGA)
+	} else if len(transactions) == 0 {
+		return errors.New("the number of contract transactions must be greater than 0")
+	} else if err := params.ValidateResolvedContractTransactions(transactions); err != nil {
+		return err
+	} else if !timeoutHeight.IsZero() && uint64(ctx.BlockHeight()) >= timeoutHeight.GetRevisionHeight() {
+		return fmt.Errorf("the given timeoutHeight is in the past: current=%v timeout=%v", ctx.BlockHeight(), timeoutHeight.GetRevisionHeight())
+	} else if timeoutTimestamp != 0 && uint64(ctx.BlockTime().Unix()) >= timeoutTimestamp {
+		return fmt.Errorf("the given timeoutTimestamp is in the past: current=%v timeout=%v", ctx.BlockTime().Unix(), timeoutTimestamp)
+	} else if _, found := k.GetCoordinatorState(ctx, txID); found {
+		return fmt.Errorf("txID '%X' already exists", txID)
+	}
+
+	var channels []xcctypes.ChannelInfo
+	for i, tx := range transactions {
+		if !k.xccResolver.Capabilities().CrossChainCalls(ctx) && len(tx.CallResults) > 0 {
+			return errors.New("the chainResolver cannot resolve cannot support the cross-chain calls feature")
+		}
+		xcc, err := tx.GetCrossChainChannel(k.cdc)
+		if err != nil {
+			return err
+		}
+		ci, err := k.xccResolver.ResolveCrossChainChannel(ctx, xcc)
+		if err != nil {
+			return err
+		}
+		if !k.xccResolver.IsSelfCrossChainChannel(ctx, xcc) {
+			if _, found := k.ChannelKeeper().GetChannel(ctx, ci.Port, ci.Channel); !found {
+				return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "txIndex=%v channel=%v", i, ci.String())
+			}
+		}
+		channels = append(channels, *ci)
+	}
+
+	cs := atomictypes.NewCoordinatorState(
+		txtypes.COMMIT_PROTOCOL_SAGA,
+		atomictypes.COORDINATOR_PHASE_PREPARE,
+		channels,
+	)
+	saga := atomictypes.NewSagaState(transactions, timeoutHeight, timeoutTimestamp)
+	cs.Saga = &saga
+	return k.proceed(ctx, packetSender, txID, &cs)
+}
+
+// proceed runs the steps (or the compensations) of the saga on our chain until the coordinator sends a packet to another chain or the saga is completed.
+// caller is coordinator
+func (k Keeper) proceed(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	txID crosstypes.TxID,
+	cs *atomictypes.CoordinatorState,
+) error {
+	for !cs.IsCompleted() {
+		var (
+			sent bool
+			err  error
+		)
+		switch cs.Phase {
+		case atomictypes.COORDINATOR_PHASE_PREPARE:
+			txIndex, found := cs.Saga.NextStep()
+			if !found {
+				cs.Decision = atomictypes.COORDINATOR_DECISION_COMMIT
+				complete(ctx, txID, cs)
+				continue
+			}
+			sent, err = k.sendStep(ctx, packetSender, txID, txIndex, cs)
+		case atomictypes.COORDINATOR_PHASE_COMPENSATE:
+			txIndex, found := cs.Saga.LastCompletedStep()
+			if !found {
+				complete(ctx, txID, cs)
+				continue
+			}
+			sent, err = k.sendCompensate(ctx, packetSender, txID, txIndex, cs)
+		default:
+			return fmt.Errorf("unexpected phase %v", cs.Phase)
+		}
+		if err != nil {
+			return err
+		} else if sent {
+			break
+		}
+	}
+	k.SetCoordinatorState(ctx, txID, *cs)
+	return nil
+}
+
+// sendStep commits the step on our chain or sends it to the participant.
+// It returns true if the coordinator has to wait for the acknowledgement.
+func (k Keeper) sendStep(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	cs *atomictypes.CoordinatorState,
+) (bool, error) {
+	step := &cs.Saga.Steps[txIndex]
+	if cs.Saga.IsTimedOut(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) {
+		// the step is never started, so it doesn't need to be compensated
+		step.Status = atomictypes.SAGA_STEP_STATUS_FAILED
+		step.ErrorMessage = "the tx has timed out"
+		failStep(ctx, txID, txIndex, cs, atomictypes.ABORT_REASON_TIMEOUT)
+		return false, nil
+	}
+
+	ci := cs.Channels[txIndex]
+	isSelf, err := k.isSelf(ctx, step.Tx)
+	if err != nil {
+		return false, err
+	}
+	if isSelf {
+		if err := cs.Confirm(txIndex, ci); err != nil {
+			return false, err
+		}
+		if _, err := k.commitImmediately(ctx, txID, txIndex, step.Tx); err != nil {
+			k.Logger(ctx).Info("failed to commit a step", "txIndex", txIndex, "error", err.Error())
+			k.SetContractTransactionState(ctx, txID, txIndex, makeStepContractTransactionState(ci, false))
+			step.Status = atomictypes.SAGA_STEP_STATUS_FAILED
+			step.ErrorMessage = err.Error()
+			failStep(ctx, txID, txIndex, cs, atomictypes.ABORT_REASON_STEP_FAILED)
+		} else {
+			k.SetContractTransactionState(ctx, txID, txIndex, makeStepContractTransactionState(ci, true))
+			step.Status = atomictypes.SAGA_STEP_STATUS_COMPLETED
+		}
+		return false, nil
+	}
+
+	c, found := k.ChannelKeeper().GetChannel(ctx, ci.Port, ci.Channel)
+	if !found {
+		return false, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, ci.Channel)
+	}
+	// the timeout height of the tx is checked by IsTimedOut before each step is started
+	timeoutHeight, timeoutTimestamp := basekeeper.PacketTimeout(ctx, cs.Saga.TimeoutTimestamp)
+	if err := k.SendPacket(
+		ctx,
+		packetSender,
+		types.NewPacketDataStep(txID, txIndex, step.Tx),
+		ci.Port, ci.Channel,
+		c.Counterparty.PortId, c.Counterparty.ChannelId,
+		timeoutHeight, timeoutTimestamp,
+	); err != nil {
+		return false, err
+	}
+	step.Status = atomictypes.SAGA_STEP_STATUS_PENDING
+	return true, nil
+}
+
+// ReceivePacketStep commits the contract transaction of the step immediately
+// caller is participant
+func (k Keeper) ReceivePacketStep(
+	ctx sdk.Context,
+	destPort,
+	destChannel string,
+	data types.PacketDataStep,
+) (*txtypes.ContractCallResult, *types.PacketAcknowledgementStep, error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+
+	_, found := k.ChannelKeeper().GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, nil, fmt.Errorf("channel(port=%v channel=%v) not found", destPort, destChannel)
+	}
+
+	if _, ok := k.GetContractTransactionState(ctx, data.TxId, data.TxIndex); ok {
+		return nil, nil, fmt.Errorf("txID '%x' already exists", data.TxId)
+	}
+
+	if err := k.GetParams(ctx).ValidateCallResults(len(data.Tx.CallResults)); err != nil {
+		return nil, nil, err
+	}
+
+	ci := xcctypes.ChannelInfo{Port: destPort, Channel: destChannel}
+	res, err := k.commitImmediately(ctx, data.TxId, data.TxIndex, data.Tx)
+	if err != nil {
+		k.Logger(ctx).Info("failed to commit a step", "error", err.Error())
+		k.SetContractTransactionState(ctx, data.TxId, data.TxIndex, makeStepContractTransactionState(ci, false))
+		return nil, types.NewPacketAcknowledgementStep(types.COMMIT_STATUS_FAILED, err.Error()), nil
+	}
+	k.SetContractTransactionState(ctx, data.TxId, data.TxIndex, makeStepContractTransactionState(ci, true))
+	return res, types.NewPacketAcknowledgementStep(types.COMMIT_STATUS_OK, ""), nil
+}
+
+// HandlePacketAcknowledgementStep handles an acknowledgement of the step packet.
+// If the step is completed, the coordinator proceeds to the next step.
+// Otherwise, it starts to compensate the completed steps.
+// caller is coordinator
+func (k Keeper) HandlePacketAcknowledgementStep(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	ack types.PacketAcknowledgementStep,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	if err := ack.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	cs, err := k.getPendingStep(ctx, txID, txIndex, atomictypes.COORDINATOR_PHASE_PREPARE, atomictypes.SAGA_STEP_STATUS_PENDING)
+	if err != nil {
+		return nil, err
+	}
+
+	_, found := k.ChannelKeeper().GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return nil, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, sourceChannel)
+	}
+	if err := cs.Confirm(txIndex, xcctypes.ChannelInfo{Port: sourcePort, Channel: sourceChannel}); err != nil {
+		return nil, err
+	}
+
+	step := &cs.Saga.Steps[txIndex]
+	switch ack.Status {
+	case types.COMMIT_STATUS_OK:
+		step.Status = atomictypes.SAGA_STEP_STATUS_COMPLETED
+	case types.COMMIT_STATUS_FAILED:
+		step.Status = atomictypes.SAGA_STEP_STATUS_FAILED
+		step.ErrorMessage = ack.ErrorMessage
+		failStep(ctx, txID, txIndex, cs, atomictypes.ABORT_REASON_STEP_FAILED)
+	default:
+		return nil, fmt.Errorf("unknown commit status '%v'", ack.Status)
+	}
+
+	if err := k.proceed(ctx, ps, txID, cs); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// HandlePacketTimeoutStep handles a timeout of the step packet.
+// The participant never receives the step packet, so the step doesn't need to be compensated.
+// caller is coordinator
+func (k Keeper) HandlePacketTimeoutStep(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	cs, err := k.getPendingStep(ctx, txID, txIndex, atomictypes.COORDINATOR_PHASE_PREPARE, atomictypes.SAGA_STEP_STATUS_PENDING)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypePacketTimeout,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+		),
+	)
+
+	if err := cs.Confirm(txIndex, xcctypes.ChannelInfo{Port: sourcePort, Channel: sourceChannel}); err != nil {
+		return nil, err
+	}
+	step := &cs.Saga.Steps[txIndex]
+	step.Status = atomictypes.SAGA_STEP_STATUS_FAILED
+	step.ErrorMessage = "the step packet has timed out"
+	failStep(ctx, txID, txIndex, cs, atomictypes.ABORT_REASON_TIMEOUT)
+
+	if err := k.proceed(ctx, ps, txID, cs); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// HandlePacketErrorAcknowledgementStep handles an error acknowledgement of the step packet.
+// The participant failed to handle the packet, so it is treated as COMMIT_STATUS_FAILED.
+// caller is coordinator
+func (k Keeper) HandlePacketErrorAcknowledgementStep(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	errMsg string,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeErrorACK,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, errMsg),
+		),
+	)
+	return k.HandlePacketAcknowledgementStep(
+		ctx,
+		sourcePort, sourceChannel,
+		*types.NewPacketAcknowledgementStep(types.COMMIT_STATUS_FAILED, errMsg),
+		txID, txIndex, ps,
+	)
+}
+
+// getPendingStep returns the coordinator state after checking that the step is waiting for a packet result
+func (k Keeper) getPendingStep(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	phase atomictypes.CoordinatorPhase,
+	status atomictypes.SagaStepStatus,
+) (*atomictypes.CoordinatorState, error) {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	} else if cs.Type != txtypes.COMMIT_PROTOCOL_SAGA || cs.Saga == nil {
+		return nil, fmt.Errorf("txID '%x' is not a saga", txID)
+	} else if cs.Phase != phase {
+		return nil, fmt.Errorf("coordinator status must be '%v'", phase.String())
+	} else if int(txIndex) >= len(cs.Saga.Steps) {
+		return nil, fmt.Errorf("txIndex '%v' not found", txIndex)
+	} else if s := cs.Saga.Steps[txIndex].Status; s != status {
+		return nil, fmt.Errorf("expected step status is '%v', but got '%v'", status.String(), s.String())
+	}
+	return cs, nil
+}
+
+// failStep decides to abort the tx and starts to compensate the completed steps
+func failStep(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	cs *atomictypes.CoordinatorState,
+	reason atomictypes.AbortReason,
+) {
+	cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+	cs.AbortReason = reason
+	cs.Phase = atomictypes.COORDINATOR_PHASE_COMPENSATE
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeStepFailed,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, cs.Saga.Steps[txIndex].ErrorMessage),
+		),
+	)
+}
+
+// complete finalizes the coordinator state
+func complete(ctx sdk.Context, txID crosstypes.TxID, cs *atomictypes.CoordinatorState) {
+	cs.Phase = atomictypes.COORDINATOR_PHASE_COMPLETED
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeTxCompleted,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyDecision, cs.Decision.String()),
+		),
+	)
+}
+
+// makeStepContractTransactionState returns a ContractTransactionState of the participant after the step
+func makeStepContractTransactionState(channel xcctypes.ChannelInfo, committed bool) atomictypes.ContractTransactionState {
+	if committed {
+		return atomictypes.NewContractTransactionState(atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, atomictypes.PREPARE_RESULT_OK, channel)
+	}
+	return atomictypes.NewContractTransactionState(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, atomictypes.PREPARE_RESULT_FAILED, channel)
+}
@@ -0,0 +1,152 @@
+package saga
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	sagakeeper "github.com/datachainlab/cross/x/core/atomic/protocol/saga/keeper"
+	"github.com/datachainlab/cross/x/core/atomic/protocol/saga/types"
+	"github.com/datachainlab/cross/x/core/router"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+type PacketHandler struct {
+	packetMiddleware packets.PacketMiddleware
+
+	cdc    codec.Codec
+	keeper sagakeeper.Keeper
+}
+
+var _ router.PacketHandler = (*PacketHandler)(nil)
+
+func NewPacketHandler(cdc codec.Codec, k sagakeeper.Keeper, packetMiddleware packets.PacketMiddleware) PacketHandler {
+	return PacketHandler{cdc: cdc, keeper: k, packetMiddleware: packetMiddleware}
+}
+
+func (h PacketHandler) HandlePacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+) (*sdk.Result, *packets.PacketAcknowledgementData, error) {
+	ctx, _, as, err := h.packetMiddleware.HandlePacket(ctx, ip, packets.NewBasicPacketSender(h.keeper.ChannelKeeper()), packets.NewBasicACKSender())
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to handle request: %v", err)
+	}
+
+	var (
+		data []byte
+		ack  packets.OutgoingPacketAcknowledgement
+	)
+	switch payload := ip.Payload().(type) {
+	case *types.PacketDataStep:
+		res, ap, err := h.keeper.ReceivePacketStep(
+			ctx,
+			packet.DestinationPort, packet.DestinationChannel,
+			*payload,
+		)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceivePacketStep: %v", err)
+		}
+		ack = packets.NewOutgoingPacketAcknowledgement(nil, ap)
+		data = res.GetData()
+	case *types.PacketDataCompensate:
+		res, ap, err := h.keeper.ReceivePacketCompensate(
+			ctx,
+			packet.DestinationPort, packet.DestinationChannel,
+			*payload,
+		)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceivePacketCompensate: %v", err)
+		}
+		ack = packets.NewOutgoingPacketAcknowledgement(nil, ap)
+		data = res.GetData()
+	default:
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized packet type: %T", payload)
+	}
+	if err = as.SendACK(ctx, ack); err != nil {
+		return nil, nil, err
+	}
+	ackData := ack.Data()
+	return &sdk.Result{Data: data, Events: ctx.EventManager().ABCIEvents()}, &ackData, nil
+}
+
+func (h PacketHandler) HandleACK(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+	ipa packets.IncomingPacketAcknowledgement,
+) (*sdk.Result, error) {
+	ctx, ps, err := h.packetMiddleware.HandleACK(ctx, ip, ipa, packets.NewBasicPacketSender(h.keeper.ChannelKeeper()))
+	if err != nil {
+		return nil, err
+	}
+
+	switch payload := ipa.Payload().(type) {
+	case *types.PacketAcknowledgementStep:
+		pd := ip.Payload().(*types.PacketDataStep)
+		return h.keeper.HandlePacketAcknowledgementStep(
+			ctx,
+			packet.SourcePort, packet.SourceChannel,
+			*payload, pd.TxId, pd.TxIndex, ps,
+		)
+	case *types.PacketAcknowledgementCompensate:
+		pd := ip.Payload().(*types.PacketDataCompensate)
+		return h.keeper.HandlePacketAcknowledgementCompensate(
+			ctx,
+			*payload, pd.TxId, pd.TxIndex, ps,
+		)
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ack type: %T", payload)
+	}
+}
+
+func (h PacketHandler) HandleErrorACK(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+	errMsg string,
+) (*sdk.Result, error) {
+	switch payload := ip.Payload().(type) {
+	case *types.PacketDataStep:
+		return h.keeper.HandlePacketErrorAcknowledgementStep(
+			ctx,
+			packet.SourcePort, packet.SourceChannel,
+			payload.TxId, payload.TxIndex, errMsg,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	case *types.PacketDataCompensate:
+		return h.keeper.HandlePacketErrorAcknowledgementCompensate(
+			ctx,
+			payload.TxId, payload.TxIndex, errMsg,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected packet type: %T", payload)
+	}
+}
+
+func (h PacketHandler) HandleTimeout(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+) (*sdk.Result, error) {
+	switch payload := ip.Payload().(type) {
+	case *types.PacketDataStep:
+		return h.keeper.HandlePacketTimeoutStep(
+			ctx,
+			packet.SourcePort, packet.SourceChannel,
+			payload.TxId, payload.TxIndex,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	case *types.PacketDataCompensate:
+		return h.keeper.HandlePacketTimeoutCompensate(
+			ctx,
+			payload.TxId, payload.TxIndex,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected timeout packet type: %T", payload)
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"errors"
+
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+const (
+	PacketType = "cross/core/atomic/saga"
+)
+
+var _ packets.PacketDataPayload = (*PacketDataStep)(nil)
+
+// NewPacketDataStep creates a new instance of PacketDataStep
+func NewPacketDataStep(txID crosstypes.TxID, txIndex crosstypes.TxIndex, tx txtypes.ResolvedContractTransaction) *PacketDataStep {
+	return &PacketDataStep{TxId: txID, TxIndex: txIndex, Tx: tx}
+}
+
+func (p PacketDataStep) ValidateBasic() error {
+	return p.Tx.ValidateBasic()
+}
+
+func (PacketDataStep) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketAcknowledgementPayload = (*PacketAcknowledgementStep)(nil)
+
+func NewPacketAcknowledgementStep(status CommitStatus, errorMessage string) *PacketAcknowledgementStep {
+	return &PacketAcknowledgementStep{Status: status, ErrorMessage: errorMessage}
+}
+
+func (PacketAcknowledgementStep) ValidateBasic() error {
+	return nil
+}
+
+func (PacketAcknowledgementStep) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketDataPayload = (*PacketDataCompensate)(nil)
+
+// NewPacketDataCompensate creates a new instance of PacketDataCompensate
+func NewPacketDataCompensate(txID crosstypes.TxID, txIndex crosstypes.TxIndex, tx txtypes.ResolvedContractTransaction) *PacketDataCompensate {
+	return &PacketDataCompensate{TxId: txID, TxIndex: txIndex, Tx: tx}
+}
+
+func (p PacketDataCompensate) ValidateBasic() error {
+	if len(p.Tx.CallInfo) == 0 {
+		return errors.New("the call info of the compensating transaction must not be empty")
+	}
+	return p.Tx.ValidateBasic()
+}
+
+func (PacketDataCompensate) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketAcknowledgementPayload = (*PacketAcknowledgementCompensate)(nil)
+
+func NewPacketAcknowledgementCompensate(status CommitStatus, errorMessage string) *PacketAcknowledgementCompensate {
+	return &PacketAcknowledgementCompensate{Status: status, ErrorMessage: errorMessage}
+}
+
+func (PacketAcknowledgementCompensate) ValidateBasic() error {
+	return nil
+}
+
+func (PacketAcknowledgementCompensate) Type() string {
+	return PacketType
+}
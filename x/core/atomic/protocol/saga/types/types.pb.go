@@ -0,0 +1,1072 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cross/core/atomic/saga/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/datachainlab/cross/x/core/tx/types"
+	github_com_datachainlab_cross_x_core_types "github.com/datachainlab/cross/x/core/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CommitStatus int32
+
+const (
+	COMMIT_STATUS_UNKNOWN CommitStatus = 0
+	COMMIT_STATUS_OK      CommitStatus = 1
+	COMMIT_STATUS_FAILED  CommitStatus = 2
+)
+
+var CommitStatus_name = map[int32]string{
+	0: "COMMIT_STATUS_UNKNOWN",
+	1: "COMMIT_STATUS_OK",
+	2: "COMMIT_STATUS_FAILED",
+}
+
+var CommitStatus_value = map[string]int32{
+	"COMMIT_STATUS_UNKNOWN": 0,
+	"COMMIT_STATUS_OK":      1,
+	"COMMIT_STATUS_FAILED":  2,
+}
+
+func (x CommitStatus) String() string {
+	return proto.EnumName(CommitStatus_name, int32(x))
+}
+
+func (CommitStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b98f7016727904e4, []int{0}
+}
+
+type PacketDataStep struct {
+	TxId    github_com_datachainlab_cross_x_core_types.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	TxIndex github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"tx_index,omitempty"`
+	Tx      types.ResolvedContractTransaction                  `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx"`
+}
+
+func (m *PacketDataStep) Reset()         { *m = PacketDataStep{} }
+func (m *PacketDataStep) String() string { return proto.CompactTextString(m) }
+func (*PacketDataStep) ProtoMessage()    {}
+func (*PacketDataStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98f7016727904e4, []int{0}
+}
+func (m *PacketDataStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketDataStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketDataStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketDataStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketDataStep.Merge(m, src)
+}
+func (m *PacketDataStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketDataStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketDataStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketDataStep proto.InternalMessageInfo
+
+type PacketAcknowledgementStep struct {
+	Status       CommitStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cross.core.atomic.saga.CommitStatus" json:"status,omitempty"`
+	ErrorMessage string       `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *PacketAcknowledgementStep) Reset()         { *m = PacketAcknowledgementStep{} }
+func (m *PacketAcknowledgementStep) String() string { return proto.CompactTextString(m) }
+func (*PacketAcknowledgementStep) ProtoMessage()    {}
+func (*PacketAcknowledgementStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98f7016727904e4, []int{1}
+}
+func (m *PacketAcknowledgementStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketAcknowledgementStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketAcknowledgementStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketAcknowledgementStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketAcknowledgementStep.Merge(m, src)
+}
+func (m *PacketAcknowledgementStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketAcknowledgementStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketAcknowledgementStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketAcknowledgementStep proto.InternalMessageInfo
+
+type PacketDataCompensate struct {
+	TxId    github_com_datachainlab_cross_x_core_types.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	TxIndex github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"tx_index,omitempty"`
+	// tx is the compensating transaction, whose call_info is the compensation_call_info of the step
+	Tx types.ResolvedContractTransaction `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx"`
+}
+
+func (m *PacketDataCompensate) Reset()         { *m = PacketDataCompensate{} }
+func (m *PacketDataCompensate) String() string { return proto.CompactTextString(m) }
+func (*PacketDataCompensate) ProtoMessage()    {}
+func (*PacketDataCompensate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98f7016727904e4, []int{2}
+}
+func (m *PacketDataCompensate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketDataCompensate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketDataCompensate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketDataCompensate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketDataCompensate.Merge(m, src)
+}
+func (m *PacketDataCompensate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketDataCompensate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketDataCompensate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketDataCompensate proto.InternalMessageInfo
+
+type PacketAcknowledgementCompensate struct {
+	Status       CommitStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cross.core.atomic.saga.CommitStatus" json:"status,omitempty"`
+	ErrorMessage string       `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *PacketAcknowledgementCompensate) Reset()         { *m = PacketAcknowledgementCompensate{} }
+func (m *PacketAcknowledgementCompensate) String() string { return proto.CompactTextString(m) }
+func (*PacketAcknowledgementCompensate) ProtoMessage()    {}
+func (*PacketAcknowledgementCompensate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98f7016727904e4, []int{3}
+}
+func (m *PacketAcknowledgementCompensate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketAcknowledgementCompensate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketAcknowledgementCompensate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketAcknowledgementCompensate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketAcknowledgementCompensate.Merge(m, src)
+}
+func (m *PacketAcknowledgementCompensate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketAcknowledgementCompensate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketAcknowledgementCompensate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketAcknowledgementCompensate proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cross.core.atomic.saga.CommitStatus", CommitStatus_name, CommitStatus_value)
+	proto.RegisterType((*PacketDataStep)(nil), "cross.core.atomic.saga.PacketDataStep")
+	proto.RegisterType((*PacketAcknowledgementStep)(nil), "cross.core.atomic.saga.PacketAcknowledgementStep")
+	proto.RegisterType((*PacketDataCompensate)(nil), "cross.core.atomic.saga.PacketDataCompensate")
+	proto.RegisterType((*PacketAcknowledgementCompensate)(nil), "cross.core.atomic.saga.PacketAcknowledgementCompensate")
+}
+
+func init() {
+	proto.RegisterFile("cross/core/atomic/saga/types.proto", fileDescriptor_b98f7016727904e4)
+}
+
+var fileDescriptor_b98f7016727904e4 = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x33, 0xb5, 0xae, 0x3a, 0x76, 0x97, 0x12, 0xaa, 0xb4, 0x3d, 0x24, 0xa5, 0x7a, 0x28,
+	0x7b, 0x48, 0xa0, 0x0b, 0x1e, 0x44, 0xd0, 0xfe, 0x51, 0x2c, 0x6b, 0x5b, 0x4d, 0xb3, 0x08, 0x82,
+	0x84, 0xe9, 0x64, 0xc8, 0x86, 0x6d, 0x32, 0x25, 0xf3, 0xae, 0x8e, 0x77, 0x0f, 0xe2, 0xc9, 0x8f,
+	0x20, 0xf8, 0x65, 0x7a, 0xdc, 0xa3, 0xa7, 0xa2, 0xed, 0xc5, 0x2f, 0xe0, 0x65, 0xbd, 0x48, 0x26,
+	0x05, 0xb3, 0xd0, 0x83, 0x5e, 0xbc, 0xec, 0x6d, 0x78, 0xf2, 0xcc, 0x8f, 0xbc, 0xcf, 0xc3, 0xbc,
+	0xb8, 0x49, 0x13, 0x2e, 0x84, 0x4d, 0x79, 0xc2, 0x6c, 0x02, 0x3c, 0x0a, 0xa9, 0x2d, 0x48, 0x40,
+	0x6c, 0x78, 0x37, 0x67, 0xc2, 0x9a, 0x27, 0x1c, 0xb8, 0x7e, 0x5b, 0x79, 0xac, 0xd4, 0x63, 0x65,
+	0x1e, 0x2b, 0xf5, 0xd4, 0x2b, 0x01, 0x0f, 0xb8, 0xb2, 0xd8, 0xe9, 0x29, 0x73, 0xd7, 0x6b, 0x39,
+	0x22, 0xc8, 0x3c, 0xa8, 0xf9, 0x13, 0xe1, 0xbd, 0xe7, 0x84, 0x9e, 0x30, 0xe8, 0x13, 0x20, 0x13,
+	0x60, 0x73, 0xfd, 0x29, 0xbe, 0x0a, 0xd2, 0x0b, 0xfd, 0x2a, 0x6a, 0xa0, 0x56, 0xa9, 0x7b, 0x70,
+	0xbe, 0x34, 0xed, 0x20, 0x84, 0xe3, 0xd3, 0xa9, 0x45, 0x79, 0x64, 0xfb, 0x04, 0x08, 0x3d, 0x26,
+	0x61, 0x3c, 0x23, 0x53, 0x3b, 0x03, 0xcb, 0x0d, 0x5a, 0x71, 0x5d, 0x39, 0xe8, 0x3b, 0x45, 0x90,
+	0x03, 0x5f, 0x7f, 0x81, 0xaf, 0xa7, 0xa4, 0xd8, 0x67, 0xb2, 0x5a, 0x68, 0xa0, 0xd6, 0x6e, 0xf7,
+	0xde, 0xf9, 0xd2, 0x6c, 0xff, 0x1b, 0x2c, 0xbd, 0xed, 0x5c, 0x83, 0xec, 0xa0, 0x3f, 0xc2, 0x05,
+	0x90, 0xd5, 0x2b, 0x0d, 0xd4, 0xba, 0xd9, 0xde, 0xb7, 0x72, 0x29, 0x80, 0xb4, 0x1c, 0x26, 0xf8,
+	0xec, 0x0d, 0xf3, 0x7b, 0x3c, 0x86, 0x84, 0x50, 0x70, 0x13, 0x12, 0x0b, 0x42, 0x21, 0xe4, 0x71,
+	0xb7, 0xb8, 0x58, 0x9a, 0x9a, 0x53, 0x00, 0x79, 0xbf, 0xf8, 0xe3, 0xb3, 0xa9, 0x35, 0xdf, 0x23,
+	0x5c, 0xcb, 0xe6, 0xee, 0xd0, 0x93, 0x98, 0xbf, 0x9d, 0x31, 0x3f, 0x60, 0x11, 0x8b, 0x41, 0x45,
+	0xf0, 0x00, 0xef, 0x08, 0x20, 0x70, 0x2a, 0x54, 0x06, 0x7b, 0xed, 0xbb, 0xd6, 0xf6, 0xbc, 0xad,
+	0x1e, 0x8f, 0xa2, 0x10, 0x26, 0xca, 0xeb, 0x6c, 0xee, 0xe8, 0x77, 0xf0, 0x2e, 0x4b, 0x12, 0x9e,
+	0x78, 0x11, 0x13, 0x82, 0x04, 0x4c, 0xcd, 0x7e, 0xc3, 0x29, 0x29, 0x71, 0x98, 0x69, 0x9b, 0xdf,
+	0xf8, 0x85, 0x70, 0xe5, 0x4f, 0xfc, 0x3d, 0x1e, 0xcd, 0x59, 0x2c, 0x08, 0xb0, 0xcb, 0x51, 0xc2,
+	0x47, 0x84, 0xcd, 0xad, 0x25, 0xe4, 0x82, 0xf8, 0x5f, 0x55, 0xec, 0x7b, 0xb8, 0x94, 0x47, 0xe8,
+	0x35, 0x7c, 0xab, 0x37, 0x1e, 0x0e, 0x07, 0xae, 0x37, 0x71, 0x3b, 0xee, 0xd1, 0xc4, 0x3b, 0x1a,
+	0x1d, 0x8e, 0xc6, 0x2f, 0x47, 0x65, 0x4d, 0xaf, 0xe0, 0xf2, 0xc5, 0x4f, 0xe3, 0xc3, 0x32, 0xd2,
+	0xab, 0xb8, 0x72, 0x51, 0x7d, 0xd2, 0x19, 0x3c, 0x7b, 0xdc, 0x2f, 0x17, 0xea, 0xc5, 0x0f, 0x5f,
+	0x0c, 0xad, 0xfb, 0x7a, 0xf1, 0xdd, 0xd0, 0x16, 0x2b, 0x03, 0x9d, 0xad, 0x0c, 0xf4, 0x6d, 0x65,
+	0xa0, 0x4f, 0x6b, 0x43, 0x3b, 0x5b, 0x1b, 0xda, 0xd7, 0xb5, 0xa1, 0xbd, 0x7a, 0xf8, 0x57, 0x85,
+	0x6c, 0xf6, 0x81, 0x7a, 0xbb, 0x94, 0xcf, 0x72, 0x8b, 0x61, 0xba, 0xa3, 0xc4, 0x83, 0xdf, 0x03,
+	0x00, 0x67, 0x1a, 0x5b, 0x3b, 0x3f, 0x04, 0x00, 0x00,
+}
+
+func (m *PacketDataStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketDataStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketDataStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketAcknowledgementStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketAcknowledgementStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketAcknowledgementStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketDataCompensate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketDataCompensate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketDataCompensate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketAcknowledgementCompensate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketAcknowledgementCompensate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketAcknowledgementCompensate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PacketDataStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	l = m.Tx.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PacketAcknowledgementStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketDataCompensate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	l = m.Tx.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PacketAcknowledgementCompensate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PacketDataStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketDataStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketDataStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketAcknowledgementStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketAcknowledgementStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketAcknowledgementStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CommitStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketDataCompensate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketDataCompensate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketDataCompensate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketAcknowledgementCompensate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketAcknowledgementCompensate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketAcknowledgementCompensate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CommitStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,9 +1,9 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,12 +22,14 @@ import (
 )
 
 const (
-	TxIndexCoordinator crosstypes.TxIndex = 0
-	TxIndexParticipant crosstypes.TxIndex = 1
+	TypeName = "simple"
 )
 
 const (
-	TypeName = "simple"
+	// Deprecated: the transactions of the coordinator can be at any index of a tx.
+	TxIndexCoordinator crosstypes.TxIndex = 0
+	// Deprecated: use the TxIndex of PacketDataCall instead.
+	TxIndexParticipant crosstypes.TxIndex = 1
 )
 
 type Keeper struct {
@@ -53,7 +55,9 @@ func NewKeeper(
 	}
 }
 
-// SendCall starts a simple commit flow
+// SendCall starts a simple commit flow.
+// The contract transactions on our chain are prepared atomically, and the transaction of the participant on another chain is sent to it.
+// The call packet times out at the timeout timestamp of the tx, then the coordinator aborts the tx.
 // caller is Coordinator
 func (k Keeper) SendCall(
 	ctx sdk.Context,
@@ -63,10 +67,13 @@ func (k Keeper) SendCall(
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 ) error {
-	if len(transactions) != 2 {
-		return errors.New("the number of contract transactions must be 2")
-	} else if !k.xccResolver.Capabilities().CrossChainCalls(ctx) && (len(transactions[0].CallResults) > 0 || len(transactions[1].CallResults) > 0) {
-		return errors.New("the chainResolver cannot resolve cannot support the cross-chain calls feature")
+	params := k.GetParams(ctx)
+	if !params.IsAllowedCommitProtocol(txtypes.COMMIT_PROTOCOL_SIMPLE) {
+		return fmt.Errorf("the commit protocol '%v' is not allowed", txtypes.COMMIT_PROTOCOL_SIMPLE)
+	} else if len(transactions) < 2 {
+		return errors.New("the number of contract transactions must be greater than 1")
+	} else if err := params.ValidateResolvedContractTransactions(transactions); err != nil {
+		return err
 	} else if !timeoutHeight.IsZero() && uint64(ctx.BlockHeight()) >= timeoutHeight.GetRevisionHeight() {
 		return fmt.Errorf("the given timeoutHeight is in the past: current=%v timeout=%v", ctx.BlockHeight(), timeoutHeight.GetRevisionHeight())
 	} else if timeoutTimestamp != 0 && uint64(ctx.BlockTime().Unix()) >= timeoutTimestamp {
@@ -75,65 +82,96 @@ func (k Keeper) SendCall(
 		return fmt.Errorf("txID '%X' already exists", txID)
 	}
 
-	tx0 := transactions[TxIndexCoordinator]
-	tx1 := transactions[TxIndexParticipant]
-
-	xcc0, err := tx0.GetCrossChainChannel(k.cdc)
-	if err != nil {
-		return err
-	}
-
-	// check if xcc0 indicates our chain
-	if !k.xccResolver.IsSelfCrossChainChannel(ctx, xcc0) {
-		return fmt.Errorf("a cross-chain channel that txIndex '%v' indicates must be our chain", TxIndexCoordinator)
-	}
-
-	xcc1, err := tx1.GetCrossChainChannel(k.cdc)
-	if err != nil {
-		return err
-	}
-	ch0, err := k.xccResolver.ResolveCrossChainChannel(ctx, xcc0)
-	if err != nil {
-		return err
+	var (
+		channels    []xcctypes.ChannelInfo
+		participant = -1
+	)
+	for i, tx := range transactions {
+		if !k.xccResolver.Capabilities().CrossChainCalls(ctx) && len(tx.CallResults) > 0 {
+			return errors.New("the chainResolver cannot resolve cannot support the cross-chain calls feature")
+		}
+		xcc, err := tx.GetCrossChainChannel(k.cdc)
+		if err != nil {
+			return err
+		}
+		ch, err := k.xccResolver.ResolveCrossChainChannel(ctx, xcc)
+		if err != nil {
+			return err
+		}
+		if !k.xccResolver.IsSelfCrossChainChannel(ctx, xcc) {
+			if participant >= 0 {
+				return fmt.Errorf("only one contract transaction can run on another chain: txIndex=%v, %v", participant, i)
+			}
+			participant = i
+		}
+		channels = append(channels, *ch)
 	}
-	ch1, err := k.xccResolver.ResolveCrossChainChannel(ctx, xcc1)
-	if err != nil {
-		return err
+	if participant < 0 {
+		return errors.New("a contract transaction must run on another chain")
 	}
+	txIndexParticipant := crosstypes.TxIndex(participant)
+	chp := channels[txIndexParticipant]
 
-	c, found := k.ChannelKeeper().GetChannel(ctx, ch1.Port, ch1.Channel)
+	c, found := k.ChannelKeeper().GetChannel(ctx, chp.Port, chp.Channel)
 	if !found {
-		return sdkerrors.Wrap(channeltypes.ErrChannelNotFound, ch1.Channel)
+		return sdkerrors.Wrap(channeltypes.ErrChannelNotFound, chp.Channel)
 	}
 
-	var prepareResult atomictypes.PrepareResult
+	packetTimeoutHeight, packetTimeoutTimestamp := basekeeper.PacketTimeout(ctx, timeoutTimestamp)
 
-	// TODO returns a result of contract call
-	_, err = k.cm.PrepareCommit(ctx, txID, TxIndexCoordinator, tx0)
-	if err != nil {
-		prepareResult = atomictypes.PREPARE_RESULT_FAILED
-		k.Logger(ctx).Info("failed to PrepareCommit", "err", err)
-	} else {
-		prepareResult = atomictypes.PREPARE_RESULT_OK
+	prepareResult, txPrepareResults := k.prepareLocalTransactions(ctx, txID, transactions, txIndexParticipant)
+	if prepareResult == atomictypes.PREPARE_RESULT_OK {
 		if err := k.SendPacket(
 			ctx,
 			packetSender,
-			types.NewPacketDataCall(txID, tx1),
-			ch1.Port, ch1.Channel,
+			types.NewPacketDataCall(txID, txIndexParticipant, transactions[txIndexParticipant]),
+			chp.Port, chp.Channel,
 			c.Counterparty.PortId, c.Counterparty.ChannelId,
-			// NOTE: packet-timeout isn't supported in the simple commit
-			clienttypes.NewHeight(clienttypes.ParseChainID(ctx.ChainID()), math.MaxUint64),
-			0,
+			packetTimeoutHeight,
+			packetTimeoutTimestamp,
 		); err != nil {
 			return err
 		}
 	}
 
-	k.SetCoordinatorState(ctx, txID, makeCoordinatorState([]xcctypes.ChannelInfo{*ch0, *ch1}, prepareResult))
-	k.SetContractTransactionState(ctx, txID, TxIndexCoordinator, makeSenderContractTransactionState(prepareResult, *ch0))
+	k.SetCoordinatorState(ctx, txID, makeCoordinatorState(channels, txIndexParticipant, prepareResult))
+	for i, ch := range channels {
+		if txIndex := crosstypes.TxIndex(i); txIndex != txIndexParticipant {
+			k.SetContractTransactionState(ctx, txID, txIndex, makeSenderContractTransactionState(prepareResult, txPrepareResults[i], ch))
+		}
+	}
 	return nil
 }
 
+// prepareLocalTransactions prepares all contract transactions on our chain atomically.
+// If any of them fails to prepare, the state changes of the others are discarded and PREPARE_RESULT_FAILED is returned.
+// It also returns the result of each transaction, which is PREPARE_RESULT_UNKNOWN if it isn't prepared.
+func (k Keeper) prepareLocalTransactions(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	transactions []txtypes.ResolvedContractTransaction,
+	txIndexParticipant crosstypes.TxIndex,
+) (atomictypes.PrepareResult, []atomictypes.PrepareResult) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	results := make([]atomictypes.PrepareResult, len(transactions))
+	for i, tx := range transactions {
+		txIndex := crosstypes.TxIndex(i)
+		if txIndex == txIndexParticipant {
+			continue
+		}
+		// TODO returns a result of contract call
+		if _, err := k.cm.PrepareCommit(cacheCtx, txID, txIndex, tx); err != nil {
+			k.Logger(ctx).Info("failed to PrepareCommit", "txIndex", txIndex, "err", err)
+			results[i] = atomictypes.PREPARE_RESULT_FAILED
+			return atomictypes.PREPARE_RESULT_FAILED, results
+		}
+		results[i] = atomictypes.PREPARE_RESULT_OK
+	}
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return atomictypes.PREPARE_RESULT_OK, results
+}
+
 // ReceiveCallPacket receives a PacketDataCall to commit a transaction
 // caller is participant
 func (k Keeper) ReceiveCallPacket(
@@ -152,16 +190,17 @@ func (k Keeper) ReceiveCallPacket(
 		return nil, nil, fmt.Errorf("channel(port=%v channel=%v) not found", destPort, destChannel)
 	}
 
-	if _, ok := k.GetContractTransactionState(ctx, data.TxId, TxIndexParticipant); ok {
+	if _, ok := k.GetContractTransactionState(ctx, data.TxId, data.TxIndex); ok {
 		return nil, nil, fmt.Errorf("txID '%x' already exists", data.TxId)
 	}
 
-	// if !k.xccResolver.Capabilities().CrossChainCalls(ctx, uint32(txtypes.COMMIT_PROTOCOL_SIMPLE)) && len(data.TxInfo.Tx.Links) > 0 {
-	// 	return nil, nil, errors.New("CrossChainResolver cannot resolve cannot support the cross-chain calls feature")
-	// }
+	// the call results are rejected if cross-chain calls are disabled on our chain
+	if err := k.GetParams(ctx).ValidateCallResults(len(data.Tx.CallResults)); err != nil {
+		return nil, nil, err
+	}
 
 	var commitStatus types.CommitStatus
-	res, err := k.cm.CommitImmediately(ctx, data.TxId, TxIndexParticipant, data.Tx)
+	res, err := k.cm.CommitImmediately(ctx, data.TxId, data.TxIndex, data.Tx)
 	if err != nil {
 		commitStatus = types.COMMIT_STATUS_FAILED
 		k.Logger(ctx).Error("failed to CommitImmediatelyTransaction", "err", err)
@@ -171,7 +210,7 @@ func (k Keeper) ReceiveCallPacket(
 	k.SetContractTransactionState(
 		ctx,
 		data.TxId,
-		TxIndexParticipant,
+		data.TxIndex,
 		makeReceiverContractTransactionState(
 			xcctypes.ChannelInfo{Port: destPort, Channel: destChannel},
 			commitStatus,
@@ -202,7 +241,12 @@ func (k Keeper) ReceiveCallAcknowledgement(
 	if !found {
 		return false, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, sourceChannel)
 	}
-	if err := cs.Confirm(TxIndexParticipant, xcctypes.ChannelInfo{Port: sourcePort, Channel: sourceChannel}); err != nil {
+	ch := xcctypes.ChannelInfo{Port: sourcePort, Channel: sourceChannel}
+	txIndex, err := getParticipantTxIndex(*cs, ch)
+	if err != nil {
+		return false, err
+	}
+	if err := cs.Confirm(txIndex, ch); err != nil {
 		return false, err
 	}
 	switch ack.Status {
@@ -211,13 +255,13 @@ func (k Keeper) ReceiveCallAcknowledgement(
 		isCommittable = true
 	case types.COMMIT_STATUS_FAILED:
 		cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+		cs.AbortReason = atomictypes.ABORT_REASON_PREPARE_FAILED
 		isCommittable = false
 	default:
 		panic("unreachable")
 	}
 	cs.Phase = atomictypes.COORDINATOR_PHASE_COMMIT
-	cs.AddAck(TxIndexCoordinator)
-	cs.AddAck(TxIndexParticipant)
+	addAllAcks(cs)
 	if !cs.IsConfirmedALLPrepares() || !cs.IsConfirmedALLCommits() {
 		panic("fatal error")
 	}
@@ -225,35 +269,136 @@ func (k Keeper) ReceiveCallAcknowledgement(
 	return isCommittable, nil
 }
 
-// TryCommit try to commit or abort a transaction
+// HandlePacketTimeoutCall handles a timeout of PacketDataCall to abort a transaction
 // caller is coordinator
-func (k Keeper) TryCommit(
+func (k Keeper) HandlePacketTimeoutCall(
 	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
 	txID crosstypes.TxID,
-	isCommittable bool,
 ) (*txtypes.ContractCallResult, error) {
-	_, err := k.EnsureContractTransactionStatus(ctx, txID, TxIndexCoordinator, atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE)
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	} else if cs.Phase != atomictypes.COORDINATOR_PHASE_PREPARE {
+		return nil, fmt.Errorf("coordinator status must be '%v'", atomictypes.COORDINATOR_PHASE_PREPARE.String())
+	}
+
+	ch := xcctypes.ChannelInfo{Port: sourcePort, Channel: sourceChannel}
+	txIndex, err := getParticipantTxIndex(*cs, ch)
+	if err != nil {
+		return nil, err
+	}
+	if err := cs.Confirm(txIndex, ch); err != nil {
+		return nil, err
+	}
+	cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+	cs.AbortReason = atomictypes.ABORT_REASON_TIMEOUT
+	cs.Phase = atomictypes.COORDINATOR_PHASE_COMMIT
+	addAllAcks(cs)
+	k.SetCoordinatorState(ctx, txID, *cs)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypePacketTimeout,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+		),
+	)
+	return k.TryCommit(ctx, txID, false)
+}
+
+// HandlePacketErrorAcknowledgementCall handles an error acknowledgement of PacketDataCall.
+// The participant failed to handle the packet, so the coordinator aborts the transaction.
+// caller is coordinator
+func (k Keeper) HandlePacketErrorAcknowledgementCall(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	txID crosstypes.TxID,
+	errMsg string,
+) (*txtypes.ContractCallResult, error) {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	}
+	txIndex, err := getParticipantTxIndex(*cs, xcctypes.ChannelInfo{Port: sourcePort, Channel: sourceChannel})
 	if err != nil {
 		return nil, err
 	}
-	var (
-		res    *txtypes.ContractCallResult
-		status atomictypes.ContractTransactionStatus
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeErrorACK,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, errMsg),
+		),
 	)
-	if isCommittable {
-		res, err = k.cm.Commit(ctx, txID, TxIndexCoordinator)
-		if err != nil {
-			return nil, err
+	isCommittable, err := k.ReceiveCallAcknowledgement(
+		ctx,
+		sourcePort, sourceChannel,
+		*types.NewPacketAcknowledgementCall(types.COMMIT_STATUS_FAILED),
+		txID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return k.TryCommit(ctx, txID, isCommittable)
+}
+
+// TryCommit try to commit or abort all contract transactions on our chain.
+// It returns the result of the first contract transaction with the events of all contract transactions.
+// caller is coordinator
+func (k Keeper) TryCommit(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	isCommittable bool,
+) (*txtypes.ContractCallResult, error) {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	} else if cs.Phase != atomictypes.COORDINATOR_PHASE_COMMIT {
+		return nil, fmt.Errorf("coordinator status must be '%v'", atomictypes.COORDINATOR_PHASE_COMMIT.String())
+	}
+	// the contract transactions on our chain are committed or aborted atomically
+	cacheCtx, writeFn := ctx.CacheContext()
+	var res *txtypes.ContractCallResult
+	for i := range cs.Channels {
+		txIndex := crosstypes.TxIndex(i)
+		if _, found := k.GetContractTransactionState(cacheCtx, txID, txIndex); !found {
+			// the contract transaction runs on the participant chain
+			continue
 		}
-		status = atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT
-	} else {
-		err = k.cm.Abort(ctx, txID, TxIndexCoordinator)
+		_, err := k.EnsureContractTransactionStatus(cacheCtx, txID, txIndex, atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE)
 		if err != nil {
 			return nil, err
 		}
-		status = atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT
+		var status atomictypes.ContractTransactionStatus
+		if isCommittable {
+			r, err := k.cm.Commit(cacheCtx, txID, txIndex)
+			if err != nil {
+				return nil, err
+			}
+			if res == nil {
+				res = r
+			} else if r != nil {
+				res.Events = append(res.Events, r.Events...)
+			}
+			status = atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT
+		} else {
+			err = k.cm.Abort(cacheCtx, txID, txIndex)
+			if err != nil {
+				return nil, err
+			}
+			status = atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT
+		}
+		k.UpdateContractTransactionStatus(cacheCtx, txID, txIndex, status)
 	}
-	k.UpdateContractTransactionStatus(ctx, txID, TxIndexCoordinator, status)
+	// the simple commit protocol doesn't send any commit packets, so the tx is finalized here
+	cs.Phase = atomictypes.COORDINATOR_PHASE_COMPLETED
+	k.SetCoordinatorState(cacheCtx, txID, *cs)
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	return res, nil
 }
 
@@ -264,24 +409,30 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // makeCoordinatorState returns a state of coordinator after `prepare`
 // CONTRACT:
-// - `channels` length must be 2
+// - `channels` length must be greater than 1
+// - `txIndexParticipant` must be an index of `channels`
 // - `prepareResult` must be PREPARE_RESULT_OK or PREPARE_RESULT_FAILED
-func makeCoordinatorState(channels []xcctypes.ChannelInfo, prepareResult atomictypes.PrepareResult) atomictypes.CoordinatorState {
-	if len(channels) != 2 {
-		panic(fmt.Errorf("channels length must be 2"))
+func makeCoordinatorState(channels []xcctypes.ChannelInfo, txIndexParticipant crosstypes.TxIndex, prepareResult atomictypes.PrepareResult) atomictypes.CoordinatorState {
+	if len(channels) < 2 {
+		panic(fmt.Errorf("channels length must be greater than 1"))
+	} else if int(txIndexParticipant) >= len(channels) {
+		panic(fmt.Errorf("txIndexParticipant '%v' is out of range", txIndexParticipant))
 	}
 
 	var (
 		coordinatorPhase    atomictypes.CoordinatorPhase
 		coordinatorDecision atomictypes.CoordinatorDecision
+		abortReason         atomictypes.AbortReason
 	)
 
 	if prepareResult == atomictypes.PREPARE_RESULT_OK {
 		coordinatorPhase = atomictypes.COORDINATOR_PHASE_PREPARE
 		coordinatorDecision = atomictypes.COORDINATOR_DECISION_UNKNOWN
 	} else if prepareResult == atomictypes.PREPARE_RESULT_FAILED {
-		coordinatorPhase = atomictypes.COORDINATOR_PHASE_COMMIT
+		// the transaction is aborted without sending the call packet, so it's already finalized
+		coordinatorPhase = atomictypes.COORDINATOR_PHASE_COMPLETED
 		coordinatorDecision = atomictypes.COORDINATOR_DECISION_ABORT
+		abortReason = atomictypes.ABORT_REASON_PREPARE_FAILED
 	} else {
 		panic(fmt.Errorf("unexpected value: %v", prepareResult))
 	}
@@ -292,8 +443,16 @@ func makeCoordinatorState(channels []xcctypes.ChannelInfo, prepareResult atomict
 		channels,
 	)
 	cs.Decision = coordinatorDecision
-	if err := cs.Confirm(TxIndexCoordinator, channels[0]); err != nil {
-		panic(err)
+	cs.AbortReason = abortReason
+	for i, ch := range channels {
+		if txIndex := crosstypes.TxIndex(i); txIndex != txIndexParticipant {
+			if err := cs.Confirm(txIndex, ch); err != nil {
+				panic(err)
+			}
+		}
+	}
+	if prepareResult == atomictypes.PREPARE_RESULT_FAILED {
+		addAllAcks(&cs)
 	}
 	return cs
 }
@@ -301,16 +460,34 @@ func makeCoordinatorState(channels []xcctypes.ChannelInfo, prepareResult atomict
 // makeSenderContractTransactionState returns a ContractTransactionState of coordinator after `prepare`
 // CONTRACT:
 // - `prepareResult` must be PREPARE_RESULT_OK or PREPARE_RESULT_FAILED
-func makeSenderContractTransactionState(prepareResult atomictypes.PrepareResult, channel xcctypes.ChannelInfo) atomictypes.ContractTransactionState {
+// - `txPrepareResult` is the result of the transaction itself, which is PREPARE_RESULT_UNKNOWN if it isn't prepared
+func makeSenderContractTransactionState(prepareResult atomictypes.PrepareResult, txPrepareResult atomictypes.PrepareResult, channel xcctypes.ChannelInfo) atomictypes.ContractTransactionState {
 	if prepareResult == atomictypes.PREPARE_RESULT_OK {
-		return atomictypes.NewContractTransactionState(atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE, prepareResult, channel)
+		return atomictypes.NewContractTransactionState(atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE, txPrepareResult, channel)
 	} else if prepareResult == atomictypes.PREPARE_RESULT_FAILED {
-		return atomictypes.NewContractTransactionState(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, prepareResult, channel)
+		return atomictypes.NewContractTransactionState(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, txPrepareResult, channel)
 	} else {
 		panic(fmt.Errorf("unexpected value: %v", prepareResult))
 	}
 }
 
+// getParticipantTxIndex returns the txIndex of the participant that a given channel indicates
+func getParticipantTxIndex(cs atomictypes.CoordinatorState, channel xcctypes.ChannelInfo) (crosstypes.TxIndex, error) {
+	for i, ch := range cs.Channels {
+		if ch == channel {
+			return crosstypes.TxIndex(i), nil
+		}
+	}
+	return 0, fmt.Errorf("channel '%v' not found", channel.String())
+}
+
+// addAllAcks adds all txIndexes to Acks because the simple commit protocol doesn't need any commit packets
+func addAllAcks(cs *atomictypes.CoordinatorState) {
+	for i := range cs.Channels {
+		cs.AddAck(crosstypes.TxIndex(i))
+	}
+}
+
 // makeReceiverContractTransactionState returns a ContractTransactionState of participant(doesn't have the coordinator role) after `commit`
 // CONTRACT:
 // - `commitStatus` must be COMMIT_STATUS_OK or COMMIT_STATUS_FAILED
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,7 +14,6 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	samplemodtypes "github.com/datachainlab/cross/simapp/samplemod/types"
-	"github.com/datachainlab/cross/x/core/atomic/protocol/simple/keeper"
 	"github.com/datachainlab/cross/x/core/atomic/protocol/simple/types"
 	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
@@ -320,8 +320,9 @@ func (suite *KeeperTestSuite) TestCall() {
 			cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
 			suite.Require().True(found)
 			if c.hasErrorSendCall {
-				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
 				suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+				suite.Require().True(cs.IsCompleted())
 				suite.Require().Equal(0, len(ps.Packets()))
 				return
 			} else {
@@ -351,6 +352,7 @@ func (suite *KeeperTestSuite) TestCall() {
 			suite.Require().True(found)
 			suite.Require().Equal(atomictypes.COORDINATOR_PHASE_PREPARE, cs.Phase)
 			suite.Require().Equal(atomictypes.COORDINATOR_DECISION_UNKNOWN, cs.Decision)
+			suite.Require().False(cs.IsCompleted())
 
 			// check if ReceiveCallPacket call is expected
 
@@ -383,7 +385,7 @@ func (suite *KeeperTestSuite) TestCall() {
 				})
 			}
 			suite.chainB.NextBlock()
-			ctxs, found := kB.GetContractTransactionState(suite.chainB.GetContext(), txID, keeper.TxIndexParticipant)
+			ctxs, found := kB.GetContractTransactionState(suite.chainB.GetContext(), txID, crosstypes.TxIndex(1))
 			suite.Require().True(found)
 			suite.Require().Equal(c.participantContractTransactionStatus, ctxs.Status)
 			suite.Require().Equal(c.participantPrepareResult, ctxs.PrepareResult)
@@ -400,20 +402,359 @@ func (suite *KeeperTestSuite) TestCall() {
 
 			// check if TryCommit call is expected
 
+			committed := suite.chainA.App.SamplemodKeeper.GetCommittedCount(suite.chainA.GetContext())
+			aborted := suite.chainA.App.SamplemodKeeper.GetAbortedCount(suite.chainA.GetContext())
 			res, err = kA.TryCommit(suite.chainA.GetContext(), txID, isCommittable)
 			suite.Require().NoError(err)
 			if c.initiatorCommittable {
 				suite.Require().NotNil(res)
 				suite.Require().Equal(c.expectedResult[0], res.GetData())
+				suite.Require().Equal(committed+1, suite.chainA.App.SamplemodKeeper.GetCommittedCount(suite.chainA.GetContext()))
 			} else {
 				suite.Require().Nil(res)
+				suite.Require().Equal(aborted+1, suite.chainA.App.SamplemodKeeper.GetAbortedCount(suite.chainA.GetContext()))
 			}
+			cs, found = kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+			suite.Require().True(found)
+			suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+			suite.Require().True(cs.IsCompleted())
 			suite.chainA.NextBlock()
 		})
 	}
 
 }
 
+func (suite *KeeperTestSuite) TestCallMultipleLocalTransactions() {
+	// setup
+
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelA, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+
+	chAB := xcctypes.ChannelInfo{Port: channelA.PortID, Channel: channelA.ID}
+	xccB, err := xcctypes.PackCrossChainChannel(&chAB)
+	suite.Require().NoError(err)
+	xccSelf, err := xcctypes.PackCrossChainChannel(
+		suite.chainA.App.XCCResolver.GetSelfCrossChainChannel(suite.chainA.GetContext()),
+	)
+	suite.Require().NoError(err)
+
+	localTx := func(account string, method string) initiatortypes.ContractTransaction {
+		return initiatortypes.ContractTransaction{
+			CrossChainChannel: xccSelf,
+			Signers: []authtypes.Account{
+				authtypes.NewLocalAccount(authtypes.AccountID(account)),
+			},
+			CallInfo: samplemodtypes.NewContractCallRequest(method).ContractCallInfo(suite.chainA.App.AppCodec()),
+		}
+	}
+	remoteTx := func(method string) initiatortypes.ContractTransaction {
+		return initiatortypes.ContractTransaction{
+			CrossChainChannel: xccB,
+			Signers: []authtypes.Account{
+				authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
+			},
+			CallInfo: samplemodtypes.NewContractCallRequest(method).ContractCallInfo(suite.chainB.App.AppCodec()),
+		}
+	}
+
+	var cases = []struct {
+		name                  string
+		txs                   []initiatortypes.ContractTransaction
+		hasErrorSendCall      bool
+		initiatorCommittable  bool
+		expectedLocalStatus   atomictypes.ContractTransactionStatus
+		expectedPrepareResult [3]atomictypes.PrepareResult
+	}{
+		{
+			"all contract transactions are committed",
+			[]initiatortypes.ContractTransaction{localTx("alice", "counter"), remoteTx("counter"), localTx("bob", "counter")},
+			false,
+			true,
+			atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT,
+			[3]atomictypes.PrepareResult{atomictypes.PREPARE_RESULT_OK, 0, atomictypes.PREPARE_RESULT_OK},
+		},
+		{
+			"the participant fails",
+			[]initiatortypes.ContractTransaction{localTx("alice", "counter"), remoteTx("fail"), localTx("bob", "counter")},
+			false,
+			false,
+			atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT,
+			[3]atomictypes.PrepareResult{atomictypes.PREPARE_RESULT_OK, 0, atomictypes.PREPARE_RESULT_OK},
+		},
+		{
+			"a local contract transaction fails",
+			[]initiatortypes.ContractTransaction{localTx("alice", "counter"), remoteTx("counter"), localTx("bob", "fail")},
+			true,
+			false,
+			atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT,
+			[3]atomictypes.PrepareResult{atomictypes.PREPARE_RESULT_OK, 0, atomictypes.PREPARE_RESULT_FAILED},
+		},
+	}
+
+	for i, c := range cases {
+		suite.Run(c.name, func() {
+			txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+				suite.chainA.GetContext(),
+				c.txs,
+			)
+			suite.Require().NoError(err)
+
+			txID := []byte(fmt.Sprintf("txid-multi-%v", i))
+			kA := suite.chainA.App.AtomicKeeper.SimpleKeeper()
+
+			ps := ibctesting.NewCapturePacketSender(
+				packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+			)
+			suite.Require().NoError(
+				kA.SendCall(
+					suite.chainA.GetContext(),
+					ps,
+					txID,
+					txs,
+					clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+					0,
+				),
+			)
+			suite.chainA.NextBlock()
+
+			checkLocalStates := func(status atomictypes.ContractTransactionStatus) {
+				for _, txIndex := range []crosstypes.TxIndex{0, 2} {
+					ctxs, found := kA.GetContractTransactionState(suite.chainA.GetContext(), txID, txIndex)
+					suite.Require().True(found)
+					suite.Require().Equal(status, ctxs.Status)
+					suite.Require().Equal(c.expectedPrepareResult[txIndex], ctxs.PrepareResult)
+				}
+				_, found := kA.GetContractTransactionState(suite.chainA.GetContext(), txID, 1)
+				suite.Require().False(found)
+			}
+
+			cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+			suite.Require().True(found)
+			if c.hasErrorSendCall {
+				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+				suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+				suite.Require().True(cs.IsCompleted())
+				suite.Require().Equal(0, len(ps.Packets()))
+				checkLocalStates(c.expectedLocalStatus)
+				return
+			}
+			suite.Require().Equal(atomictypes.COORDINATOR_PHASE_PREPARE, cs.Phase)
+			suite.Require().Equal(1, len(ps.Packets()))
+			checkLocalStates(atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE)
+
+			// the participant receives the contract transaction with its txIndex
+
+			p0 := ps.Packets()[0]
+			ip0, err := packets.UnmarshalIncomingPacket(suite.chainA.App.AppCodec(), p0)
+			suite.Require().NoError(err)
+			var payload0 packets.PacketDataPayload
+			suite.Require().NoError(suite.chainB.App.AppCodec().UnpackAny(ip0.PacketData().GetPayload(), &payload0))
+			callData := payload0.(*types.PacketDataCall)
+			suite.Require().Equal(crosstypes.TxIndex(1), callData.TxIndex)
+
+			kB := suite.chainB.App.AtomicKeeper.SimpleKeeper()
+			_, ack, err := kB.ReceiveCallPacket(suite.chainB.GetContext(), p0.GetDestPort(), p0.GetDestChannel(), *callData)
+			suite.Require().NoError(err)
+			suite.chainB.NextBlock()
+			_, found = kB.GetContractTransactionState(suite.chainB.GetContext(), txID, 1)
+			suite.Require().True(found)
+
+			isCommittable, err := kA.ReceiveCallAcknowledgement(
+				suite.chainA.GetContext(),
+				channelA.PortID, channelA.ID,
+				*ack, txID,
+			)
+			suite.Require().NoError(err)
+			suite.Require().Equal(c.initiatorCommittable, isCommittable)
+
+			// all local contract transactions are committed or aborted together
+
+			committed := suite.chainA.App.SamplemodKeeper.GetCommittedCount(suite.chainA.GetContext())
+			aborted := suite.chainA.App.SamplemodKeeper.GetAbortedCount(suite.chainA.GetContext())
+			{
+				// no local contract transactions are committed if any of them fails
+				failCtx, _ := suite.chainA.GetContext().CacheContext()
+				kA.UpdateContractTransactionStatus(failCtx, txID, 2, atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT)
+				_, err := kA.TryCommit(failCtx, txID, isCommittable)
+				suite.Require().Error(err)
+				ctxs, found := kA.GetContractTransactionState(failCtx, txID, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE, ctxs.Status)
+				suite.Require().Equal(committed, suite.chainA.App.SamplemodKeeper.GetCommittedCount(failCtx))
+				suite.Require().Equal(aborted, suite.chainA.App.SamplemodKeeper.GetAbortedCount(failCtx))
+			}
+			res, err := kA.TryCommit(suite.chainA.GetContext(), txID, isCommittable)
+			suite.Require().NoError(err)
+			if c.initiatorCommittable {
+				suite.Require().NotNil(res)
+				suite.Require().Equal(sdk.Uint64ToBigEndian(1), res.GetData())
+				suite.Require().Equal(committed+2, suite.chainA.App.SamplemodKeeper.GetCommittedCount(suite.chainA.GetContext()))
+			} else {
+				suite.Require().Nil(res)
+				suite.Require().Equal(aborted+2, suite.chainA.App.SamplemodKeeper.GetAbortedCount(suite.chainA.GetContext()))
+			}
+			checkLocalStates(c.expectedLocalStatus)
+			suite.chainA.NextBlock()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCallTimeout() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelA, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+
+	chAB := xcctypes.ChannelInfo{Port: channelA.PortID, Channel: channelA.ID}
+	xccB, err := xcctypes.PackCrossChainChannel(&chAB)
+	suite.Require().NoError(err)
+	xccSelf, err := xcctypes.PackCrossChainChannel(
+		suite.chainA.App.XCCResolver.GetSelfCrossChainChannel(suite.chainA.GetContext()),
+	)
+	suite.Require().NoError(err)
+
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+		suite.chainA.GetContext(),
+		[]initiatortypes.ContractTransaction{
+			{
+				CrossChainChannel: xccSelf,
+				Signers: []authtypes.Account{
+					authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+				},
+				CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainA.App.AppCodec()),
+			},
+			{
+				CrossChainChannel: xccB,
+				Signers: []authtypes.Account{
+					authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
+				},
+				CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec()),
+			},
+		},
+	)
+	suite.Require().NoError(err)
+
+	txID := []byte("txid-timeout")
+	kA := suite.chainA.App.AtomicKeeper.SimpleKeeper()
+
+	timeoutHeight := clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100)
+	timeoutTimestamp := uint64(suite.chainA.CurrentHeader.Time.Unix()) + 100
+	ps := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	suite.Require().NoError(
+		kA.SendCall(suite.chainA.GetContext(), ps, txID, txs, timeoutHeight, timeoutTimestamp),
+	)
+	suite.chainA.NextBlock()
+
+	// check if the packet has the timeout timestamp of the tx in nanoseconds, but not the timeout height of the coordinator chain
+	suite.Require().Equal(1, len(ps.Packets()))
+	p0 := ps.Packets()[0]
+	suite.Require().True(p0.GetTimeoutHeight().IsZero())
+	suite.Require().Equal(timeoutTimestamp*uint64(time.Second), p0.GetTimeoutTimestamp())
+
+	// the call packet is timed out
+	res, err := kA.HandlePacketTimeoutCall(suite.chainA.GetContext(), p0.GetSourcePort(), p0.GetSourceChannel(), txID)
+	suite.Require().NoError(err)
+	suite.Require().Nil(res)
+	suite.chainA.NextBlock()
+
+	cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+	suite.Require().Equal(atomictypes.ABORT_REASON_TIMEOUT, cs.AbortReason)
+
+	// the tx is finalized once the coordinator aborts its own transactions
+	fin, err := suite.chainA.App.AtomicKeeper.TxFinalization(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		&atomictypes.QueryTxFinalizationRequest{TxId: txID},
+	)
+	suite.Require().NoError(err)
+	suite.Require().True(fin.Completed)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, fin.Decision)
+
+	ctxs, found := kA.GetContractTransactionState(suite.chainA.GetContext(), txID, crosstypes.TxIndex(0))
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, ctxs.Status)
+
+	// check if the locks are released
+	suite.Require().NotPanics(func() {
+		ctx, _ := suite.chainA.GetContext().CacheContext()
+		ctx = contracttypes.SetupContractContext(ctx, contracttypes.ContractRuntimeInfo{CommitMode: contracttypes.BasicMode})
+		_, err = suite.chainA.App.SamplemodKeeper.HandleCounter(
+			ctx,
+			txs[0].Signers,
+			samplemodtypes.NewContractCallRequest("counter"),
+		)
+	})
+
+	// a timeout after the decision fails
+	_, err = kA.HandlePacketTimeoutCall(suite.chainA.GetContext(), p0.GetSourcePort(), p0.GetSourceChannel(), txID)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCallErrorAcknowledgement() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelA, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+
+	chAB := xcctypes.ChannelInfo{Port: channelA.PortID, Channel: channelA.ID}
+	xccB, err := xcctypes.PackCrossChainChannel(&chAB)
+	suite.Require().NoError(err)
+	xccSelf, err := xcctypes.PackCrossChainChannel(
+		suite.chainA.App.XCCResolver.GetSelfCrossChainChannel(suite.chainA.GetContext()),
+	)
+	suite.Require().NoError(err)
+
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+		suite.chainA.GetContext(),
+		[]initiatortypes.ContractTransaction{
+			{
+				CrossChainChannel: xccSelf,
+				Signers: []authtypes.Account{
+					authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+				},
+				CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainA.App.AppCodec()),
+			},
+			{
+				CrossChainChannel: xccB,
+				Signers: []authtypes.Account{
+					authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
+				},
+				CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec()),
+			},
+		},
+	)
+	suite.Require().NoError(err)
+
+	txID := []byte("txid-error-ack")
+	kA := suite.chainA.App.AtomicKeeper.SimpleKeeper()
+
+	ps := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	suite.Require().NoError(
+		kA.SendCall(suite.chainA.GetContext(), ps, txID, txs, clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100), 0),
+	)
+	suite.chainA.NextBlock()
+	suite.Require().Equal(1, len(ps.Packets()))
+	p0 := ps.Packets()[0]
+
+	// the participant returns an error acknowledgement
+	res, err := kA.HandlePacketErrorAcknowledgementCall(suite.chainA.GetContext(), p0.GetSourcePort(), p0.GetSourceChannel(), txID, "malformed packet")
+	suite.Require().NoError(err)
+	suite.Require().Nil(res)
+	suite.chainA.NextBlock()
+
+	cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+	suite.Require().Equal(atomictypes.ABORT_REASON_PREPARE_FAILED, cs.AbortReason)
+
+	ctxs, found := kA.GetContractTransactionState(suite.chainA.GetContext(), txID, crosstypes.TxIndex(0))
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, ctxs.Status)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
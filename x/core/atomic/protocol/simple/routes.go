@@ -75,3 +75,38 @@ func (h PacketHandler) HandleACK(
 	ctx.EventManager().EmitEvents(res.GetEvents())
 	return &sdk.Result{Data: res.GetData(), Events: ctx.EventManager().ABCIEvents()}, nil
 }
+
+func (h PacketHandler) HandleErrorACK(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+	errMsg string,
+) (*sdk.Result, error) {
+	payload, ok := ip.Payload().(*types.PacketDataCall)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected packet type: %T", ip.Payload())
+	}
+	res, err := h.keeper.HandlePacketErrorAcknowledgementCall(ctx, packet.SourcePort, packet.SourceChannel, payload.TxId, errMsg)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(res.GetEvents())
+	return &sdk.Result{Data: res.GetData(), Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func (h PacketHandler) HandleTimeout(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+) (*sdk.Result, error) {
+	payload, ok := ip.Payload().(*types.PacketDataCall)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected timeout packet type: %T", ip.Payload())
+	}
+	res, err := h.keeper.HandlePacketTimeoutCall(ctx, packet.SourcePort, packet.SourceChannel, payload.TxId)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(res.GetEvents())
+	return &sdk.Result{Data: res.GetData(), Events: ctx.EventManager().ABCIEvents()}, nil
+}
@@ -15,9 +15,10 @@ var _ packets.PacketDataPayload = (*PacketDataCall)(nil)
 // NewPacketDataCall creates a new instance of PacketDataCall
 func NewPacketDataCall(
 	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
 	tx txtypes.ResolvedContractTransaction,
 ) *PacketDataCall {
-	return &PacketDataCall{TxId: txID, Tx: tx}
+	return &PacketDataCall{TxId: txID, TxIndex: txIndex, Tx: tx}
 }
 
 func (p PacketDataCall) ValidateBasic() error {
@@ -56,6 +56,10 @@ func (CommitStatus) EnumDescriptor() ([]byte, []int) {
 type PacketDataCall struct {
 	TxId github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
 	Tx   types.ResolvedContractTransaction               `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx"`
+	// tx_index is the index of the participant's transaction. The other transactions run on the coordinator chain.
+	// NOTE: the coordinators that don't set this field put the participant's transaction at index 1, but it's decoded as 0,
+	// so the coordinator and participant chains must be upgraded together.
+	TxIndex github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"tx_index,omitempty"`
 }
 
 func (m *PacketDataCall) Reset()         { *m = PacketDataCall{} }
@@ -139,33 +143,35 @@ func init() {
 }
 
 var fileDescriptor_0e762a072cef465e = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x6b, 0x1b, 0x31,
-	0x14, 0xc7, 0x25, 0xe3, 0x66, 0x50, 0x43, 0x30, 0x87, 0x0b, 0xb6, 0x07, 0x39, 0x84, 0x52, 0x42,
-	0x06, 0x09, 0x92, 0xad, 0x43, 0xa9, 0x7d, 0x6e, 0xe9, 0x91, 0xc6, 0x2e, 0xe7, 0x0b, 0x85, 0x2e,
-	0x87, 0xac, 0x13, 0x97, 0x23, 0x77, 0x27, 0x73, 0x7a, 0x69, 0xd5, 0x6f, 0xd0, 0xb1, 0x1f, 0xa1,
-	0xb4, 0x5f, 0xc6, 0x63, 0xc6, 0x4e, 0xa1, 0xb5, 0x97, 0x7e, 0x86, 0x4e, 0x25, 0x3a, 0x0f, 0xe7,
-	0xa1, 0x90, 0x4d, 0xbc, 0xf7, 0xd3, 0x4f, 0x7f, 0x9e, 0x1e, 0x79, 0x2a, 0x2b, 0x6d, 0x0c, 0x97,
-	0xba, 0x52, 0x5c, 0x80, 0x2e, 0x32, 0xc9, 0x4d, 0x56, 0x2c, 0x73, 0xc5, 0xe1, 0xf3, 0x52, 0x19,
-	0xb6, 0xac, 0x34, 0x68, 0xaf, 0xe7, 0x28, 0x76, 0x4f, 0xb1, 0x9a, 0x62, 0x35, 0x35, 0xe8, 0xa6,
-	0x3a, 0xd5, 0x0e, 0xe2, 0xf7, 0xa7, 0x9a, 0x1f, 0xf4, 0x1b, 0x56, 0xb0, 0x4d, 0xd5, 0xd1, 0x77,
-	0x4c, 0x0e, 0xde, 0x09, 0x79, 0xad, 0x60, 0x22, 0x40, 0xf8, 0x22, 0xcf, 0xbd, 0x37, 0xe4, 0x11,
-	0xd8, 0x38, 0x4b, 0x7a, 0xf8, 0x10, 0x1f, 0xef, 0x8f, 0xcf, 0xfe, 0xde, 0x0d, 0x79, 0x9a, 0xc1,
-	0xd5, 0xcd, 0x82, 0x49, 0x5d, 0xf0, 0x44, 0x80, 0x90, 0x57, 0x22, 0x2b, 0x73, 0xb1, 0xe0, 0xb5,
-	0xd8, 0x6e, 0xd5, 0xce, 0x1b, 0xd9, 0x60, 0x12, 0xb6, 0xc1, 0x06, 0x89, 0xf7, 0x92, 0xb4, 0xc0,
-	0xf6, 0x5a, 0x87, 0xf8, 0xf8, 0xf1, 0xe9, 0x09, 0x6b, 0x84, 0x06, 0xcb, 0x42, 0x65, 0x74, 0xfe,
-	0x51, 0x25, 0xbe, 0x2e, 0xa1, 0x12, 0x12, 0xa2, 0x4a, 0x94, 0x46, 0x48, 0xc8, 0x74, 0x39, 0x6e,
-	0xaf, 0xee, 0x86, 0x28, 0x6c, 0x81, 0x7d, 0xde, 0xfe, 0xf3, 0x6d, 0x88, 0x8e, 0x04, 0xe9, 0xd7,
-	0x19, 0x47, 0xf2, 0xba, 0xd4, 0x9f, 0x72, 0x95, 0xa4, 0xaa, 0x50, 0x25, 0xb8, 0xb8, 0x2f, 0xc8,
-	0x9e, 0x01, 0x01, 0x37, 0xc6, 0xe5, 0x3d, 0x38, 0x7d, 0xc6, 0xfe, 0x37, 0x1d, 0xe6, 0xeb, 0xa2,
-	0xc8, 0x60, 0xee, 0xe8, 0x70, 0x7b, 0xab, 0x7e, 0xe2, 0x24, 0x26, 0xfb, 0xcd, 0xae, 0xd7, 0x27,
-	0x4f, 0xfc, 0xd9, 0xc5, 0x45, 0x10, 0xc5, 0xf3, 0x68, 0x14, 0x5d, 0xce, 0xe3, 0xcb, 0xe9, 0xf9,
-	0x74, 0xf6, 0x7e, 0xda, 0x41, 0x5e, 0x97, 0x74, 0x76, 0x5b, 0xb3, 0xf3, 0x0e, 0xf6, 0x7a, 0xa4,
-	0xbb, 0x5b, 0x7d, 0x3d, 0x0a, 0xde, 0xbe, 0x9a, 0x74, 0x5a, 0x83, 0xf6, 0x97, 0x1f, 0x14, 0x8d,
-	0xe3, 0xd5, 0x6f, 0x8a, 0x56, 0x6b, 0x8a, 0x6f, 0xd7, 0x14, 0xff, 0x5a, 0x53, 0xfc, 0x75, 0x43,
-	0xd1, 0xed, 0x86, 0xa2, 0x9f, 0x1b, 0x8a, 0x3e, 0x8c, 0x1e, 0x34, 0xe0, 0xed, 0x46, 0xb8, 0x9f,
-	0x93, 0x3a, 0xdf, 0x59, 0x8d, 0xc5, 0x9e, 0x2b, 0x9f, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x08,
-	0xde, 0x73, 0xba, 0x43, 0x02, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0x13, 0x41,
+	0x10, 0xc6, 0xa7, 0x63, 0x5c, 0xa5, 0x5d, 0x97, 0x30, 0x44, 0x98, 0xe4, 0xd0, 0x09, 0x8b, 0x48,
+	0xd8, 0x43, 0x37, 0x64, 0xc1, 0x83, 0x07, 0x31, 0x7f, 0x14, 0xc3, 0xba, 0x89, 0x4e, 0x66, 0x11,
+	0xbc, 0x0c, 0x9d, 0x9e, 0x26, 0x3b, 0xec, 0xcc, 0x74, 0x98, 0xae, 0xd5, 0xf6, 0x0d, 0x3c, 0xfa,
+	0x08, 0x82, 0x2f, 0x93, 0xe3, 0x1e, 0x3d, 0x05, 0x4d, 0x2e, 0xbe, 0x80, 0x97, 0x9c, 0x24, 0x3d,
+	0x39, 0x24, 0x07, 0xc1, 0xbd, 0x15, 0x55, 0xbf, 0xfa, 0xba, 0xfa, 0xe3, 0xc3, 0x8f, 0x45, 0xae,
+	0xb4, 0x66, 0x42, 0xe5, 0x92, 0x71, 0x50, 0x69, 0x2c, 0x98, 0x8e, 0xd3, 0x59, 0x22, 0x19, 0x7c,
+	0x9e, 0x49, 0x4d, 0x67, 0xb9, 0x02, 0xe5, 0x7a, 0x96, 0xa2, 0x1b, 0x8a, 0x16, 0x14, 0x2d, 0xa8,
+	0x7a, 0x75, 0xaa, 0xa6, 0xca, 0x42, 0x6c, 0x53, 0x15, 0x7c, 0xbd, 0xb6, 0xa3, 0x0a, 0x66, 0x57,
+	0xea, 0xf8, 0x0f, 0xc2, 0x47, 0x6f, 0xb9, 0xb8, 0x92, 0xd0, 0xe7, 0xc0, 0x7b, 0x3c, 0x49, 0xdc,
+	0xd7, 0xf8, 0x2e, 0x98, 0x30, 0x8e, 0x3c, 0xd4, 0x44, 0xad, 0xc3, 0xee, 0xe9, 0x7a, 0xd1, 0x60,
+	0xd3, 0x18, 0x2e, 0xaf, 0x27, 0x54, 0xa8, 0x94, 0x45, 0x1c, 0xb8, 0xb8, 0xe4, 0x71, 0x96, 0xf0,
+	0x09, 0x2b, 0x84, 0xcd, 0x56, 0xda, 0xea, 0x06, 0x66, 0xd0, 0xf7, 0xcb, 0x60, 0x06, 0x91, 0xfb,
+	0x02, 0x97, 0xc0, 0x78, 0xa5, 0x26, 0x6a, 0x3d, 0x68, 0x9f, 0xd0, 0x9d, 0xa3, 0xc1, 0x50, 0x5f,
+	0x6a, 0x95, 0x7c, 0x94, 0x51, 0x4f, 0x65, 0x90, 0x73, 0x01, 0x41, 0xce, 0x33, 0xcd, 0x05, 0xc4,
+	0x2a, 0xeb, 0x96, 0xe7, 0x8b, 0x86, 0xe3, 0x97, 0xc0, 0xb8, 0xef, 0xf0, 0xfd, 0xcd, 0x2d, 0x59,
+	0x24, 0x8d, 0x77, 0xa7, 0x89, 0x5a, 0x0f, 0xbb, 0x4f, 0xd7, 0x8b, 0x46, 0xfb, 0x76, 0xe7, 0x6c,
+	0xb6, 0xfd, 0x7b, 0x50, 0x14, 0xcf, 0xca, 0xbf, 0xbf, 0x35, 0x9c, 0x63, 0x8e, 0x6b, 0xc5, 0xb7,
+	0x3b, 0xe2, 0x2a, 0x53, 0x9f, 0x12, 0x19, 0x4d, 0x65, 0x2a, 0x33, 0xb0, 0x0e, 0x3c, 0xc7, 0x07,
+	0x1a, 0x38, 0x5c, 0x6b, 0x6b, 0xc1, 0x51, 0xfb, 0x09, 0xfd, 0x97, 0xe1, 0xb4, 0xa7, 0xd2, 0x34,
+	0x86, 0xb1, 0xa5, 0xfd, 0xed, 0x56, 0xf1, 0xc4, 0x49, 0x88, 0x0f, 0x77, 0xa7, 0x6e, 0x0d, 0x3f,
+	0xea, 0x8d, 0xce, 0xcf, 0x07, 0x41, 0x38, 0x0e, 0x3a, 0xc1, 0xc5, 0x38, 0xbc, 0x18, 0x9e, 0x0d,
+	0x47, 0xef, 0x87, 0x15, 0xc7, 0xad, 0xe2, 0xca, 0xfe, 0x68, 0x74, 0x56, 0x41, 0xae, 0x87, 0xab,
+	0xfb, 0xdd, 0x57, 0x9d, 0xc1, 0x9b, 0x97, 0xfd, 0x4a, 0xa9, 0x5e, 0xfe, 0xf2, 0x9d, 0x38, 0xdd,
+	0x70, 0xfe, 0x8b, 0x38, 0xf3, 0x25, 0x41, 0x37, 0x4b, 0x82, 0x7e, 0x2e, 0x09, 0xfa, 0xba, 0x22,
+	0xce, 0xcd, 0x8a, 0x38, 0x3f, 0x56, 0xc4, 0xf9, 0xd0, 0xf9, 0x2f, 0x93, 0xb6, 0x21, 0xb3, 0x61,
+	0x10, 0x2a, 0xd9, 0x4b, 0xdb, 0xe4, 0xc0, 0xb6, 0x4f, 0xff, 0x0e, 0x00, 0x9d, 0x28, 0xb7, 0x55,
+	0x96, 0x02, 0x00, 0x00,
 }
 
 func (m *PacketDataCall) Marshal() (dAtA []byte, err error) {
@@ -188,6 +194,11 @@ func (m *PacketDataCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -259,6 +270,9 @@ func (m *PacketDataCall) Size() (n int) {
 	}
 	l = m.Tx.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
 	return n
 }
 
@@ -376,6 +390,25 @@ func (m *PacketDataCall) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
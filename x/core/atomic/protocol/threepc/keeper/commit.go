@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	"github.com/datachainlab/cross/x/core/atomic/protocol/threepc/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+// SendCommit sends commits or aborts to the participants that haven't been finalized yet.
+// caller is coordinator
+func (k Keeper) SendCommit(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	txID crosstypes.TxID,
+	isCommittable bool,
+) error {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return fmt.Errorf("txID '%x' not found", txID)
+	} else if cs.Phase != atomictypes.COORDINATOR_PHASE_PREPARE && cs.Phase != atomictypes.COORDINATOR_PHASE_PRE_COMMIT {
+		return fmt.Errorf("coordinator status must be '%v' or '%v'", atomictypes.COORDINATOR_PHASE_PREPARE.String(), atomictypes.COORDINATOR_PHASE_PRE_COMMIT.String())
+	} else if cs.Decision == atomictypes.COORDINATOR_DECISION_UNKNOWN {
+		return fmt.Errorf("coordinator must decide any status")
+	}
+
+	var (
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+	)
+	if cs.Phase == atomictypes.COORDINATOR_PHASE_PRE_COMMIT && !isCommittable {
+		// NOTE: a pre-committed participant commits the tx by itself at the deadline,
+		// so the abort commit must arrive before it. Otherwise, the coordinator records a commit failure.
+		timeoutTimestamp = cs.TimeoutTimestamp
+	} else {
+		// the participant terminates the tx by itself with the same decision, so the commit is never timed out
+		timeoutHeight = clienttypes.NewHeight(
+			clienttypes.ParseChainID(ctx.ChainID()),
+			math.MaxUint64,
+		)
+	}
+	for id, c := range cs.Channels {
+		if cs.HasAck(crosstypes.TxIndex(id)) {
+			// skip the participant that doesn't need a commit
+			continue
+		}
+		ch, found := k.ChannelKeeper().GetChannel(ctx, c.Port, c.Channel)
+		if !found {
+			return sdkerrors.Wrap(channeltypes.ErrChannelNotFound, c.Channel)
+		}
+		pd := types.NewPacketDataCommit(txID, crosstypes.TxIndex(id), isCommittable)
+		if err := k.SendPacket(
+			ctx,
+			packetSender,
+			pd,
+			c.Port,
+			c.Channel,
+			ch.GetCounterparty().GetPortID(),
+			ch.GetCounterparty().GetChannelID(),
+			timeoutHeight,
+			timeoutTimestamp,
+		); err != nil {
+			return err
+		}
+	}
+	cs.Phase = atomictypes.COORDINATOR_PHASE_COMMIT
+	k.SetCoordinatorState(ctx, txID, *cs)
+	return nil
+}
+
+// ReceivePacketCommit commits or aborts the contract transaction according to the decision of the coordinator.
+// caller is participant
+func (k Keeper) ReceivePacketCommit(
+	ctx sdk.Context,
+	destPort,
+	destChannel string,
+	data types.PacketDataCommit,
+) (*txtypes.ContractCallResult, *types.PacketAcknowledgementCommit, error) {
+	// Validations
+
+	_, found := k.ChannelKeeper().GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, nil, fmt.Errorf("channel not found: port=%v channel=%v", destPort, destChannel)
+	}
+	ci := &xcctypes.ChannelInfo{Channel: destChannel, Port: destPort}
+
+	// NOTE: the abort commit may arrive before the prepare packet if the coordinator has aborted the tx due to a timeout.
+	// In this case, the participant records the abort to reject the prepare packet that arrives later.
+	txState, found := k.GetContractTransactionState(ctx, data.TxId, data.TxIndex)
+	if !found && !data.IsCommittable {
+		k.SetContractTransactionState(
+			ctx, data.TxId, data.TxIndex,
+			atomictypes.NewContractTransactionState(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, atomictypes.PREPARE_RESULT_UNKNOWN, *ci),
+		)
+		return nil, types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_OK), nil
+	} else if !found {
+		return nil, nil, fmt.Errorf("(txID, txIndex) = ('%x', '%v') not found", data.TxId, data.TxIndex)
+	} else if !txState.CoordinatorChannel.Equal(ci) {
+		return nil, nil, fmt.Errorf("expected CoordinatorChannel is %v, but got %v", txState.CoordinatorChannel, ci)
+	}
+
+	// NOTE: the participant may have already terminated the tx by itself with the same decision
+	if (data.IsCommittable && txState.Status == atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT) ||
+		(!data.IsCommittable && txState.Status == atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT) {
+		return nil, types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_OK), nil
+	}
+
+	switch txState.Status {
+	case atomictypes.CONTRACT_TRANSACTION_STATUS_PRE_COMMIT:
+	case atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE:
+		if data.IsCommittable {
+			return nil, nil, fmt.Errorf("expected status is %v, but got %v", atomictypes.CONTRACT_TRANSACTION_STATUS_PRE_COMMIT, txState.Status)
+		}
+	default:
+		return nil, nil, fmt.Errorf("unexpected status %v", txState.Status)
+	}
+
+	// Try to Commit or Abort
+
+	res, err := k.finalize(ctx, data.TxId, data.TxIndex, data.IsCommittable)
+	if err != nil {
+		if !data.IsCommittable {
+			return nil, nil, err
+		}
+		ack := types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_FAILED)
+		ack.ErrorMessage = err.Error()
+		return nil, ack, nil
+	}
+	return res, types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_OK), nil
+}
+
+// ReceiveCommitAcknowledgement records the acknowledgement of the commit from the participant.
+// If all acknowledgements are received, the coordinator phase transitions to COMPLETED.
+// caller is coordinator
+func (k Keeper) ReceiveCommitAcknowledgement(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ack types.PacketAcknowledgementCommit,
+) error {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return fmt.Errorf("txID '%x' not found", txID)
+	} else if cs.Phase != atomictypes.COORDINATOR_PHASE_COMMIT {
+		return fmt.Errorf("coordinator status must be '%v'", atomictypes.COORDINATOR_PHASE_COMMIT.String())
+	} else if cs.Decision == atomictypes.COORDINATOR_DECISION_UNKNOWN {
+		return fmt.Errorf("coordinator must decide any status")
+	}
+
+	if !cs.AddAck(txIndex) {
+		return fmt.Errorf("tx '%v' already exists", txIndex)
+	}
+
+	switch ack.Status {
+	case types.COMMIT_STATUS_OK:
+	case types.COMMIT_STATUS_FAILED:
+		cs.AddCommitFailure(txIndex, ack.ErrorMessage)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				atomictypes.EventTypeCommitFailed,
+				sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+				sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+				sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, ack.ErrorMessage),
+			),
+		)
+	default:
+		return fmt.Errorf("unknown commit status '%v'", ack.Status)
+	}
+
+	if cs.IsConfirmedALLCommits() {
+		cs.Phase = atomictypes.COORDINATOR_PHASE_COMPLETED
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				atomictypes.EventTypeTxCompleted,
+				sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+				sdk.NewAttribute(atomictypes.AttributeKeyDecision, cs.Decision.String()),
+			),
+		)
+	}
+
+	k.SetCoordinatorState(ctx, txID, *cs)
+	return nil
+}
+
+// HandlePacketTimeoutCommit handles a timeout of the abort commit sent in the pre-commit phase.
+// The participant may have committed the tx by itself, so it is recorded as a commit failure.
+// caller is coordinator
+func (k Keeper) HandlePacketTimeoutCommit(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+) error {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypePacketTimeout,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+		),
+	)
+	ack := types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_FAILED)
+	ack.ErrorMessage = "the abort commit timed out, so the participant may have committed the tx by itself"
+	return k.ReceiveCommitAcknowledgement(ctx, txID, txIndex, *ack)
+}
+
+// finalize commits or aborts the contract transaction, updates its status and removes it from the termination index
+func (k Keeper) finalize(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	isCommittable bool,
+) (*txtypes.ContractCallResult, error) {
+	txState, found := k.GetContractTransactionState(ctx, txID, txIndex)
+	if !found {
+		return nil, fmt.Errorf("(txID, txIndex) = ('%x', '%v') not found", txID, txIndex)
+	}
+	var (
+		res    *txtypes.ContractCallResult
+		status atomictypes.ContractTransactionStatus
+	)
+	if isCommittable {
+		var err error
+		if res, err = k.cm.Commit(ctx, txID, txIndex); err != nil {
+			return nil, err
+		}
+		status = atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT
+	} else {
+		if err := k.cm.Abort(ctx, txID, txIndex); err != nil {
+			return nil, err
+		}
+		status = atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT
+	}
+	k.DeleteTxTermination(ctx, txState.TimeoutTimestamp, txID, txIndex)
+	txState.Status = status
+	k.SetContractTransactionState(ctx, txID, txIndex, *txState)
+	return res, nil
+}
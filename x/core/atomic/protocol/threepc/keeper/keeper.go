@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	basekeeper "github.com/datachainlab/cross/x/core/atomic/protocol/base/keeper"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+)
+
+const (
+	TypeName = "threepc"
+)
+
+// Keeper implements the three-phase commit protocol.
+//
+// Unlike the two-phase commit, a participant doesn't block when the coordinator halts:
+// a participant that has been prepared aborts the tx by itself when the deadline of the prepare phase has passed,
+// and a participant that has been pre-committed commits the tx by itself when the deadline of the pre-commit phase has passed.
+// Each deadline is derived from the ThreePhaseCommitTimeout param.
+//
+// NOTE: the protocol assumes that every participant chain keeps producing blocks and the relayers deliver the packets within the deadlines.
+// A participant chain that halts (e.g. for maintenance or an upgrade) longer than ThreePhaseCommitTimeout after it has acknowledged the prepare breaks the atomicity:
+// it aborts the tx by itself when it resumes, while the other participants that have received the pre-commit commit the tx by themselves at the next deadline.
+// The same applies to a network partition. The coordinator detects such a divergence as a timeout of the abort commit,
+// records it in the CommitFailures of the coordinator state and emits a commit_failed event, but it can't undo the committed tx.
+// Operators must not halt a participant chain longer than ThreePhaseCommitTimeout while it has any prepared txs.
+type Keeper struct {
+	cdc codec.Codec
+
+	cm          txtypes.ContractManager
+	xccResolver xcctypes.XCCResolver
+
+	basekeeper.Keeper
+}
+
+func NewKeeper(
+	cdc codec.Codec,
+	cm txtypes.ContractManager,
+	xccResolver xcctypes.XCCResolver,
+	baseKeeper basekeeper.Keeper,
+) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		cm:          cm,
+		xccResolver: xccResolver,
+		Keeper:      baseKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("cross/core/atomic/%s", TypeName))
+}
+
+// phaseTimeout returns the duration (in nanoseconds) of each phase
+func (k Keeper) phaseTimeout(ctx sdk.Context) uint64 {
+	return uint64(time.Duration(k.GetParams(ctx).ThreePhaseCommitTimeout) * time.Second)
+}
+
+// now returns the current block time in nanoseconds, which is comparable with the packet timeout timestamp
+func now(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().UnixNano())
+}
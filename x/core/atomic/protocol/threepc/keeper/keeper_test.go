@@ -0,0 +1,692 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	"github.com/stretchr/testify/suite"
+
+	samplemodtypes "github.com/datachainlab/cross/simapp/samplemod/types"
+	"github.com/datachainlab/cross/x/core/atomic/protocol/threepc/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
+	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+	ibctesting "github.com/datachainlab/cross/x/ibc/testing"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func (suite *KeeperTestSuite) TestTransaction() {
+	var cases = []struct {
+		name                      string
+		calls                     [2]string
+		participantPrepareResults [2]atomictypes.PrepareResult
+		expectedDecision          atomictypes.CoordinatorDecision
+	}{
+		{"commit", [2]string{"counter", "counter"}, [2]atomictypes.PrepareResult{atomictypes.PREPARE_RESULT_OK, atomictypes.PREPARE_RESULT_OK}, atomictypes.COORDINATOR_DECISION_COMMIT},
+		{"abort", [2]string{"counter", "fail"}, [2]atomictypes.PrepareResult{atomictypes.PREPARE_RESULT_OK, atomictypes.PREPARE_RESULT_FAILED}, atomictypes.COORDINATOR_DECISION_ABORT},
+	}
+
+	for i, c := range cases {
+		suite.Run(c.name, func() {
+			suite.SetupTest()
+			txs := suite.setupTransactions(c.calls)
+			txID := []byte(fmt.Sprintf("txid-%v", i))
+			kA := suite.chainA.App.AtomicKeeper.ThreePCKeeper()
+			kB := suite.chainB.App.AtomicKeeper.ThreePCKeeper()
+			kC := suite.chainC.App.AtomicKeeper.ThreePCKeeper()
+
+			// A sends prepares to B and C
+			preparePackets := suite.sendPrepare(txID, txs)
+			prepareDeadline := suite.phaseDeadline(0)
+			for _, p := range preparePackets {
+				suite.Require().Equal(prepareDeadline, p.GetTimeoutTimestamp())
+			}
+			{
+				cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+				suite.Require().True(found)
+				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_PREPARE, cs.Phase)
+				suite.Require().Equal(prepareDeadline, cs.TimeoutTimestamp)
+			}
+
+			// B and C prepare the txs
+			prepareAcks := suite.receivePrepares(preparePackets)
+			for i, ack := range prepareAcks {
+				suite.Require().Equal(c.participantPrepareResults[i], ack.Result)
+			}
+			{
+				ctxs, found := kB.GetContractTransactionState(suite.chainB.GetContext(), txID, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE, ctxs.Status)
+				suite.Require().Equal(prepareDeadline, ctxs.TimeoutTimestamp)
+			}
+
+			// A receives the acknowledgements of the prepares
+			ps0 := suite.newCapturePacketSender()
+			_, err := kA.HandlePacketAcknowledgementPrepare(
+				suite.chainA.GetContext(),
+				preparePackets[0].GetSourcePort(), preparePackets[0].GetSourceChannel(),
+				*prepareAcks[0], txID, 0, ps0,
+			)
+			suite.Require().NoError(err)
+			suite.Require().Empty(ps0.Packets())
+			suite.chainA.NextBlock()
+
+			ps1 := suite.newCapturePacketSender()
+			_, err = kA.HandlePacketAcknowledgementPrepare(
+				suite.chainA.GetContext(),
+				preparePackets[1].GetSourcePort(), preparePackets[1].GetSourceChannel(),
+				*prepareAcks[1], txID, 1, ps1,
+			)
+			suite.Require().NoError(err)
+			suite.Require().Equal(2, len(ps1.Packets()))
+			suite.chainA.NextBlock()
+
+			commitPackets := ps1.Packets()
+			if c.expectedDecision == atomictypes.COORDINATOR_DECISION_COMMIT {
+				cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+				suite.Require().True(found)
+				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_PRE_COMMIT, cs.Phase)
+				suite.Require().Equal(atomictypes.COORDINATOR_DECISION_UNKNOWN, cs.Decision)
+				suite.Require().Equal(suite.phaseDeadline(1), cs.TimeoutTimestamp)
+
+				// B and C pre-commit the txs
+				preCommitPackets := ps1.Packets()
+				for _, p := range preCommitPackets {
+					suite.Require().Equal(prepareDeadline, p.GetTimeoutTimestamp())
+				}
+				preCommitAcks := suite.receivePreCommits(preCommitPackets)
+				{
+					ctxs, found := kC.GetContractTransactionState(suite.chainC.GetContext(), txID, 1)
+					suite.Require().True(found)
+					suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_PRE_COMMIT, ctxs.Status)
+					suite.Require().Equal(suite.phaseDeadline(1), ctxs.TimeoutTimestamp)
+				}
+
+				// A receives the acknowledgements of the pre-commits
+				ps2 := suite.newCapturePacketSender()
+				_, err = kA.HandlePacketAcknowledgementPreCommit(suite.chainA.GetContext(), *preCommitAcks[0], txID, 0, ps2)
+				suite.Require().NoError(err)
+				suite.Require().Empty(ps2.Packets())
+				_, err = kA.HandlePacketAcknowledgementPreCommit(suite.chainA.GetContext(), *preCommitAcks[0], txID, 0, ps2)
+				suite.Require().Error(err)
+				_, err = kA.HandlePacketAcknowledgementPreCommit(suite.chainA.GetContext(), *preCommitAcks[1], txID, 1, ps2)
+				suite.Require().NoError(err)
+				suite.Require().Equal(2, len(ps2.Packets()))
+				suite.chainA.NextBlock()
+				commitPackets = ps2.Packets()
+			}
+			{
+				cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+				suite.Require().True(found)
+				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+				suite.Require().Equal(c.expectedDecision, cs.Decision)
+			}
+
+			// B and C commit or abort the txs
+			commitAcks := suite.receiveCommits(commitPackets, c.expectedDecision == atomictypes.COORDINATOR_DECISION_COMMIT)
+			for i, chain := range []*ibctesting.TestChain{suite.chainB, suite.chainC} {
+				ctx := chain.GetContext()
+				ctxs, found := chain.App.AtomicKeeper.ThreePCKeeper().GetContractTransactionState(ctx, txID, crosstypes.TxIndex(i))
+				suite.Require().True(found)
+				if c.expectedDecision == atomictypes.COORDINATOR_DECISION_COMMIT {
+					suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, ctxs.Status)
+				} else {
+					suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, ctxs.Status)
+				}
+				// the finalized tx is removed from the termination index
+				suite.Require().Empty(chain.App.AtomicKeeper.ThreePCKeeper().GetTxTerminations(ctx, suite.phaseDeadline(2), 10))
+			}
+
+			// A receives the acknowledgements of the commits
+			for i, ack := range commitAcks {
+				suite.Require().NoError(kA.ReceiveCommitAcknowledgement(suite.chainA.GetContext(), txID, crosstypes.TxIndex(i), *ack))
+			}
+			{
+				cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+				suite.Require().True(found)
+				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+				suite.Require().Equal(c.expectedDecision, cs.Decision)
+				suite.Require().Empty(cs.CommitFailures)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTerminateTxsAfterPrepare() {
+	txs := suite.setupTransactions([2]string{"counter", "counter"})
+	txID := []byte("txid-terminate-prepare")
+	kA := suite.chainA.App.AtomicKeeper.ThreePCKeeper()
+	kB := suite.chainB.App.AtomicKeeper.ThreePCKeeper()
+
+	preparePackets := suite.sendPrepare(txID, txs)
+	prepareAcks := suite.receivePrepares(preparePackets)
+
+	// the coordinator is under maintenance, so the participants don't receive any pre-commits
+	suite.Require().Equal(0, kB.TerminateTxs(suite.chainB.GetContext(), atomictypes.MaxTerminatedTxsPerBlock))
+	suite.coordinator.IncrementTimeBy(time.Duration(txtypes.DefaultThreePhaseCommitTimeout) * time.Second)
+	for i, chain := range []*ibctesting.TestChain{suite.chainB, suite.chainC} {
+		k := chain.App.AtomicKeeper.ThreePCKeeper()
+		ctx := chain.GetContext()
+		suite.Require().Equal(1, k.TerminateTxs(ctx, atomictypes.MaxTerminatedTxsPerBlock))
+		suite.Require().Equal(atomictypes.EventTypeTxTerminated, ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+		ctxs, found := k.GetContractTransactionState(ctx, txID, crosstypes.TxIndex(i))
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, ctxs.Status)
+		suite.Require().Equal(0, k.TerminateTxs(ctx, atomictypes.MaxTerminatedTxsPerBlock))
+		chain.NextBlock()
+	}
+
+	// the coordinator recovers after the deadline, so it decides to abort the tx
+	ps := suite.newCapturePacketSender()
+	for i, ack := range prepareAcks {
+		_, err := kA.HandlePacketAcknowledgementPrepare(
+			suite.chainA.GetContext(),
+			preparePackets[i].GetSourcePort(), preparePackets[i].GetSourceChannel(),
+			*ack, txID, crosstypes.TxIndex(i), ps,
+		)
+		suite.Require().NoError(err)
+	}
+	suite.chainA.NextBlock()
+	cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+	suite.Require().Equal(atomictypes.ABORT_REASON_TIMEOUT, cs.AbortReason)
+
+	// the abort commits are accepted by the participants that have already aborted the txs
+	for _, ack := range suite.receiveCommits(ps.Packets(), false) {
+		suite.Require().Equal(types.COMMIT_STATUS_OK, ack.Status)
+	}
+}
+
+func (suite *KeeperTestSuite) TestTerminateTxsAfterPreCommit() {
+	txs := suite.setupTransactions([2]string{"counter", "counter"})
+	txID := []byte("txid-terminate-precommit")
+	kA := suite.chainA.App.AtomicKeeper.ThreePCKeeper()
+	kB := suite.chainB.App.AtomicKeeper.ThreePCKeeper()
+
+	preparePackets := suite.sendPrepare(txID, txs)
+	prepareAcks := suite.receivePrepares(preparePackets)
+	ps := suite.newCapturePacketSender()
+	for i, ack := range prepareAcks {
+		_, err := kA.HandlePacketAcknowledgementPrepare(
+			suite.chainA.GetContext(),
+			preparePackets[i].GetSourcePort(), preparePackets[i].GetSourceChannel(),
+			*ack, txID, crosstypes.TxIndex(i), ps,
+		)
+		suite.Require().NoError(err)
+	}
+	suite.chainA.NextBlock()
+	preCommitAcks := suite.receivePreCommits(ps.Packets())
+
+	// the coordinator is under maintenance after sending the pre-commits
+	suite.coordinator.IncrementTimeBy(time.Duration(txtypes.DefaultThreePhaseCommitTimeout) * time.Second)
+	suite.Require().Equal(0, kB.TerminateTxs(suite.chainB.GetContext(), atomictypes.MaxTerminatedTxsPerBlock))
+	suite.coordinator.IncrementTimeBy(time.Duration(txtypes.DefaultThreePhaseCommitTimeout) * time.Second)
+	for i, chain := range []*ibctesting.TestChain{suite.chainB, suite.chainC} {
+		k := chain.App.AtomicKeeper.ThreePCKeeper()
+		ctx := chain.GetContext()
+		suite.Require().Equal(1, k.TerminateTxs(ctx, atomictypes.MaxTerminatedTxsPerBlock))
+		ctxs, found := k.GetContractTransactionState(ctx, txID, crosstypes.TxIndex(i))
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, ctxs.Status)
+		chain.NextBlock()
+	}
+
+	// the coordinator recovers and decides to commit the tx
+	ps1 := suite.newCapturePacketSender()
+	for i, ack := range preCommitAcks {
+		_, err := kA.HandlePacketAcknowledgementPreCommit(suite.chainA.GetContext(), *ack, txID, crosstypes.TxIndex(i), ps1)
+		suite.Require().NoError(err)
+	}
+	suite.chainA.NextBlock()
+	commitAcks := suite.receiveCommits(ps1.Packets(), true)
+	for i, ack := range commitAcks {
+		suite.Require().Equal(types.COMMIT_STATUS_OK, ack.Status)
+		suite.Require().NoError(kA.ReceiveCommitAcknowledgement(suite.chainA.GetContext(), txID, crosstypes.TxIndex(i), *ack))
+	}
+	cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_COMMIT, cs.Decision)
+}
+
+func (suite *KeeperTestSuite) TestTerminateTxsRetry() {
+	txID := []byte("txid-terminate-retry")
+	kB := suite.chainB.App.AtomicKeeper.ThreePCKeeper()
+	ctx := suite.chainB.GetContext()
+	deadline := uint64(ctx.BlockTime().UnixNano())
+
+	// the contract manager has no prepared tx for the state, so the commit fails
+	txState := atomictypes.NewContractTransactionState(atomictypes.CONTRACT_TRANSACTION_STATUS_PRE_COMMIT, atomictypes.PREPARE_RESULT_OK, xcctypes.ChannelInfo{Port: "port0", Channel: "channel0"})
+	txState.TimeoutTimestamp = deadline
+	kB.SetContractTransactionState(ctx, txID, 0, txState)
+	kB.SetTxTermination(ctx, deadline, txID, 0)
+
+	suite.Require().Equal(1, kB.TerminateTxs(ctx, atomictypes.MaxTerminatedTxsPerBlock))
+	suite.Require().Equal(atomictypes.EventTypeCommitFailed, ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+	res, found := kB.GetContractTransactionState(ctx, txID, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_PRE_COMMIT, res.Status)
+
+	// the entry is re-inserted with the next deadline instead of being lost
+	suite.Require().Equal(0, kB.TerminateTxs(ctx, atomictypes.MaxTerminatedTxsPerBlock))
+	retryDeadline := deadline + uint64(time.Duration(txtypes.DefaultThreePhaseCommitTimeout)*time.Second)
+	entries := kB.GetTxTerminations(ctx, retryDeadline, 10)
+	suite.Require().Len(entries, 1)
+	suite.Require().Equal(retryDeadline, entries[0].TimeoutTimestamp)
+	suite.Require().Equal(crosstypes.TxID(txID), entries[0].TxID)
+
+	// the retry entry is still valid although its deadline is later than the tx's one
+	suite.coordinator.IncrementTimeBy(time.Duration(txtypes.DefaultThreePhaseCommitTimeout) * time.Second)
+	ctx = suite.chainB.GetContext()
+	suite.Require().Equal(1, kB.TerminateTxs(ctx, atomictypes.MaxTerminatedTxsPerBlock))
+	suite.Require().Equal(atomictypes.EventTypeCommitFailed, ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+	suite.Require().Len(kB.GetTxTerminations(ctx, uint64(ctx.BlockTime().UnixNano())+uint64(time.Duration(txtypes.DefaultThreePhaseCommitTimeout)*time.Second), 10), 1)
+}
+
+// TestTerminateTxsDivergence shows that the atomicity is broken if a participant halts longer than the ThreePhaseCommitTimeout after it has been prepared.
+// The participant that halts aborts the tx by itself, and the other participant that has been pre-committed commits the tx by itself.
+// The coordinator detects the divergence as a commit failure.
+func (suite *KeeperTestSuite) TestTerminateTxsDivergence() {
+	txs := suite.setupTransactions([2]string{"counter", "counter"})
+	txID := []byte("txid-terminate-divergence")
+	kA := suite.chainA.App.AtomicKeeper.ThreePCKeeper()
+	kC := suite.chainC.App.AtomicKeeper.ThreePCKeeper()
+
+	preparePackets := suite.sendPrepare(txID, txs)
+	prepareAcks := suite.receivePrepares(preparePackets)
+	ps := suite.newCapturePacketSender()
+	for i, ack := range prepareAcks {
+		_, err := kA.HandlePacketAcknowledgementPrepare(
+			suite.chainA.GetContext(),
+			preparePackets[i].GetSourcePort(), preparePackets[i].GetSourceChannel(),
+			*ack, txID, crosstypes.TxIndex(i), ps,
+		)
+		suite.Require().NoError(err)
+	}
+	suite.chainA.NextBlock()
+	suite.Require().Equal(2, len(ps.Packets()))
+
+	// B halts after it has acknowledged the prepare, so only C receives the pre-commit
+	p1 := ps.Packets()[1]
+	preCommitC := *suite.parsePacket(suite.chainC.App.AppCodec(), p1).(*types.PacketDataPreCommit)
+	ack, err := kC.ReceivePacketPreCommit(suite.chainC.GetContext(), p1.GetDestPort(), p1.GetDestChannel(), preCommitC)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.COMMIT_STATUS_OK, ack.Status)
+	suite.chainC.NextBlock()
+
+	// the coordinator can't decide to abort the tx until B resumes, so C commits the tx by itself at the pre-commit deadline
+	suite.coordinator.IncrementTimeBy(2 * time.Duration(txtypes.DefaultThreePhaseCommitTimeout) * time.Second)
+	suite.Require().Equal(1, kC.TerminateTxs(suite.chainC.GetContext(), atomictypes.MaxTerminatedTxsPerBlock))
+	suite.chainC.NextBlock()
+
+	// B resumes after the prepare deadline, so it aborts the tx by itself
+	kB := suite.chainB.App.AtomicKeeper.ThreePCKeeper()
+	suite.Require().Equal(1, kB.TerminateTxs(suite.chainB.GetContext(), atomictypes.MaxTerminatedTxsPerBlock))
+	suite.chainB.NextBlock()
+
+	ctxsB, found := kB.GetContractTransactionState(suite.chainB.GetContext(), txID, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, ctxsB.Status)
+	ctxsC, found := kC.GetContractTransactionState(suite.chainC.GetContext(), txID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, ctxsC.Status)
+
+	// the pre-commit to B times out, so the coordinator decides to abort the tx, but the abort commit to C has already timed out
+	ps1 := suite.newCapturePacketSender()
+	_, err = kA.HandlePacketTimeoutPreCommit(suite.chainA.GetContext(), txID, 0, ps1)
+	suite.Require().NoError(err)
+	_, err = kA.HandlePacketAcknowledgementPreCommit(suite.chainA.GetContext(), *ack, txID, 1, ps1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, len(ps1.Packets()))
+	suite.Require().LessOrEqual(ps1.Packets()[0].GetTimeoutTimestamp(), uint64(suite.chainC.GetContext().BlockTime().UnixNano()))
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().NoError(kA.HandlePacketTimeoutCommit(ctx, txID, 1))
+	suite.Require().Equal(atomictypes.EventTypeCommitFailed, ctx.EventManager().Events()[len(ctx.EventManager().Events())-2].Type)
+	cs, found := kA.GetCoordinatorState(ctx, txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+	suite.Require().Len(cs.CommitFailures, 1)
+	suite.Require().Equal(crosstypes.TxIndex(1), cs.CommitFailures[0].TxIndex)
+}
+
+func (suite *KeeperTestSuite) TestPreCommitTimeout() {
+	txs := suite.setupTransactions([2]string{"counter", "counter"})
+	txID := []byte("txid-precommit-timeout")
+	kA := suite.chainA.App.AtomicKeeper.ThreePCKeeper()
+	kB := suite.chainB.App.AtomicKeeper.ThreePCKeeper()
+	kC := suite.chainC.App.AtomicKeeper.ThreePCKeeper()
+
+	preparePackets := suite.sendPrepare(txID, txs)
+	prepareAcks := suite.receivePrepares(preparePackets)
+	ps := suite.newCapturePacketSender()
+	for i, ack := range prepareAcks {
+		_, err := kA.HandlePacketAcknowledgementPrepare(
+			suite.chainA.GetContext(),
+			preparePackets[i].GetSourcePort(), preparePackets[i].GetSourceChannel(),
+			*ack, txID, crosstypes.TxIndex(i), ps,
+		)
+		suite.Require().NoError(err)
+	}
+	suite.chainA.NextBlock()
+	suite.Require().Equal(2, len(ps.Packets()))
+
+	// B receives the pre-commit, but the pre-commit to C is timed out
+	p0 := ps.Packets()[0]
+	preCommitB := *suite.parsePacket(suite.chainB.App.AppCodec(), p0).(*types.PacketDataPreCommit)
+	ack, err := kB.ReceivePacketPreCommit(suite.chainB.GetContext(), p0.GetDestPort(), p0.GetDestChannel(), preCommitB)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.COMMIT_STATUS_OK, ack.Status)
+	suite.chainB.NextBlock()
+
+	suite.coordinator.IncrementTimeBy(time.Duration(txtypes.DefaultThreePhaseCommitTimeout) * time.Second)
+	suite.Require().Equal(1, kC.TerminateTxs(suite.chainC.GetContext(), atomictypes.MaxTerminatedTxsPerBlock))
+	suite.chainC.NextBlock()
+
+	ps1 := suite.newCapturePacketSender()
+	_, err = kA.HandlePacketTimeoutPreCommit(suite.chainA.GetContext(), txID, 1, ps1)
+	suite.Require().NoError(err)
+	suite.chainA.NextBlock()
+	cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+	suite.Require().Equal(atomictypes.ABORT_REASON_TIMEOUT, cs.AbortReason)
+
+	// the acknowledgement of the pre-commit that arrives after the decision is ignored
+	_, err = kA.HandlePacketAcknowledgementPreCommit(suite.chainA.GetContext(), *ack, txID, 0, ps1)
+	suite.Require().NoError(err)
+
+	// an abort commit is sent to B only, and it must arrive before B commits the tx by itself
+	suite.Require().Equal(1, len(ps1.Packets()))
+	abortB := *suite.parsePacket(suite.chainB.App.AppCodec(), ps1.Packets()[0]).(*types.PacketDataCommit)
+	suite.Require().Equal(crosstypes.TxIndex(0), abortB.TxIndex)
+	suite.Require().False(abortB.IsCommittable)
+	suite.Require().Equal(cs.TimeoutTimestamp, ps1.Packets()[0].GetTimeoutTimestamp())
+	suite.Require().True(ps1.Packets()[0].GetTimeoutHeight().IsZero())
+
+	_, commitAck, err := kB.ReceivePacketCommit(suite.chainB.GetContext(), ps1.Packets()[0].GetDestPort(), ps1.Packets()[0].GetDestChannel(), abortB)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.COMMIT_STATUS_OK, commitAck.Status)
+	suite.chainB.NextBlock()
+	for i, chain := range []*ibctesting.TestChain{suite.chainB, suite.chainC} {
+		ctxs, found := chain.App.AtomicKeeper.ThreePCKeeper().GetContractTransactionState(chain.GetContext(), txID, crosstypes.TxIndex(i))
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, ctxs.Status)
+	}
+
+	suite.Require().NoError(kA.ReceiveCommitAcknowledgement(suite.chainA.GetContext(), txID, 0, *commitAck))
+	cs, found = kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+}
+
+func (suite *KeeperTestSuite) TestCommitTimeout() {
+	txID := []byte("txid-commit-timeout")
+	kA := suite.chainA.App.AtomicKeeper.ThreePCKeeper()
+	cs := atomictypes.NewCoordinatorState(
+		txtypes.COMMIT_PROTOCOL_3PC,
+		atomictypes.COORDINATOR_PHASE_COMMIT,
+		[]xcctypes.ChannelInfo{{Port: "port0", Channel: "channel0"}, {Port: "port1", Channel: "channel1"}},
+	)
+	cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+	cs.Acks = []crosstypes.TxIndex{1}
+	kA.SetCoordinatorState(suite.chainA.GetContext(), txID, cs)
+
+	// the participant may have committed the tx by itself, so it is recorded as a commit failure
+	suite.Require().NoError(kA.HandlePacketTimeoutCommit(suite.chainA.GetContext(), txID, 0))
+	res, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, res.Phase)
+	suite.Require().Len(res.CommitFailures, 1)
+	suite.Require().Equal(crosstypes.TxIndex(0), res.CommitFailures[0].TxIndex)
+}
+
+func (suite *KeeperTestSuite) TestSendPrepareParams() {
+	txs := suite.setupTransactions([2]string{"counter", "counter"})
+	ctx := suite.chainA.GetContext()
+	params := suite.chainA.App.CrossKeeper.GetParams(ctx)
+	params.AllowedCommitProtocols = []txtypes.CommitProtocol{txtypes.COMMIT_PROTOCOL_TPC}
+	suite.chainA.App.CrossKeeper.SetParams(ctx, params)
+
+	err := suite.chainA.App.AtomicKeeper.ThreePCKeeper().SendPrepare(
+		ctx, suite.newCapturePacketSender(), []byte("txid-params"), txs,
+		clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100), 0,
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestLinkThroughChannelPath() {
+	var cases = []struct {
+		name                    string
+		callsThroughCoordinator bool
+		expectedDecision        atomictypes.CoordinatorDecision
+	}{
+		{"the callee is reached through the coordinator", true, atomictypes.COORDINATOR_DECISION_COMMIT},
+		{"the callee isn't reached through the coordinator", false, atomictypes.COORDINATOR_DECISION_ABORT},
+	}
+
+	for i, c := range cases {
+		suite.Run(c.name, func() {
+			suite.SetupTest()
+
+			// B and C aren't connected to each other
+			chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+			chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
+
+			// the contract on C calls the contract on B through the channels CA and AB
+			path := []string{chB.Signer().HexString(), chB.Channel.ID}
+			if c.callsThroughCoordinator {
+				path = []string{chB.Signer().HexString(), chC.Counterparty.ID, chB.Channel.ID}
+			}
+			txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+				suite.chainA.GetContext(),
+				[]initiatortypes.ContractTransaction{
+					{
+						CrossChainChannel: chB.XCC,
+						Signers:           []authtypes.Account{chB.Signer()},
+						CallInfo:          samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec()),
+						ReturnValue:       txtypes.NewReturnValue(sdk.Uint64ToBigEndian(1)),
+					},
+					{
+						CrossChainChannel: chC.XCC,
+						Signers:           []authtypes.Account{chC.Signer()},
+						CallInfo:          samplemodtypes.NewContractCallRequest("external-call", path...).ContractCallInfo(suite.chainC.App.AppCodec()),
+						Links:             []initiatortypes.Link{{SrcIndex: 0}},
+					},
+				},
+			)
+			suite.Require().NoError(err)
+
+			// the result of B is converted into C's context
+			suite.Require().Len(txs[1].CallResults, 1)
+			xcc := txs[1].UnpackCallResults(suite.chainA.App.AppCodec())[0].GetCrossChainChannel(suite.chainA.App.AppCodec())
+			suite.Require().True(xcc.Equal(xcctypes.NewChannelPath(
+				xcctypes.ChannelInfo{Port: chC.Counterparty.PortID, Channel: chC.Counterparty.ID},
+				chB.ChannelInfo(),
+			)))
+
+			txID := []byte(fmt.Sprintf("txid-path-%v", i))
+			kA := suite.chainA.App.AtomicKeeper.ThreePCKeeper()
+
+			preparePackets := suite.sendPrepare(txID, txs)
+			prepareAcks := suite.receivePrepares(preparePackets)
+			suite.Require().Equal(atomictypes.PREPARE_RESULT_OK, prepareAcks[0].Result)
+			if c.expectedDecision == atomictypes.COORDINATOR_DECISION_ABORT {
+				suite.Require().Equal(atomictypes.PREPARE_RESULT_FAILED, prepareAcks[1].Result)
+				return
+			}
+			suite.Require().Equal(atomictypes.PREPARE_RESULT_OK, prepareAcks[1].Result)
+
+			ps := suite.newCapturePacketSender()
+			for i, ack := range prepareAcks {
+				_, err = kA.HandlePacketAcknowledgementPrepare(
+					suite.chainA.GetContext(),
+					preparePackets[i].GetSourcePort(), preparePackets[i].GetSourceChannel(),
+					*ack, txID, crosstypes.TxIndex(i), ps,
+				)
+				suite.Require().NoError(err)
+			}
+			suite.chainA.NextBlock()
+			preCommitAcks := suite.receivePreCommits(ps.Packets())
+
+			ps = suite.newCapturePacketSender()
+			for i, ack := range preCommitAcks {
+				_, err = kA.HandlePacketAcknowledgementPreCommit(suite.chainA.GetContext(), *ack, txID, crosstypes.TxIndex(i), ps)
+				suite.Require().NoError(err)
+			}
+			suite.chainA.NextBlock()
+			commitAcks := suite.receiveCommits(ps.Packets(), true)
+			for i, ack := range commitAcks {
+				suite.Require().NoError(kA.ReceiveCommitAcknowledgement(suite.chainA.GetContext(), txID, crosstypes.TxIndex(i), *ack))
+			}
+
+			cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+			suite.Require().True(found)
+			suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+			suite.Require().Equal(c.expectedDecision, cs.Decision)
+			ctxs, found := suite.chainC.App.AtomicKeeper.ThreePCKeeper().GetContractTransactionState(suite.chainC.GetContext(), txID, 1)
+			suite.Require().True(found)
+			suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, ctxs.Status)
+		})
+	}
+}
+
+// setupTransactions creates the channels A-B and A-C, and returns the resolved contract transactions that call a given function on B and C
+func (suite *KeeperTestSuite) setupTransactions(calls [2]string) []txtypes.ResolvedContractTransaction {
+	chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+	chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
+
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+		suite.chainA.GetContext(),
+		[]initiatortypes.ContractTransaction{
+			chB.ContractTransaction(samplemodtypes.NewContractCallRequest(calls[0]).ContractCallInfo(suite.chainB.App.AppCodec())),
+			chC.ContractTransaction(samplemodtypes.NewContractCallRequest(calls[1]).ContractCallInfo(suite.chainC.App.AppCodec())),
+		},
+	)
+	suite.Require().NoError(err)
+	return txs
+}
+
+// phaseDeadline returns the deadline of the n-th phase, assuming that the tx has been initiated at the current time of chainA
+func (suite *KeeperTestSuite) phaseDeadline(n int) uint64 {
+	timeout := time.Duration(txtypes.DefaultThreePhaseCommitTimeout) * time.Second
+	return uint64(suite.chainA.CurrentHeader.Time.Add(time.Duration(n+1) * timeout).UnixNano())
+}
+
+// capturePacketSender is a packet sender that captures the sent packets
+type capturePacketSender interface {
+	packets.PacketSender
+	Packets() []packets.OutgoingPacket
+}
+
+func (suite *KeeperTestSuite) newCapturePacketSender() capturePacketSender {
+	return ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+}
+
+func (suite *KeeperTestSuite) sendPrepare(txID crosstypes.TxID, txs []txtypes.ResolvedContractTransaction) []packets.OutgoingPacket {
+	ps := suite.newCapturePacketSender()
+	suite.Require().NoError(
+		suite.chainA.App.AtomicKeeper.ThreePCKeeper().SendPrepare(
+			suite.chainA.GetContext(),
+			ps,
+			txID,
+			txs,
+			clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+			0,
+		),
+	)
+	suite.chainA.NextBlock()
+	suite.Require().Equal(len(txs), len(ps.Packets()))
+	for _, p := range ps.Packets() {
+		// the prepare packets time out at the deadline of the prepare phase, but not at the timeout height of the coordinator chain
+		suite.Require().True(p.GetTimeoutHeight().IsZero())
+		suite.Require().NotZero(p.GetTimeoutTimestamp())
+	}
+	return ps.Packets()
+}
+
+func (suite *KeeperTestSuite) receivePrepares(ps []packets.OutgoingPacket) []*types.PacketAcknowledgementPrepare {
+	var acks []*types.PacketAcknowledgementPrepare
+	for i, chain := range []*ibctesting.TestChain{suite.chainB, suite.chainC} {
+		p := ps[i]
+		data := *suite.parsePacket(chain.App.AppCodec(), p).(*types.PacketDataPrepare)
+		_, ack, err := chain.App.AtomicKeeper.ThreePCKeeper().ReceivePacketPrepare(chain.GetContext(), p.GetDestPort(), p.GetDestChannel(), data)
+		suite.Require().NoError(err)
+		chain.NextBlock()
+		acks = append(acks, ack)
+	}
+	return acks
+}
+
+func (suite *KeeperTestSuite) receivePreCommits(ps []packets.OutgoingPacket) []*types.PacketAcknowledgementPreCommit {
+	var acks []*types.PacketAcknowledgementPreCommit
+	for i, chain := range []*ibctesting.TestChain{suite.chainB, suite.chainC} {
+		p := ps[i]
+		data := *suite.parsePacket(chain.App.AppCodec(), p).(*types.PacketDataPreCommit)
+		ack, err := chain.App.AtomicKeeper.ThreePCKeeper().ReceivePacketPreCommit(chain.GetContext(), p.GetDestPort(), p.GetDestChannel(), data)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.COMMIT_STATUS_OK, ack.Status)
+		chain.NextBlock()
+		acks = append(acks, ack)
+	}
+	return acks
+}
+
+func (suite *KeeperTestSuite) receiveCommits(ps []packets.OutgoingPacket, isCommittable bool) []*types.PacketAcknowledgementCommit {
+	var acks []*types.PacketAcknowledgementCommit
+	for i, chain := range []*ibctesting.TestChain{suite.chainB, suite.chainC} {
+		p := ps[i]
+		data := *suite.parsePacket(chain.App.AppCodec(), p).(*types.PacketDataCommit)
+		suite.Require().Equal(isCommittable, data.IsCommittable)
+		_, ack, err := chain.App.AtomicKeeper.ThreePCKeeper().ReceivePacketCommit(chain.GetContext(), p.GetDestPort(), p.GetDestChannel(), data)
+		suite.Require().NoError(err)
+		chain.NextBlock()
+		acks = append(acks, ack)
+	}
+	return acks
+}
+
+func (suite *KeeperTestSuite) parsePacket(cdc codec.Codec, p packets.OutgoingPacket) packets.PacketDataPayload {
+	ip, err := packets.UnmarshalIncomingPacket(suite.chainA.App.AppCodec(), p)
+	suite.Require().NoError(err)
+	var payload packets.PacketDataPayload
+	if err := cdc.UnpackAny(ip.PacketData().GetPayload(), &payload); err != nil {
+		panic(err)
+	}
+	return payload
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
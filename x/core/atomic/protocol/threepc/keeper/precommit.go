@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	"github.com/datachainlab/cross/x/core/atomic/protocol/threepc/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+// SendPreCommit sends pre-commits to all participants after they have been prepared successfully.
+// The pre-commit packets time out at the deadline of the prepare phase, so a participant never receives a pre-commit after it has aborted the tx by itself.
+// caller is coordinator
+func (k Keeper) SendPreCommit(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	txID crosstypes.TxID,
+) error {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return fmt.Errorf("txID '%x' not found", txID)
+	} else if cs.Phase != atomictypes.COORDINATOR_PHASE_PREPARE {
+		return fmt.Errorf("coordinator status must be '%v'", atomictypes.COORDINATOR_PHASE_PREPARE.String())
+	} else if !cs.IsConfirmedALLPrepares() {
+		return fmt.Errorf("all transactions must be confirmed")
+	} else if cs.Decision != atomictypes.COORDINATOR_DECISION_UNKNOWN {
+		return fmt.Errorf("coordinator has already decided '%v'", cs.Decision.String())
+	}
+
+	prepareDeadline := cs.TimeoutTimestamp
+	deadline := prepareDeadline + k.phaseTimeout(ctx)
+	for id, c := range cs.Channels {
+		ch, found := k.ChannelKeeper().GetChannel(ctx, c.Port, c.Channel)
+		if !found {
+			return sdkerrors.Wrap(channeltypes.ErrChannelNotFound, c.Channel)
+		}
+		pd := types.NewPacketDataPreCommit(txID, crosstypes.TxIndex(id), deadline)
+		if err := k.SendPacket(
+			ctx,
+			packetSender,
+			pd,
+			c.Port,
+			c.Channel,
+			ch.GetCounterparty().GetPortID(),
+			ch.GetCounterparty().GetChannelID(),
+			clienttypes.ZeroHeight(),
+			prepareDeadline,
+		); err != nil {
+			return err
+		}
+	}
+	cs.Phase = atomictypes.COORDINATOR_PHASE_PRE_COMMIT
+	cs.TimeoutTimestamp = deadline
+	k.SetCoordinatorState(ctx, txID, *cs)
+	return nil
+}
+
+// ReceivePacketPreCommit pre-commits the prepared contract transaction and reschedules its termination at the deadline of the pre-commit phase.
+// caller is participant
+func (k Keeper) ReceivePacketPreCommit(
+	ctx sdk.Context,
+	destPort,
+	destChannel string,
+	data types.PacketDataPreCommit,
+) (*types.PacketAcknowledgementPreCommit, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	_, found := k.ChannelKeeper().GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, fmt.Errorf("channel not found: port=%v channel=%v", destPort, destChannel)
+	}
+	ci := &xcctypes.ChannelInfo{Channel: destChannel, Port: destPort}
+
+	txState, found := k.GetContractTransactionState(ctx, data.TxId, data.TxIndex)
+	if !found {
+		return nil, fmt.Errorf("(txID, txIndex) = ('%x', '%v') not found", data.TxId, data.TxIndex)
+	} else if !txState.CoordinatorChannel.Equal(ci) {
+		return nil, fmt.Errorf("expected CoordinatorChannel is %v, but got %v", txState.CoordinatorChannel, ci)
+	} else if txState.Status != atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE || txState.PrepareResult != atomictypes.PREPARE_RESULT_OK {
+		// NOTE: the participant may have already aborted the tx with the abort commit that arrives before the pre-commit
+		k.Logger(ctx).Info("failed to pre-commit", "txID", hex.EncodeToString(data.TxId), "status", txState.Status.String(), "result", txState.PrepareResult.String())
+		return types.NewPacketAcknowledgementPreCommit(types.COMMIT_STATUS_FAILED), nil
+	}
+
+	k.DeleteTxTermination(ctx, txState.TimeoutTimestamp, data.TxId, data.TxIndex)
+	txState.Status = atomictypes.CONTRACT_TRANSACTION_STATUS_PRE_COMMIT
+	txState.TimeoutTimestamp = data.TimeoutTimestamp
+	k.SetContractTransactionState(ctx, data.TxId, data.TxIndex, *txState)
+	k.SetTxTermination(ctx, txState.TimeoutTimestamp, data.TxId, data.TxIndex)
+
+	return types.NewPacketAcknowledgementPreCommit(types.COMMIT_STATUS_OK), nil
+}
+
+// HandlePacketAcknowledgementPreCommit handles an acknowledgement of the pre-commit packet.
+// If all participants are pre-committed, the coordinator decides to commit the tx.
+// If any participant fails to pre-commit, the coordinator decides to abort the tx.
+// caller is coordinator
+func (k Keeper) HandlePacketAcknowledgementPreCommit(
+	ctx sdk.Context,
+	ack types.PacketAcknowledgementPreCommit,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	if err := ack.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	} else if int(txIndex) >= len(cs.Channels) {
+		return nil, fmt.Errorf("txIndex '%v' not found", txIndex)
+	}
+
+	switch cs.Phase {
+	case atomictypes.COORDINATOR_PHASE_PRE_COMMIT:
+		switch ack.Status {
+		case types.COMMIT_STATUS_OK:
+			if !cs.AddAck(txIndex) {
+				return nil, fmt.Errorf("tx '%v' already exists", txIndex)
+			}
+			if cs.IsConfirmedALLCommits() {
+				// every participant will commit the tx even if the coordinator halts from now on
+				cs.Decision = atomictypes.COORDINATOR_DECISION_COMMIT
+				cs.Acks = nil
+				k.SetCoordinatorState(ctx, txID, *cs)
+				if err := k.SendCommit(ctx, ps, txID, true); err != nil {
+					return nil, err
+				}
+			} else {
+				k.SetCoordinatorState(ctx, txID, *cs)
+			}
+		case types.COMMIT_STATUS_FAILED:
+			if err := k.abortPreCommit(ctx, ps, txID, *cs, txIndex, atomictypes.ABORT_REASON_PRE_COMMIT_FAILED); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown commit status '%v'", ack.Status)
+		}
+	case atomictypes.COORDINATOR_PHASE_COMMIT, atomictypes.COORDINATOR_PHASE_COMPLETED:
+		// nop: the coordinator has already decided to abort the tx
+	default:
+		return nil, fmt.Errorf("unexpected phase %v", cs.Phase)
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// HandlePacketTimeoutPreCommit handles a timeout of the pre-commit packet.
+// The participant has aborted the tx by itself, so the coordinator decides to abort the tx.
+// caller is coordinator
+func (k Keeper) HandlePacketTimeoutPreCommit(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypePacketTimeout,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+		),
+	)
+
+	switch cs.Phase {
+	case atomictypes.COORDINATOR_PHASE_PRE_COMMIT:
+		if err := k.abortPreCommit(ctx, ps, txID, *cs, txIndex, atomictypes.ABORT_REASON_TIMEOUT); err != nil {
+			return nil, err
+		}
+	case atomictypes.COORDINATOR_PHASE_COMMIT, atomictypes.COORDINATOR_PHASE_COMPLETED:
+		// nop: the abort commit has already been sent to the participant
+	default:
+		return nil, fmt.Errorf("unexpected phase %v", cs.Phase)
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// HandlePacketErrorAcknowledgementPreCommit handles an error acknowledgement of the pre-commit packet.
+// The participant failed to handle the packet, so it is treated as COMMIT_STATUS_FAILED.
+// caller is coordinator
+func (k Keeper) HandlePacketErrorAcknowledgementPreCommit(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	errMsg string,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeErrorACK,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, errMsg),
+		),
+	)
+	return k.HandlePacketAcknowledgementPreCommit(
+		ctx,
+		*types.NewPacketAcknowledgementPreCommit(types.COMMIT_STATUS_FAILED),
+		txID, txIndex, ps,
+	)
+}
+
+// abortPreCommit decides to abort the tx in the pre-commit phase and sends abort commits to the participants.
+// The participant of txIndex hasn't been pre-committed, so it doesn't need an abort commit.
+func (k Keeper) abortPreCommit(
+	ctx sdk.Context,
+	ps packets.PacketSender,
+	txID crosstypes.TxID,
+	cs atomictypes.CoordinatorState,
+	txIndex crosstypes.TxIndex,
+	reason atomictypes.AbortReason,
+) error {
+	cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+	cs.AbortReason = reason
+	cs.Acks = []crosstypes.TxIndex{txIndex}
+	k.SetCoordinatorState(ctx, txID, cs)
+	return k.SendCommit(ctx, ps, txID, false)
+}
@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	"github.com/datachainlab/cross/x/core/atomic/protocol/threepc/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+// SendPrepare sends prepare packets to all participants.
+// The prepare packets time out at the deadline of the prepare phase, so a participant that has been prepared can abort the tx by itself at the same deadline.
+// caller is coordinator
+func (k Keeper) SendPrepare(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	txID crosstypes.TxID,
+	transactions []txtypes.ResolvedContractTransaction,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	params := k.GetParams(ctx)
+	if !params.IsAllowedCommitProtocol(txtypes.COMMIT_PROTOCOL_3PC) {
+		return fmt.Errorf("the commit protocol '%v' is not allowed", txtypes.COMMIT_PROTOCOL_3PC)
+	} else if len(transactions) == 0 {
+		return errors.New("the number of contract transactions must be greater than 1")
+	} else if err := params.ValidateResolvedContractTransactions(transactions); err != nil {
+		return err
+	} else if !timeoutHeight.IsZero() && uint64(ctx.BlockHeight()) >= timeoutHeight.GetRevisionHeight() {
+		return fmt.Errorf("the given timeoutHeight is in the past: current=%v timeout=%v", ctx.BlockHeight(), timeoutHeight.GetRevisionHeight())
+	} else if timeoutTimestamp != 0 && uint64(ctx.BlockTime().Unix()) >= timeoutTimestamp {
+		return fmt.Errorf("the given timeoutTimestamp is in the past: current=%v timeout=%v", ctx.BlockTime().Unix(), timeoutTimestamp)
+	} else if _, found := k.GetCoordinatorState(ctx, txID); found {
+		return fmt.Errorf("txID '%X' already exists", txID)
+	}
+
+	deadline := now(ctx) + k.phaseTimeout(ctx)
+	var channels []xcctypes.ChannelInfo
+	for i, tx := range transactions {
+		data := types.NewPacketDataPrepare(
+			txID,
+			tx,
+			crosstypes.TxIndex(i),
+			deadline,
+		)
+		xcc, err := tx.GetCrossChainChannel(k.cdc)
+		if err != nil {
+			return err
+		}
+		ci, err := k.xccResolver.ResolveCrossChainChannel(ctx, xcc)
+		if err != nil {
+			return err
+		}
+		ch, found := k.ChannelKeeper().GetChannel(ctx, ci.Port, ci.Channel)
+		if !found {
+			return sdkerrors.Wrap(channeltypes.ErrChannelNotFound, ci.String())
+		}
+		if err := k.SendPacket(
+			ctx,
+			packetSender,
+			&data,
+			ci.Port, ci.Channel, ch.Counterparty.PortId, ch.Counterparty.ChannelId,
+			// the timeout height of the tx is the height of our chain, so it isn't applied to the packet
+			clienttypes.ZeroHeight(), deadline,
+		); err != nil {
+			return err
+		}
+		channels = append(channels, *ci)
+	}
+
+	cs := atomictypes.NewCoordinatorState(
+		txtypes.COMMIT_PROTOCOL_3PC,
+		atomictypes.COORDINATOR_PHASE_PREPARE,
+		channels,
+	)
+	cs.TimeoutTimestamp = deadline
+	k.SetCoordinatorState(ctx, txID, cs)
+	return nil
+}
+
+// ReceivePacketPrepare prepares the contract transaction and schedules its termination at the deadline of the prepare phase.
+// caller is participant
+func (k Keeper) ReceivePacketPrepare(
+	ctx sdk.Context,
+	destPort,
+	destChannel string,
+	data types.PacketDataPrepare,
+) (*txtypes.ContractCallResult, *types.PacketAcknowledgementPrepare, error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+
+	_, found := k.ChannelKeeper().GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, nil, fmt.Errorf("channel(port=%v channel=%v) not found", destPort, destChannel)
+	}
+
+	if _, ok := k.GetContractTransactionState(ctx, data.TxId, data.TxIndex); ok {
+		return nil, nil, fmt.Errorf("txID '%x' already exists", data.TxId)
+	}
+
+	if err := k.GetParams(ctx).ValidateCallResults(len(data.Tx.CallResults)); err != nil {
+		return nil, nil, err
+	}
+
+	var prepareResult atomictypes.PrepareResult
+	res, err := k.cm.PrepareCommit(ctx, data.TxId, data.TxIndex, data.Tx)
+	if err != nil {
+		k.Logger(ctx).Info("failed to prepare a commit", "error", err.Error())
+		prepareResult = atomictypes.PREPARE_RESULT_FAILED
+	} else {
+		prepareResult = atomictypes.PREPARE_RESULT_OK
+	}
+
+	txState := atomictypes.NewContractTransactionState(
+		atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE,
+		prepareResult,
+		xcctypes.ChannelInfo{Channel: destChannel, Port: destPort},
+	)
+	txState.TimeoutTimestamp = data.TimeoutTimestamp
+	k.SetContractTransactionState(ctx, data.TxId, data.TxIndex, txState)
+	k.SetTxTermination(ctx, txState.TimeoutTimestamp, data.TxId, data.TxIndex)
+
+	return res, types.NewPacketAcknowledgementPrepare(prepareResult), nil
+}
+
+// HandlePacketAcknowledgementPrepare handles an acknowledgement of the prepare packet.
+// If all participants are prepared successfully, the coordinator sends pre-commits to them.
+// If any participant fails to prepare, the coordinator decides to abort the tx.
+// caller is coordinator
+func (k Keeper) HandlePacketAcknowledgementPrepare(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	ack types.PacketAcknowledgementPrepare,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	if err := ack.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	} else if cs.Phase == atomictypes.COORDINATOR_PHASE_UNKNOWN {
+		return nil, fmt.Errorf("coordinator status should not be '%v'", atomictypes.COORDINATOR_PHASE_UNKNOWN.String())
+	} else if cs.IsConfirmedALLPrepares() {
+		return nil, errors.New("all transactions are already confirmed")
+	}
+
+	_, found = k.ChannelKeeper().GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return nil, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, sourceChannel)
+	}
+
+	if err := cs.Confirm(txIndex, xcctypes.ChannelInfo{Port: sourcePort, Channel: sourceChannel}); err != nil {
+		return nil, err
+	}
+
+	var goPreCommit, goAbort bool
+	switch cs.Phase {
+	case atomictypes.COORDINATOR_PHASE_PREPARE:
+		switch ack.Result {
+		case atomictypes.PREPARE_RESULT_OK:
+			if !cs.IsConfirmedALLPrepares() {
+				// wait for more acks
+			} else if now(ctx) >= cs.TimeoutTimestamp {
+				// the participants may have already aborted the tx by themselves
+				cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+				cs.AbortReason = atomictypes.ABORT_REASON_TIMEOUT
+				goAbort = true
+			} else {
+				goPreCommit = true
+			}
+		case atomictypes.PREPARE_RESULT_FAILED:
+			cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+			cs.AbortReason = atomictypes.ABORT_REASON_PREPARE_FAILED
+			goAbort = true
+		default:
+			return nil, fmt.Errorf("unexpected result %v", ack.Result)
+		}
+	case atomictypes.COORDINATOR_PHASE_COMMIT, atomictypes.COORDINATOR_PHASE_COMPLETED:
+		// nop: the coordinator has already decided to abort the tx
+	default:
+		return nil, fmt.Errorf("unexpected phase %v", cs.Phase)
+	}
+	k.SetCoordinatorState(ctx, txID, *cs)
+
+	if goPreCommit {
+		if err := k.SendPreCommit(ctx, ps, txID); err != nil {
+			return nil, err
+		}
+	} else if goAbort {
+		if err := k.SendCommit(ctx, ps, txID, false); err != nil {
+			return nil, err
+		}
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// HandlePacketTimeoutPrepare handles a timeout of the prepare packet.
+// If the coordinator is still in the prepare phase, it decides to abort the tx and sends abort commits to the other participants.
+// caller is coordinator
+func (k Keeper) HandlePacketTimeoutPrepare(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypePacketTimeout,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+		),
+	)
+
+	switch cs.Phase {
+	case atomictypes.COORDINATOR_PHASE_PREPARE:
+		if err := cs.Confirm(txIndex, xcctypes.ChannelInfo{Port: sourcePort, Channel: sourceChannel}); err != nil {
+			return nil, err
+		}
+		// the participant never receives the prepare packet, so it doesn't need a commit packet
+		cs.AddAck(txIndex)
+		cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+		cs.AbortReason = atomictypes.ABORT_REASON_TIMEOUT
+		k.SetCoordinatorState(ctx, txID, *cs)
+		if err := k.SendCommit(ctx, ps, txID, false); err != nil {
+			return nil, err
+		}
+	case atomictypes.COORDINATOR_PHASE_COMMIT, atomictypes.COORDINATOR_PHASE_COMPLETED:
+		// nop: the abort commit has already been sent to the participant
+	default:
+		return nil, fmt.Errorf("unexpected phase %v", cs.Phase)
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// HandlePacketErrorAcknowledgementPrepare handles an error acknowledgement of the prepare packet.
+// The participant failed to handle the packet, so it is treated as PREPARE_RESULT_FAILED.
+// caller is coordinator
+func (k Keeper) HandlePacketErrorAcknowledgementPrepare(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	errMsg string,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeErrorACK,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, errMsg),
+		),
+	)
+	return k.HandlePacketAcknowledgementPrepare(
+		ctx,
+		sourcePort, sourceChannel,
+		*types.NewPacketAcknowledgementPrepare(atomictypes.PREPARE_RESULT_FAILED),
+		txID, txIndex, ps,
+	)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+)
+
+// TerminateTxs terminates the contract transactions whose deadline has passed without hearing from the coordinator.
+// A prepared transaction is aborted and a pre-committed transaction is committed.
+// It processes at most `limit` termination entries and returns the number of processed entries.
+// caller is participant
+func (k Keeper) TerminateTxs(ctx sdk.Context, limit int) int {
+	entries := k.GetTxTerminations(ctx, now(ctx), limit)
+	for _, e := range entries {
+		if err := k.terminateTx(ctx, e); err != nil {
+			// the participant can still finalize the tx with the commit packet, and the sweeper must not halt the chain
+			k.Logger(ctx).Error("failed to terminate a tx", "txID", hex.EncodeToString(e.TxID), "txIndex", e.TxIndex, "err", err)
+		}
+	}
+	return len(entries)
+}
+
+// terminateTx finalizes the contract transaction of a given entry.
+// The entry is removed only if the tx is finalized or the entry is stale.
+// If it fails to finalize the tx, the entry is re-inserted with the next deadline so that the sweeper retries it later without blocking the other entries.
+func (k Keeper) terminateTx(ctx sdk.Context, e atomictypes.TxTermination) error {
+	txState, found := k.GetContractTransactionState(ctx, e.TxID, e.TxIndex)
+	// NOTE: an entry whose deadline is earlier than the tx's one is stale because the deadline has been extended by the pre-commit
+	if !found || txState.TimeoutTimestamp > e.TimeoutTimestamp {
+		k.DeleteTxTermination(ctx, e.TimeoutTimestamp, e.TxID, e.TxIndex)
+		return nil
+	}
+
+	var decision atomictypes.CoordinatorDecision
+	switch txState.Status {
+	case atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE:
+		decision = atomictypes.COORDINATOR_DECISION_ABORT
+	case atomictypes.CONTRACT_TRANSACTION_STATUS_PRE_COMMIT:
+		decision = atomictypes.COORDINATOR_DECISION_COMMIT
+	default:
+		k.DeleteTxTermination(ctx, e.TimeoutTimestamp, e.TxID, e.TxIndex)
+		return nil
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	res, err := k.finalize(cacheCtx, e.TxID, e.TxIndex, decision == atomictypes.COORDINATOR_DECISION_COMMIT)
+	if err != nil {
+		k.DeleteTxTermination(ctx, e.TimeoutTimestamp, e.TxID, e.TxIndex)
+		k.SetTxTermination(ctx, now(ctx)+k.phaseTimeout(ctx), e.TxID, e.TxIndex)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				atomictypes.EventTypeCommitFailed,
+				sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(e.TxID)),
+				sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(e.TxIndex)),
+				sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, err.Error()),
+			),
+		)
+		return err
+	}
+	writeFn()
+	k.DeleteTxTermination(ctx, e.TimeoutTimestamp, e.TxID, e.TxIndex)
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	if res != nil {
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeTxTerminated,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(e.TxID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(e.TxIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyDecision, decision.String()),
+		),
+	)
+	return nil
+}
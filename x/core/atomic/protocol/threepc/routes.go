@@ -0,0 +1,187 @@
+package threepc
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	threepckeeper "github.com/datachainlab/cross/x/core/atomic/protocol/threepc/keeper"
+	"github.com/datachainlab/cross/x/core/atomic/protocol/threepc/types"
+	"github.com/datachainlab/cross/x/core/router"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+type PacketHandler struct {
+	packetMiddleware packets.PacketMiddleware
+
+	cdc    codec.Codec
+	keeper threepckeeper.Keeper
+}
+
+var _ router.PacketHandler = (*PacketHandler)(nil)
+
+func NewPacketHandler(cdc codec.Codec, k threepckeeper.Keeper, packetMiddleware packets.PacketMiddleware) PacketHandler {
+	return PacketHandler{cdc: cdc, keeper: k, packetMiddleware: packetMiddleware}
+}
+
+func (h PacketHandler) HandlePacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+) (*sdk.Result, *packets.PacketAcknowledgementData, error) {
+	ctx, _, as, err := h.packetMiddleware.HandlePacket(ctx, ip, packets.NewBasicPacketSender(h.keeper.ChannelKeeper()), packets.NewBasicACKSender())
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to handle request: %v", err)
+	}
+
+	var (
+		data []byte
+		ack  packets.OutgoingPacketAcknowledgement
+	)
+	switch payload := ip.Payload().(type) {
+	case *types.PacketDataPrepare:
+		res, ap, err := h.keeper.ReceivePacketPrepare(
+			ctx,
+			packet.DestinationPort, packet.DestinationChannel,
+			*payload,
+		)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceivePacketPrepare: %v", err)
+		}
+		ack = packets.NewOutgoingPacketAcknowledgement(nil, ap)
+		data = res.GetData()
+	case *types.PacketDataPreCommit:
+		ap, err := h.keeper.ReceivePacketPreCommit(
+			ctx,
+			packet.DestinationPort, packet.DestinationChannel,
+			*payload,
+		)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceivePacketPreCommit: %v", err)
+		}
+		ack = packets.NewOutgoingPacketAcknowledgement(nil, ap)
+	case *types.PacketDataCommit:
+		res, ap, err := h.keeper.ReceivePacketCommit(
+			ctx,
+			packet.DestinationPort, packet.DestinationChannel,
+			*payload,
+		)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceivePacketCommit: %v", err)
+		}
+		ack = packets.NewOutgoingPacketAcknowledgement(nil, ap)
+		if res != nil {
+			data = res.GetData()
+			ctx.EventManager().EmitEvents(res.GetEvents())
+		} else {
+			data = nil
+		}
+	default:
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized packet type: %T", payload)
+	}
+	if err = as.SendACK(ctx, ack); err != nil {
+		return nil, nil, err
+	}
+	ackData := ack.Data()
+	return &sdk.Result{Data: data, Events: ctx.EventManager().ABCIEvents()}, &ackData, nil
+}
+
+func (h PacketHandler) HandleACK(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+	ipa packets.IncomingPacketAcknowledgement,
+) (*sdk.Result, error) {
+	ctx, ps, err := h.packetMiddleware.HandleACK(ctx, ip, ipa, packets.NewBasicPacketSender(h.keeper.ChannelKeeper()))
+	if err != nil {
+		return nil, err
+	}
+
+	switch payload := ipa.Payload().(type) {
+	case *types.PacketAcknowledgementPrepare:
+		pd := ip.Payload().(*types.PacketDataPrepare)
+		return h.keeper.HandlePacketAcknowledgementPrepare(
+			ctx,
+			packet.SourcePort, packet.SourceChannel,
+			*payload, pd.TxId, pd.TxIndex, ps,
+		)
+	case *types.PacketAcknowledgementPreCommit:
+		pd := ip.Payload().(*types.PacketDataPreCommit)
+		return h.keeper.HandlePacketAcknowledgementPreCommit(
+			ctx,
+			*payload, pd.TxId, pd.TxIndex, ps,
+		)
+	case *types.PacketAcknowledgementCommit:
+		pd := ip.Payload().(*types.PacketDataCommit)
+		if err := h.keeper.ReceiveCommitAcknowledgement(ctx, pd.TxId, pd.TxIndex, *payload); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceiveCommitAcknowledgement: %v", err)
+		}
+		bz := h.cdc.MustMarshalJSON(payload)
+		return &sdk.Result{Data: bz, Events: ctx.EventManager().ABCIEvents()}, nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ack type: %T", payload)
+	}
+}
+
+func (h PacketHandler) HandleErrorACK(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+	errMsg string,
+) (*sdk.Result, error) {
+	switch payload := ip.Payload().(type) {
+	case *types.PacketDataPrepare:
+		return h.keeper.HandlePacketErrorAcknowledgementPrepare(
+			ctx,
+			packet.SourcePort, packet.SourceChannel,
+			payload.TxId, payload.TxIndex, errMsg,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	case *types.PacketDataPreCommit:
+		return h.keeper.HandlePacketErrorAcknowledgementPreCommit(
+			ctx,
+			payload.TxId, payload.TxIndex, errMsg,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	case *types.PacketDataCommit:
+		// the participant failed to handle the commit packet, so it is recorded as a commit failure
+		ack := types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_FAILED)
+		ack.ErrorMessage = errMsg
+		if err := h.keeper.ReceiveCommitAcknowledgement(ctx, payload.TxId, payload.TxIndex, *ack); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceiveCommitAcknowledgement: %v", err)
+		}
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected packet type: %T", payload)
+	}
+}
+
+func (h PacketHandler) HandleTimeout(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+) (*sdk.Result, error) {
+	switch payload := ip.Payload().(type) {
+	case *types.PacketDataPrepare:
+		return h.keeper.HandlePacketTimeoutPrepare(
+			ctx,
+			packet.SourcePort, packet.SourceChannel,
+			payload.TxId, payload.TxIndex,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	case *types.PacketDataPreCommit:
+		return h.keeper.HandlePacketTimeoutPreCommit(
+			ctx,
+			payload.TxId, payload.TxIndex,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	case *types.PacketDataCommit:
+		if err := h.keeper.HandlePacketTimeoutCommit(ctx, payload.TxId, payload.TxIndex); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to HandlePacketTimeoutCommit: %v", err)
+		}
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected timeout packet type: %T", payload)
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+// RegisterInterfaces register the three-phase commit packet payloads to protobuf
+// Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*packets.PacketDataPayload)(nil),
+		&PacketDataPrepare{},
+		&PacketDataPreCommit{},
+		&PacketDataCommit{},
+	)
+	registry.RegisterImplementations(
+		(*packets.PacketAcknowledgementPayload)(nil),
+		&PacketAcknowledgementPrepare{},
+		&PacketAcknowledgementPreCommit{},
+		&PacketAcknowledgementCommit{},
+	)
+}
+
+var (
+	// ModuleCdc references the global codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
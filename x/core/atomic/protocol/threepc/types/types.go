@@ -0,0 +1,124 @@
+package types
+
+import (
+	"errors"
+
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+const (
+	PacketType = "cross/core/atomic/threepc"
+)
+
+var _ packets.PacketDataPayload = (*PacketDataPrepare)(nil)
+
+// NewPacketDataPrepare creates a new instance of PacketDataPrepare
+func NewPacketDataPrepare(
+	txID crosstypes.TxID,
+	tx txtypes.ResolvedContractTransaction,
+	txIndex crosstypes.TxIndex,
+	timeoutTimestamp uint64,
+) PacketDataPrepare {
+	return PacketDataPrepare{TxId: txID, TxIndex: txIndex, Tx: tx, TimeoutTimestamp: timeoutTimestamp}
+}
+
+func (p PacketDataPrepare) ValidateBasic() error {
+	if err := p.Tx.ValidateBasic(); err != nil {
+		return err
+	} else if p.TimeoutTimestamp == 0 {
+		return errors.New("timeout timestamp must not be zero")
+	}
+	return nil
+}
+
+func (PacketDataPrepare) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketAcknowledgementPayload = (*PacketAcknowledgementPrepare)(nil)
+
+func NewPacketAcknowledgementPrepare(
+	result atomictypes.PrepareResult,
+) *PacketAcknowledgementPrepare {
+	return &PacketAcknowledgementPrepare{
+		Result: result,
+	}
+}
+
+func (a PacketAcknowledgementPrepare) ValidateBasic() error {
+	return nil
+}
+
+func (PacketAcknowledgementPrepare) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketDataPayload = (*PacketDataPreCommit)(nil)
+
+func NewPacketDataPreCommit(txID crosstypes.TxID, txIndex crosstypes.TxIndex, timeoutTimestamp uint64) *PacketDataPreCommit {
+	return &PacketDataPreCommit{
+		TxId:             txID,
+		TxIndex:          txIndex,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (p PacketDataPreCommit) ValidateBasic() error {
+	if p.TimeoutTimestamp == 0 {
+		return errors.New("timeout timestamp must not be zero")
+	}
+	return nil
+}
+
+func (PacketDataPreCommit) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketAcknowledgementPayload = (*PacketAcknowledgementPreCommit)(nil)
+
+func NewPacketAcknowledgementPreCommit(status CommitStatus) *PacketAcknowledgementPreCommit {
+	return &PacketAcknowledgementPreCommit{Status: status}
+}
+
+func (PacketAcknowledgementPreCommit) ValidateBasic() error {
+	return nil
+}
+
+func (PacketAcknowledgementPreCommit) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketDataPayload = (*PacketDataCommit)(nil)
+
+func NewPacketDataCommit(txID crosstypes.TxID, txIndex crosstypes.TxIndex, isCommittable bool) *PacketDataCommit {
+	return &PacketDataCommit{
+		TxId:          txID,
+		TxIndex:       txIndex,
+		IsCommittable: isCommittable,
+	}
+}
+
+func (PacketDataCommit) ValidateBasic() error {
+	return nil
+}
+
+func (PacketDataCommit) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketAcknowledgementPayload = (*PacketAcknowledgementCommit)(nil)
+
+func NewPacketAcknowledgementCommit(status CommitStatus) *PacketAcknowledgementCommit {
+	return &PacketAcknowledgementCommit{Status: status}
+}
+
+func (PacketAcknowledgementCommit) ValidateBasic() error {
+	return nil
+}
+
+func (PacketAcknowledgementCommit) Type() string {
+	return PacketType
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math"
 
@@ -38,6 +39,10 @@ func (k Keeper) SendCommit(
 		math.MaxUint64,
 	)
 	for id, c := range cs.Channels {
+		if cs.HasAck(crosstypes.TxIndex(id)) {
+			// skip the participant that doesn't need a commit
+			continue
+		}
 		ch, found := k.ChannelKeeper().GetChannel(ctx, c.Port, c.Channel)
 		if !found {
 			return sdkerrors.Wrap(channeltypes.ErrChannelNotFound, c.Channel)
@@ -70,6 +75,29 @@ func (k Keeper) ReceivePacketCommit(
 ) (*txtypes.ContractCallResult, *types.PacketAcknowledgementCommit, error) {
 	// Validations
 
+	_, found := k.ChannelKeeper().GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, nil, fmt.Errorf("channel not found: port=%v channel=%v", destPort, destChannel)
+	}
+	ci := &xcctypes.ChannelInfo{Channel: destChannel, Port: destPort}
+
+	// NOTE: the abort commit may arrive before the prepare packet if the coordinator has aborted the tx due to a timeout.
+	// In this case, the participant records the abort to reject the prepare packet that arrives later.
+	if _, found := k.GetContractTransactionState(ctx, data.TxId, data.TxIndex); !found && !data.IsCommittable {
+		k.SetContractTransactionState(
+			ctx, data.TxId, data.TxIndex,
+			atomictypes.NewContractTransactionState(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, atomictypes.PREPARE_RESULT_UNKNOWN, *ci),
+		)
+		return nil, types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_OK), nil
+	}
+
+	// NOTE: the participant may have already finalized the tx with the decision that it queried to the coordinator
+	if txState, found := k.GetContractTransactionState(ctx, data.TxId, data.TxIndex); found && txState.CoordinatorChannel.Equal(ci) &&
+		((data.IsCommittable && txState.Status == atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT) ||
+			(!data.IsCommittable && txState.Status == atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT)) {
+		return nil, types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_OK), nil
+	}
+
 	txState, err := k.EnsureContractTransactionStatus(
 		ctx,
 		data.TxId, data.TxIndex,
@@ -78,39 +106,31 @@ func (k Keeper) ReceivePacketCommit(
 	if err != nil {
 		return nil, nil, err
 	}
-	_, found := k.ChannelKeeper().GetChannel(ctx, destPort, destChannel)
-	if !found {
-		return nil, nil, fmt.Errorf("channel not found: port=%v channel=%v", destPort, destChannel)
-	}
-
-	ci := &xcctypes.ChannelInfo{Channel: destChannel, Port: destPort}
 	if !txState.CoordinatorChannel.Equal(ci) {
 		return nil, nil, fmt.Errorf("expected CoordinatorChannel is %v, but got %v", txState.CoordinatorChannel, ci)
 	}
 
 	// Try to Commit or Abort
 
-	if data.IsCommittable {
-		res, err := k.cm.Commit(ctx, data.TxId, data.TxIndex)
-		if err != nil {
-			ack := types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_FAILED)
-			ack.ErrorMessage = err.Error()
-			return nil, ack, nil
-		}
-		return res, types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_OK), nil
-	} else {
-		err := k.cm.Abort(ctx, data.TxId, data.TxIndex)
-		if err != nil {
+	res, err := k.finalize(ctx, data.TxId, data.TxIndex, data.IsCommittable)
+	if err != nil {
+		if !data.IsCommittable {
 			return nil, nil, err
 		}
-		return nil, types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_OK), nil
+		ack := types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_FAILED)
+		ack.ErrorMessage = err.Error()
+		return nil, ack, nil
 	}
+	return res, types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_OK), nil
 }
 
+// ReceiveCommitAcknowledgement records the acknowledgement of the commit from the participant.
+// If all acknowledgements are received, the coordinator phase transitions to COMPLETED.
 func (k Keeper) ReceiveCommitAcknowledgement(
 	ctx sdk.Context,
 	txID crosstypes.TxID,
 	txIndex crosstypes.TxIndex,
+	ack types.PacketAcknowledgementCommit,
 ) error {
 	cs, found := k.GetCoordinatorState(ctx, txID)
 	if !found {
@@ -125,6 +145,33 @@ func (k Keeper) ReceiveCommitAcknowledgement(
 		return fmt.Errorf("tx '%v' already exists", txIndex)
 	}
 
+	switch ack.Status {
+	case types.COMMIT_STATUS_OK:
+	case types.COMMIT_STATUS_FAILED:
+		cs.AddCommitFailure(txIndex, ack.ErrorMessage)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				atomictypes.EventTypeCommitFailed,
+				sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+				sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+				sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, ack.ErrorMessage),
+			),
+		)
+	default:
+		return fmt.Errorf("unknown commit status '%v'", ack.Status)
+	}
+
+	if cs.IsConfirmedALLCommits() {
+		cs.Phase = atomictypes.COORDINATOR_PHASE_COMPLETED
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				atomictypes.EventTypeTxCompleted,
+				sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+				sdk.NewAttribute(atomictypes.AttributeKeyDecision, cs.Decision.String()),
+			),
+		)
+	}
+
 	k.SetCoordinatorState(ctx, txID, *cs)
 	return nil
 }
@@ -1,15 +1,19 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
-	"github.com/cosmos/ibc-go/modules/core/exported"
 	"github.com/stretchr/testify/suite"
 
 	samplemodtypes "github.com/datachainlab/cross/simapp/samplemod/types"
@@ -17,6 +21,7 @@ import (
 	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
 	crosstypes "github.com/datachainlab/cross/x/core/types"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 	ibctesting "github.com/datachainlab/cross/x/ibc/testing"
@@ -48,21 +53,8 @@ func (suite *KeeperTestSuite) SetupTest() {
 }
 
 func (suite *KeeperTestSuite) TestTransaction() {
-	// setup:
-	// A(coordinator) => B(participant) -> Connection: AB, BA, Channel: AB, AB
-	// A(coordinator) => C(participant) -> Connection: AC, CA, Channel: AC, AC
-
-	_, _, connAB, connBA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
-	channelAB, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connAB, connBA, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
-	chAB := xcctypes.ChannelInfo{Port: channelAB.PortID, Channel: channelAB.ID}
-	xccB, err := xcctypes.PackCrossChainChannel(&chAB)
-	suite.Require().NoError(err)
-
-	_, _, connAC, connCA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainC, exported.Tendermint, ibctesting.CrossVersion)
-	channelAC, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainC, connAC, connCA, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
-	chAC := xcctypes.ChannelInfo{Port: channelAC.PortID, Channel: channelAC.ID}
-	xccC, err := xcctypes.PackCrossChainChannel(&chAC)
-	suite.Require().NoError(err)
+	chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+	chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
 
 	var cases = []struct {
 		name                          string
@@ -73,20 +65,8 @@ func (suite *KeeperTestSuite) TestTransaction() {
 		{
 			"case0",
 			[2]initiatortypes.ContractTransaction{
-				{
-					CrossChainChannel: xccB,
-					Signers: []authtypes.Account{
-						authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
-					},
-					CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec()),
-				},
-				{
-					CrossChainChannel: xccC,
-					Signers: []authtypes.Account{
-						authtypes.NewAccount(authtypes.AccountID(suite.chainC.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccC)),
-					},
-					CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec()),
-				},
+				chB.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec())),
+				chC.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec())),
 			},
 			[2]atomictypes.PrepareResult{atomictypes.PREPARE_RESULT_OK, atomictypes.PREPARE_RESULT_OK},
 			[2]atomictypes.CoordinatorDecision{atomictypes.COORDINATOR_DECISION_UNKNOWN, atomictypes.COORDINATOR_DECISION_COMMIT},
@@ -94,20 +74,8 @@ func (suite *KeeperTestSuite) TestTransaction() {
 		{
 			"case1",
 			[2]initiatortypes.ContractTransaction{
-				{
-					CrossChainChannel: xccB,
-					Signers: []authtypes.Account{
-						authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
-					},
-					CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec()),
-				},
-				{
-					CrossChainChannel: xccC,
-					Signers: []authtypes.Account{
-						authtypes.NewAccount(authtypes.AccountID(suite.chainC.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccC)),
-					},
-					CallInfo: samplemodtypes.NewContractCallRequest("fail").ContractCallInfo(suite.chainC.App.AppCodec()),
-				},
+				chB.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec())),
+				chC.ContractTransaction(samplemodtypes.NewContractCallRequest("fail").ContractCallInfo(suite.chainC.App.AppCodec())),
 			},
 			[2]atomictypes.PrepareResult{atomictypes.PREPARE_RESULT_OK, atomictypes.PREPARE_RESULT_FAILED},
 			[2]atomictypes.CoordinatorDecision{atomictypes.COORDINATOR_DECISION_UNKNOWN, atomictypes.COORDINATOR_DECISION_ABORT},
@@ -115,20 +83,8 @@ func (suite *KeeperTestSuite) TestTransaction() {
 		{
 			"case2",
 			[2]initiatortypes.ContractTransaction{
-				{
-					CrossChainChannel: xccB,
-					Signers: []authtypes.Account{
-						authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
-					},
-					CallInfo: samplemodtypes.NewContractCallRequest("fail").ContractCallInfo(suite.chainB.App.AppCodec()),
-				},
-				{
-					CrossChainChannel: xccC,
-					Signers: []authtypes.Account{
-						authtypes.NewAccount(authtypes.AccountID(suite.chainC.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccC)),
-					},
-					CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec()),
-				},
+				chB.ContractTransaction(samplemodtypes.NewContractCallRequest("fail").ContractCallInfo(suite.chainB.App.AppCodec())),
+				chC.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec())),
 			},
 			[2]atomictypes.PrepareResult{atomictypes.PREPARE_RESULT_FAILED, atomictypes.PREPARE_RESULT_OK},
 			[2]atomictypes.CoordinatorDecision{atomictypes.COORDINATOR_DECISION_ABORT, atomictypes.COORDINATOR_DECISION_ABORT},
@@ -136,20 +92,8 @@ func (suite *KeeperTestSuite) TestTransaction() {
 		{
 			"case3",
 			[2]initiatortypes.ContractTransaction{
-				{
-					CrossChainChannel: xccB,
-					Signers: []authtypes.Account{
-						authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
-					},
-					CallInfo: samplemodtypes.NewContractCallRequest("fail").ContractCallInfo(suite.chainB.App.AppCodec()),
-				},
-				{
-					CrossChainChannel: xccC,
-					Signers: []authtypes.Account{
-						authtypes.NewAccount(authtypes.AccountID(suite.chainC.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccC)),
-					},
-					CallInfo: samplemodtypes.NewContractCallRequest("fail").ContractCallInfo(suite.chainC.App.AppCodec()),
-				},
+				chB.ContractTransaction(samplemodtypes.NewContractCallRequest("fail").ContractCallInfo(suite.chainB.App.AppCodec())),
+				chC.ContractTransaction(samplemodtypes.NewContractCallRequest("fail").ContractCallInfo(suite.chainC.App.AppCodec())),
 			},
 			[2]atomictypes.PrepareResult{atomictypes.PREPARE_RESULT_FAILED, atomictypes.PREPARE_RESULT_FAILED},
 			[2]atomictypes.CoordinatorDecision{atomictypes.COORDINATOR_DECISION_ABORT, atomictypes.COORDINATOR_DECISION_ABORT},
@@ -293,6 +237,15 @@ func (suite *KeeperTestSuite) TestTransaction() {
 			suite.Require().NoError(err)
 			suite.Require().Equal(types.COMMIT_STATUS_OK, commitAckB.Status)
 			suite.chainB.NextBlock()
+			{
+				ctxs, found := kB.GetContractTransactionState(suite.chainB.GetContext(), txID, 0)
+				suite.Require().True(found)
+				if c.coordinatorDecisionTransition[1] == atomictypes.COORDINATOR_DECISION_COMMIT {
+					suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, ctxs.Status)
+				} else {
+					suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, ctxs.Status)
+				}
+			}
 
 			_, commitAckC, err := kC.ReceivePacketCommit(
 				suite.chainC.GetContext(),
@@ -309,25 +262,612 @@ func (suite *KeeperTestSuite) TestTransaction() {
 					suite.chainA.GetContext(),
 					txID,
 					0,
+					*commitAckB,
 				),
 			)
+			{
+				cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+				suite.Require().True(found)
+				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+				suite.Require().False(cs.IsConfirmedALLCommits())
+			}
 			suite.Require().NoError(
 				kA.ReceiveCommitAcknowledgement(
 					suite.chainA.GetContext(),
 					txID,
 					1,
+					*commitAckC,
 				),
 			)
 			// check if coordinator state is expected
 			{
 				cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
 				suite.Require().True(found)
-				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+				suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
 				suite.Require().Equal(c.coordinatorDecisionTransition[1], cs.Decision)
 				suite.Require().True(cs.IsConfirmedALLCommits())
+				suite.Require().Empty(cs.CommitFailures)
+			}
+			// check if duplicated acknowledgement is failed
+			suite.Require().Error(
+				kA.ReceiveCommitAcknowledgement(
+					suite.chainA.GetContext(),
+					txID,
+					1,
+					*commitAckC,
+				),
+			)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPrepareTimeout() {
+	chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+	chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
+
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+		suite.chainA.GetContext(),
+		[]initiatortypes.ContractTransaction{
+			chB.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec())),
+			chC.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec())),
+		},
+	)
+	suite.Require().NoError(err)
+
+	txID := []byte("txid-timeout")
+	kA := suite.chainA.App.AtomicKeeper.TPCKeeper()
+	kB := suite.chainB.App.AtomicKeeper.TPCKeeper()
+	kC := suite.chainC.App.AtomicKeeper.TPCKeeper()
+
+	timeoutHeight := clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100)
+	timeoutTimestamp := uint64(suite.chainA.CurrentHeader.Time.Unix()) + 100
+	ps := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	suite.Require().NoError(
+		kA.SendPrepare(suite.chainA.GetContext(), ps, txID, txs, timeoutHeight, timeoutTimestamp),
+	)
+	suite.chainA.NextBlock()
+
+	// check if the packets have the timeout timestamp of the tx in nanoseconds, but not the timeout height of the coordinator chain
+	suite.Require().Equal(2, len(ps.Packets()))
+	p0, p1 := ps.Packets()[0], ps.Packets()[1]
+	for _, p := range []packets.OutgoingPacket{p0, p1} {
+		suite.Require().True(p.GetTimeoutHeight().IsZero())
+		suite.Require().Equal(timeoutTimestamp*uint64(time.Second), p.GetTimeoutTimestamp())
+	}
+
+	// chainB prepares the tx, but the prepare packet to chainC is timed out
+	prepareB := *suite.parsePacketToPacketDataPrepare(suite.chainB.App.AppCodec(), p0).(*types.PacketDataPrepare)
+	_, prepareAckB, err := kB.ReceivePacketPrepare(
+		suite.chainB.GetContext(), p0.GetDestPort(), p0.GetDestChannel(), prepareB,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(atomictypes.PREPARE_RESULT_OK, prepareAckB.Result)
+	suite.chainB.NextBlock()
+
+	_, err = kA.HandlePacketAcknowledgementPrepare(
+		suite.chainA.GetContext(),
+		p0.GetSourcePort(), p0.GetSourceChannel(),
+		*prepareAckB, txID, 0, ps,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, len(ps.Packets()))
+	suite.chainA.NextBlock()
+
+	ps1 := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	_, err = kA.HandlePacketTimeoutPrepare(
+		suite.chainA.GetContext(),
+		p1.GetSourcePort(), p1.GetSourceChannel(),
+		txID, 1, ps1,
+	)
+	suite.Require().NoError(err)
+	suite.chainA.NextBlock()
+	{
+		cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+		suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+		suite.Require().Equal(atomictypes.ABORT_REASON_TIMEOUT, cs.AbortReason)
+	}
+
+	// check if an abort commit is sent to chainB only
+	suite.Require().Equal(1, len(ps1.Packets()))
+	commitB := *suite.parsePacketToPacketDataPrepare(suite.chainB.App.AppCodec(), ps1.Packets()[0]).(*types.PacketDataCommit)
+	suite.Require().Equal(crosstypes.TxIndex(0), commitB.TxIndex)
+	suite.Require().False(commitB.IsCommittable)
+
+	_, commitAckB, err := kB.ReceivePacketCommit(
+		suite.chainB.GetContext(),
+		ps1.Packets()[0].GetDestPort(), ps1.Packets()[0].GetDestChannel(),
+		commitB,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.COMMIT_STATUS_OK, commitAckB.Status)
+	suite.chainB.NextBlock()
+	{
+		ctxs, found := kB.GetContractTransactionState(suite.chainB.GetContext(), txID, 0)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, ctxs.Status)
+	}
+
+	suite.Require().NoError(kA.ReceiveCommitAcknowledgement(suite.chainA.GetContext(), txID, 0, *commitAckB))
+	{
+		cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+	}
+
+	// check if an abort commit that arrives before the prepare is recorded on the participant
+	{
+		commitC := types.NewPacketDataCommit(txID, 1, false)
+		_, ack, err := kC.ReceivePacketCommit(suite.chainC.GetContext(), p1.GetDestPort(), p1.GetDestChannel(), *commitC)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.COMMIT_STATUS_OK, ack.Status)
+
+		prepareC := *suite.parsePacketToPacketDataPrepare(suite.chainC.App.AppCodec(), p1).(*types.PacketDataPrepare)
+		_, _, err = kC.ReceivePacketPrepare(suite.chainC.GetContext(), p1.GetDestPort(), p1.GetDestChannel(), prepareC)
+		suite.Require().Error(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestPrepareErrorAcknowledgement() {
+	chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+	chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
+
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+		suite.chainA.GetContext(),
+		[]initiatortypes.ContractTransaction{
+			chB.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec())),
+			chC.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec())),
+		},
+	)
+	suite.Require().NoError(err)
+
+	txID := []byte("txid-error-ack")
+	kA := suite.chainA.App.AtomicKeeper.TPCKeeper()
+
+	ps := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	suite.Require().NoError(
+		kA.SendPrepare(suite.chainA.GetContext(), ps, txID, txs, clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100), 0),
+	)
+	suite.chainA.NextBlock()
+	suite.Require().Equal(2, len(ps.Packets()))
+	p1 := ps.Packets()[1]
+
+	// chainC returns an error acknowledgement for the prepare packet
+	ps1 := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	ctx := suite.chainA.GetContext()
+	_, err = kA.HandlePacketErrorAcknowledgementPrepare(
+		ctx,
+		p1.GetSourcePort(), p1.GetSourceChannel(),
+		txID, 1, "malformed packet", ps1,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent(
+		atomictypes.EventTypeErrorACK,
+		sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+		sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, "1"),
+		sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, "malformed packet"),
+	))
+	suite.chainA.NextBlock()
+
+	cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+	suite.Require().Equal(atomictypes.ABORT_REASON_PREPARE_FAILED, cs.AbortReason)
+
+	// check if abort commits are sent to the participants
+	suite.Require().Equal(2, len(ps1.Packets()))
+	for _, p := range ps1.Packets() {
+		commit := *suite.parsePacketToPacketDataPrepare(suite.chainA.App.AppCodec(), p).(*types.PacketDataCommit)
+		suite.Require().False(commit.IsCommittable)
+	}
+}
+
+func (suite *KeeperTestSuite) TestReceiveCommitAcknowledgement() {
+	txID := []byte("txid-0")
+	kA := suite.chainA.App.AtomicKeeper.TPCKeeper()
+	cs := atomictypes.NewCoordinatorState(
+		txtypes.COMMIT_PROTOCOL_TPC,
+		atomictypes.COORDINATOR_PHASE_COMMIT,
+		[]xcctypes.ChannelInfo{{Port: "port0", Channel: "channel0"}, {Port: "port1", Channel: "channel1"}},
+	)
+	cs.Decision = atomictypes.COORDINATOR_DECISION_COMMIT
+	kA.SetCoordinatorState(suite.chainA.GetContext(), txID, cs)
+
+	ctx := suite.chainA.GetContext()
+	failed := types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_FAILED)
+	failed.ErrorMessage = "failed to commit"
+	suite.Require().NoError(kA.ReceiveCommitAcknowledgement(ctx, txID, 0, *failed))
+	suite.Require().Len(ctx.EventManager().Events(), 1)
+	suite.Require().Equal(atomictypes.EventTypeCommitFailed, ctx.EventManager().Events()[0].Type)
+
+	suite.Require().NoError(kA.ReceiveCommitAcknowledgement(ctx, txID, 1, *types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_OK)))
+	suite.Require().Len(ctx.EventManager().Events(), 2)
+	suite.Require().Equal(atomictypes.EventTypeTxCompleted, ctx.EventManager().Events()[1].Type)
+
+	res, err := suite.chainA.App.AtomicKeeper.TxFinalization(
+		sdk.WrapSDKContext(ctx),
+		&atomictypes.QueryTxFinalizationRequest{TxId: txID},
+	)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Completed)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_COMMIT, res.Decision)
+	suite.Require().Equal([]atomictypes.CommitFailure{{TxIndex: 0, ErrorMessage: "failed to commit"}}, res.CommitFailures)
+}
+
+func (suite *KeeperTestSuite) TestResolveTx() {
+	chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+	chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
+
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+		suite.chainA.GetContext(),
+		[]initiatortypes.ContractTransaction{
+			chB.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec())),
+			chC.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec())),
+		},
+	)
+	suite.Require().NoError(err)
+
+	txID := []byte("txid-resolve")
+	kA := suite.chainA.App.AtomicKeeper.TPCKeeper()
+	kB := suite.chainB.App.AtomicKeeper.TPCKeeper()
+	kC := suite.chainC.App.AtomicKeeper.TPCKeeper()
+
+	ps := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	suite.Require().NoError(
+		kA.SendPrepare(suite.chainA.GetContext(), ps, txID, txs, clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100), 0),
+	)
+	suite.chainA.NextBlock()
+	suite.Require().Equal(2, len(ps.Packets()))
+	p0, p1 := ps.Packets()[0], ps.Packets()[1]
+
+	// chainC prepares the tx, but the acknowledgement isn't relayed
+	prepareC := *suite.parsePacketToPacketDataPrepare(suite.chainC.App.AppCodec(), p1).(*types.PacketDataPrepare)
+	_, prepareAckC, err := kC.ReceivePacketPrepare(suite.chainC.GetContext(), p1.GetDestPort(), p1.GetDestChannel(), prepareC)
+	suite.Require().NoError(err)
+	suite.Require().Equal(atomictypes.PREPARE_RESULT_OK, prepareAckC.Result)
+	suite.chainC.NextBlock()
+
+	// the coordinator hasn't decided yet
+	psC := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainC.App.IBCKeeper.ChannelKeeper),
+	)
+	suite.Require().NoError(kC.SendQueryDecision(suite.chainC.GetContext(), psC, txID, 1, clienttypes.NewHeight(0, uint64(suite.chainC.CurrentHeader.Height)+100), 0))
+	suite.chainC.NextBlock()
+	suite.Require().Equal(1, len(psC.Packets()))
+	q0 := psC.Packets()[0]
+	query := *suite.parsePacketToPacketDataPrepare(suite.chainA.App.AppCodec(), q0).(*types.PacketDataQueryDecision)
+	queryAck, err := kA.ReceivePacketQueryDecision(suite.chainA.GetContext(), q0.GetDestPort(), q0.GetDestChannel(), query)
+	suite.Require().NoError(err)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_UNKNOWN, queryAck.Decision)
+	_, err = kC.HandlePacketAcknowledgementQueryDecision(suite.chainC.GetContext(), txID, 1, *queryAck)
+	suite.Require().NoError(err)
+	{
+		ctxs, found := kC.GetContractTransactionState(suite.chainC.GetContext(), txID, 1)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE, ctxs.Status)
+	}
+
+	// a query from the other channel is rejected
+	_, err = kA.ReceivePacketQueryDecision(suite.chainA.GetContext(), p0.GetSourcePort(), p0.GetSourceChannel(), query)
+	suite.Require().Error(err)
+
+	// only the authority can resolve the tx
+	_, err = suite.chainA.App.AtomicKeeper.ResolveTx(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		atomictypes.NewMsgResolveTx(suite.chainA.SenderAccount.GetAddress(), txID),
+	)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the authority forces the coordinator to abort the tx
+	ps1 := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	suite.Require().NoError(kA.ResolveTx(suite.chainA.GetContext(), ps1, txID))
+	suite.chainA.NextBlock()
+	{
+		cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+		suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+		suite.Require().Equal(atomictypes.ABORT_REASON_MANUAL, cs.AbortReason)
+	}
+	suite.Require().Error(kA.ResolveTx(suite.chainA.GetContext(), ps1, txID))
+	suite.Require().Equal(2, len(ps1.Packets()))
+
+	// chainB receives the abort commit before the prepare packet
+	commitB := *suite.parsePacketToPacketDataPrepare(suite.chainB.App.AppCodec(), ps1.Packets()[0]).(*types.PacketDataCommit)
+	suite.Require().False(commitB.IsCommittable)
+	_, commitAckB, err := kB.ReceivePacketCommit(suite.chainB.GetContext(), ps1.Packets()[0].GetDestPort(), ps1.Packets()[0].GetDestChannel(), commitB)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.COMMIT_STATUS_OK, commitAckB.Status)
+	suite.chainB.NextBlock()
+
+	// chainC queries the decision again and aborts the tx
+	query = *suite.parsePacketToPacketDataPrepare(suite.chainA.App.AppCodec(), q0).(*types.PacketDataQueryDecision)
+	queryAck, err = kA.ReceivePacketQueryDecision(suite.chainA.GetContext(), q0.GetDestPort(), q0.GetDestChannel(), query)
+	suite.Require().NoError(err)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, queryAck.Decision)
+	_, err = kC.HandlePacketAcknowledgementQueryDecision(suite.chainC.GetContext(), txID, 1, *queryAck)
+	suite.Require().NoError(err)
+	suite.chainC.NextBlock()
+	{
+		ctxs, found := kC.GetContractTransactionState(suite.chainC.GetContext(), txID, 1)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, ctxs.Status)
+	}
+	// the query is rejected after the tx is finalized
+	suite.Require().Error(kC.SendQueryDecision(suite.chainC.GetContext(), psC, txID, 1, clienttypes.NewHeight(0, uint64(suite.chainC.CurrentHeader.Height)+100), 0))
+
+	// the abort commit that arrives later is acknowledged successfully
+	commitC := *suite.parsePacketToPacketDataPrepare(suite.chainC.App.AppCodec(), ps1.Packets()[1]).(*types.PacketDataCommit)
+	_, commitAckC, err := kC.ReceivePacketCommit(suite.chainC.GetContext(), ps1.Packets()[1].GetDestPort(), ps1.Packets()[1].GetDestChannel(), commitC)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.COMMIT_STATUS_OK, commitAckC.Status)
+
+	suite.Require().NoError(kA.ReceiveCommitAcknowledgement(suite.chainA.GetContext(), txID, 0, *commitAckB))
+	suite.Require().NoError(kA.ReceiveCommitAcknowledgement(suite.chainA.GetContext(), txID, 1, *commitAckC))
+	{
+		cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+		suite.Require().Empty(cs.CommitFailures)
+	}
+}
+
+func (suite *KeeperTestSuite) TestResolveTxProposal() {
+	chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+	chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
+
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+		suite.chainA.GetContext(),
+		[]initiatortypes.ContractTransaction{
+			chB.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec())),
+			chC.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec())),
+		},
+	)
+	suite.Require().NoError(err)
+
+	txID := []byte("txid-resolve-proposal")
+	kA := suite.chainA.App.AtomicKeeper.TPCKeeper()
+	ps := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	suite.Require().NoError(
+		kA.SendPrepare(suite.chainA.GetContext(), ps, txID, txs, clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100), 0),
+	)
+	suite.chainA.NextBlock()
+
+	// the governance can resolve the tx without any admin
+	suite.Require().Empty(suite.chainA.App.AtomicKeeper.GetAuthority())
+
+	// an invalid proposal is rejected on submission
+	_, err = suite.chainA.App.GovKeeper.SubmitProposal(
+		suite.chainA.GetContext(),
+		atomictypes.NewResolveTxProposal("resolve", "resolve an unknown tx", []byte("txid-unknown")),
+	)
+	suite.Require().Error(err)
+
+	proposal := atomictypes.NewResolveTxProposal("resolve", "resolve a stuck tx", txID)
+	_, err = suite.chainA.App.GovKeeper.SubmitProposal(suite.chainA.GetContext(), proposal)
+	suite.Require().NoError(err)
+
+	// the passed proposal forces the coordinator to abort the tx
+	ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	handler := suite.chainA.App.GovKeeper.Router().GetRoute(proposal.ProposalRoute())
+	suite.Require().NoError(handler(ctx, proposal))
+	var sent int
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == channeltypes.EventTypeSendPacket {
+			sent++
+		}
+	}
+	suite.Require().Equal(2, sent)
+	suite.chainA.NextBlock()
+
+	cs, found := kA.GetCoordinatorState(suite.chainA.GetContext(), txID)
+	suite.Require().True(found)
+	suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+	suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+	suite.Require().Equal(atomictypes.ABORT_REASON_MANUAL, cs.AbortReason)
+
+	// the tx has already been resolved
+	suite.Require().Error(handler(suite.chainA.GetContext(), proposal))
+}
+
+func (suite *KeeperTestSuite) TestQueryStates() {
+	chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+	chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
+
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+		suite.chainA.GetContext(),
+		[]initiatortypes.ContractTransaction{
+			chB.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec())),
+			chC.ContractTransaction(samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec())),
+		},
+	)
+	suite.Require().NoError(err)
+
+	kA := suite.chainA.App.AtomicKeeper.TPCKeeper()
+	kB := suite.chainB.App.AtomicKeeper.TPCKeeper()
+	timeoutHeight := clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100)
+	ps := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+
+	// txID0 stays in the prepare phase, and txID1 is aborted by a timeout
+	txID0, txID1 := []byte("txid-query-0"), []byte("txid-query-1")
+	suite.Require().NoError(kA.SendPrepare(suite.chainA.GetContext(), ps, txID0, txs, timeoutHeight, 0))
+	suite.Require().NoError(kA.SendPrepare(suite.chainA.GetContext(), ps, txID1, txs, timeoutHeight, 0))
+	_, err = kA.HandlePacketTimeoutPrepare(
+		suite.chainA.GetContext(),
+		ps.Packets()[3].GetSourcePort(), ps.Packets()[3].GetSourceChannel(),
+		txID1, 1, ps,
+	)
+	suite.Require().NoError(err)
+	suite.chainA.NextBlock()
+
+	// chainB prepares txID0
+	p0 := ps.Packets()[0]
+	prepareB := *suite.parsePacketToPacketDataPrepare(suite.chainB.App.AppCodec(), p0).(*types.PacketDataPrepare)
+	_, _, err = kB.ReceivePacketPrepare(suite.chainB.GetContext(), p0.GetDestPort(), p0.GetDestChannel(), prepareB)
+	suite.Require().NoError(err)
+	suite.chainB.NextBlock()
+
+	qA := suite.chainA.App.AtomicKeeper
+	ctxA := sdk.WrapSDKContext(suite.chainA.GetContext())
+	{
+		res, err := qA.CoordinatorStates(ctxA, &atomictypes.QueryCoordinatorStatesRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.CoordinatorStates, 2)
+	}
+	{
+		res, err := qA.CoordinatorStates(ctxA, &atomictypes.QueryCoordinatorStatesRequest{Phase: atomictypes.COORDINATOR_PHASE_PREPARE})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.CoordinatorStates, 1)
+		suite.Require().Equal(crosstypes.TxID(txID0), res.CoordinatorStates[0].TxId)
+	}
+	{
+		res, err := qA.CoordinatorStates(ctxA, &atomictypes.QueryCoordinatorStatesRequest{Decision: atomictypes.COORDINATOR_DECISION_ABORT})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.CoordinatorStates, 1)
+		suite.Require().Equal(crosstypes.TxID(txID1), res.CoordinatorStates[0].TxId)
+		suite.Require().Equal(atomictypes.ABORT_REASON_TIMEOUT, res.CoordinatorStates[0].CoordinatorState.AbortReason)
+	}
+	{
+		res, err := qA.CoordinatorStates(ctxA, &atomictypes.QueryCoordinatorStatesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.CoordinatorStates, 1)
+		suite.Require().Equal(uint64(2), res.Pagination.Total)
+		suite.Require().NotNil(res.Pagination.NextKey)
+
+		res, err = qA.CoordinatorStates(ctxA, &atomictypes.QueryCoordinatorStatesRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.CoordinatorStates, 1)
+		suite.Require().Nil(res.Pagination.NextKey)
+	}
+
+	qB := suite.chainB.App.AtomicKeeper
+	ctxB := sdk.WrapSDKContext(suite.chainB.GetContext())
+	{
+		res, err := qB.ContractTransactionState(ctxB, &atomictypes.QueryContractTransactionStateRequest{TxId: txID0, TxIndex: 0})
+		suite.Require().NoError(err)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE, res.ContractTransactionState.Status)
+
+		_, err = qB.ContractTransactionState(ctxB, &atomictypes.QueryContractTransactionStateRequest{TxId: txID1, TxIndex: 0})
+		suite.Require().Error(err)
+	}
+	{
+		res, err := qB.ContractTransactionStates(ctxB, &atomictypes.QueryContractTransactionStatesRequest{Status: atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.ContractTransactionStates, 1)
+		suite.Require().Equal(crosstypes.TxID(txID0), res.ContractTransactionStates[0].TxId)
+		suite.Require().Equal(crosstypes.TxIndex(0), res.ContractTransactionStates[0].TxIndex)
+
+		res, err = qB.ContractTransactionStates(ctxB, &atomictypes.QueryContractTransactionStatesRequest{Status: atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.ContractTransactionStates, 0)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendPrepareParams() {
+	chB := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainB)
+	chC := suite.coordinator.SetupCrossChannel(suite.chainA, suite.chainC)
+
+	// the contract transaction for chainC refers to the result of the one for chainB
+	txs, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().ResolveTransactions(
+		suite.chainA.GetContext(),
+		[]initiatortypes.ContractTransaction{
+			{
+				CrossChainChannel: chB.XCC,
+				Signers:           []authtypes.Account{chB.Signer()},
+				CallInfo:          samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec()),
+				ReturnValue:       txtypes.NewReturnValue(sdk.Uint64ToBigEndian(1)),
+			},
+			{
+				CrossChainChannel: chC.XCC,
+				Signers:           []authtypes.Account{chC.Signer()},
+				CallInfo:          samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec()),
+				Links:             []initiatortypes.Link{{SrcIndex: 0}},
+			},
+		},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(txs[1].CallResults, 1)
+
+	cases := []struct {
+		name     string
+		malleate func(params *txtypes.Params)
+		expPass  bool
+	}{
+		{"default params", func(params *txtypes.Params) {}, true},
+		{"not allowed commit protocol", func(params *txtypes.Params) {
+			params.AllowedCommitProtocols = []txtypes.CommitProtocol{txtypes.COMMIT_PROTOCOL_SIMPLE}
+		}, false},
+		{"too many contract transactions", func(params *txtypes.Params) {
+			params.MaxContractTransactions = 1
+		}, false},
+		{"cross-chain calls are disabled", func(params *txtypes.Params) {
+			params.CrossChainCallsEnabled = false
+		}, false},
+	}
+
+	for i, c := range cases {
+		suite.Run(c.name, func() {
+			ctx, _ := suite.chainA.GetContext().CacheContext()
+			params := suite.chainA.App.CrossKeeper.GetParams(ctx)
+			c.malleate(&params)
+			suite.chainA.App.CrossKeeper.SetParams(ctx, params)
+
+			ps := ibctesting.NewCapturePacketSender(
+				packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+			)
+			err := suite.chainA.App.AtomicKeeper.TPCKeeper().SendPrepare(
+				ctx, ps, []byte(fmt.Sprintf("txid-params-%v", i)), txs,
+				clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100), 0,
+			)
+			if c.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(ps.Packets(), 2)
+			} else {
+				suite.Require().Error(err)
 			}
 		})
 	}
+
+	// the participant rejects the call results if cross-chain calls are disabled on its chain
+	ps := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainA.App.IBCKeeper.ChannelKeeper),
+	)
+	suite.Require().NoError(suite.chainA.App.AtomicKeeper.TPCKeeper().SendPrepare(
+		suite.chainA.GetContext(), ps, []byte("txid-params-participant"), txs,
+		clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100), 0,
+	))
+	suite.Require().Len(ps.Packets(), 2)
+	p := ps.Packets()[1]
+	data := *suite.parsePacketToPacketDataPrepare(suite.chainC.App.AppCodec(), p).(*types.PacketDataPrepare)
+
+	ctxC, _ := suite.chainC.GetContext().CacheContext()
+	_, _, err = suite.chainC.App.AtomicKeeper.TPCKeeper().ReceivePacketPrepare(ctxC, p.GetDestPort(), p.GetDestChannel(), data)
+	suite.Require().NoError(err)
+
+	ctxC, _ = suite.chainC.GetContext().CacheContext()
+	params := suite.chainC.App.CrossKeeper.GetParams(ctxC)
+	params.CrossChainCallsEnabled = false
+	suite.chainC.App.CrossKeeper.SetParams(ctxC, params)
+	_, _, err = suite.chainC.App.AtomicKeeper.TPCKeeper().ReceivePacketPrepare(ctxC, p.GetDestPort(), p.GetDestChannel(), data)
+	suite.Require().Error(err)
 }
 
 func (suite *KeeperTestSuite) parsePacketToPacketDataPrepare(cdc codec.Codec, p packets.OutgoingPacket) packets.PacketDataPayload {
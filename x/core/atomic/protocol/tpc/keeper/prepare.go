@@ -1,15 +1,16 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 
+	basekeeper "github.com/datachainlab/cross/x/core/atomic/protocol/base/keeper"
 	"github.com/datachainlab/cross/x/core/atomic/protocol/tpc/types"
 	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
@@ -18,6 +19,9 @@ import (
 	"github.com/datachainlab/cross/x/packets"
 )
 
+// SendPrepare sends prepare packets to all participants.
+// The prepare packets time out at the timeout timestamp of the tx, then the coordinator aborts the tx.
+// caller is coordinator
 func (k Keeper) SendPrepare(
 	ctx sdk.Context,
 	packetSender packets.PacketSender,
@@ -26,8 +30,13 @@ func (k Keeper) SendPrepare(
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 ) error {
-	if len(transactions) == 0 {
+	params := k.GetParams(ctx)
+	if !params.IsAllowedCommitProtocol(txtypes.COMMIT_PROTOCOL_TPC) {
+		return fmt.Errorf("the commit protocol '%v' is not allowed", txtypes.COMMIT_PROTOCOL_TPC)
+	} else if len(transactions) == 0 {
 		return errors.New("the number of contract transactions must be greater than 1")
+	} else if err := params.ValidateResolvedContractTransactions(transactions); err != nil {
+		return err
 	} else if !timeoutHeight.IsZero() && uint64(ctx.BlockHeight()) >= timeoutHeight.GetRevisionHeight() {
 		return fmt.Errorf("the given timeoutHeight is in the past: current=%v timeout=%v", ctx.BlockHeight(), timeoutHeight.GetRevisionHeight())
 	} else if timeoutTimestamp != 0 && uint64(ctx.BlockTime().Unix()) >= timeoutTimestamp {
@@ -36,11 +45,7 @@ func (k Keeper) SendPrepare(
 		return fmt.Errorf("txID '%X' already exists", txID)
 	}
 
-	// NOTE: packet-timeout isn't supported in the two-phase commit
-	packetTimeoutHeight := clienttypes.NewHeight(
-		clienttypes.ParseChainID(ctx.ChainID()),
-		math.MaxUint64,
-	)
+	packetTimeoutHeight, packetTimeoutTimestamp := basekeeper.PacketTimeout(ctx, timeoutTimestamp)
 	var channels []xcctypes.ChannelInfo
 	for i, tx := range transactions {
 		data := types.NewPacketDataPrepare(
@@ -65,7 +70,7 @@ func (k Keeper) SendPrepare(
 			packetSender,
 			&data,
 			ci.Port, ci.Channel, ch.Counterparty.PortId, ch.Counterparty.ChannelId,
-			packetTimeoutHeight, 0,
+			packetTimeoutHeight, packetTimeoutTimestamp,
 		); err != nil {
 			return err
 		}
@@ -101,6 +106,11 @@ func (k Keeper) ReceivePacketPrepare(
 		return nil, nil, fmt.Errorf("txID '%x' already exists", data.TxId)
 	}
 
+	// the participant also enforces the cross params of its own chain
+	if err := k.GetParams(ctx).ValidateCallResults(len(data.Tx.CallResults)); err != nil {
+		return nil, nil, err
+	}
+
 	var prepareResult atomictypes.PrepareResult
 	res, err := k.cm.PrepareCommit(ctx, data.TxId, data.TxIndex, data.Tx)
 	if err != nil {
@@ -200,11 +210,12 @@ func (k Keeper) receivePrepareAcknowledgement(
 			}
 		case atomictypes.PREPARE_RESULT_FAILED:
 			cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+			cs.AbortReason = atomictypes.ABORT_REASON_PREPARE_FAILED
 			state.GoAbort = true
 		default:
 			panic(fmt.Sprintf("unexpected result %v", ack.Result))
 		}
-	case atomictypes.COORDINATOR_PHASE_COMMIT:
+	case atomictypes.COORDINATOR_PHASE_COMMIT, atomictypes.COORDINATOR_PHASE_COMPLETED:
 		state.AlreadyCommitted = true
 	default:
 		panic(fmt.Sprintf("unexpected phase %v", cs.Phase))
@@ -213,6 +224,77 @@ func (k Keeper) receivePrepareAcknowledgement(
 	return &state, nil
 }
 
+// HandlePacketTimeoutPrepare handles a timeout of the prepare packet.
+// If the coordinator is still in the prepare phase, it decides to abort the tx and sends abort commits to the other participants.
+func (k Keeper) HandlePacketTimeoutPrepare(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypePacketTimeout,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+		),
+	)
+
+	switch cs.Phase {
+	case atomictypes.COORDINATOR_PHASE_PREPARE:
+		if err := cs.Confirm(txIndex, xcctypes.ChannelInfo{Port: sourcePort, Channel: sourceChannel}); err != nil {
+			return nil, err
+		}
+		// the participant never receives the prepare packet, so it doesn't need a commit packet
+		cs.AddAck(txIndex)
+		cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+		cs.AbortReason = atomictypes.ABORT_REASON_TIMEOUT
+		k.SetCoordinatorState(ctx, txID, *cs)
+		if err := k.SendCommit(ctx, ps, txID, false); err != nil {
+			return nil, err
+		}
+	case atomictypes.COORDINATOR_PHASE_COMMIT, atomictypes.COORDINATOR_PHASE_COMPLETED:
+		// nop: the abort commit has already been sent to the participant
+	default:
+		return nil, fmt.Errorf("unexpected phase %v", cs.Phase)
+	}
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// HandlePacketErrorAcknowledgementPrepare handles an error acknowledgement of the prepare packet.
+// The participant failed to handle the packet, so it is treated as PREPARE_RESULT_FAILED.
+func (k Keeper) HandlePacketErrorAcknowledgementPrepare(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	errMsg string,
+	ps packets.PacketSender,
+) (*sdk.Result, error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeErrorACK,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, errMsg),
+		),
+	)
+	return k.HandlePacketAcknowledgementPrepare(
+		ctx,
+		sourcePort, sourceChannel,
+		*types.NewPacketAcknowledgementPayload(atomictypes.PREPARE_RESULT_FAILED),
+		txID, txIndex, ps,
+	)
+}
+
 // receivePrepareState keeps a packet receiving state
 type receivePrepareState struct {
 	AlreadyCommitted bool // AlreadyCommitted indicates a boolean whether the tx had already reach the commit phase
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+
+	"github.com/datachainlab/cross/x/core/atomic/protocol/tpc/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+	"github.com/datachainlab/cross/x/packets"
+)
+
+// ResolveTx forces the coordinator to abort a transaction that is stuck in the prepare phase.
+// It sends abort commits to all participants that haven't been finalized yet.
+// caller is coordinator
+func (k Keeper) ResolveTx(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	txID crosstypes.TxID,
+) error {
+	cs, found := k.GetCoordinatorState(ctx, txID)
+	if !found {
+		return fmt.Errorf("txID '%x' not found", txID)
+	} else if cs.Type != txtypes.COMMIT_PROTOCOL_TPC {
+		return fmt.Errorf("commit protocol must be '%v'", txtypes.COMMIT_PROTOCOL_TPC.String())
+	} else if cs.Phase != atomictypes.COORDINATOR_PHASE_PREPARE {
+		return fmt.Errorf("coordinator status must be '%v'", atomictypes.COORDINATOR_PHASE_PREPARE.String())
+	}
+
+	cs.Decision = atomictypes.COORDINATOR_DECISION_ABORT
+	cs.AbortReason = atomictypes.ABORT_REASON_MANUAL
+	k.SetCoordinatorState(ctx, txID, *cs)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeTxResolved,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyDecision, cs.Decision.String()),
+		),
+	)
+	return k.SendCommit(ctx, packetSender, txID, false)
+}
+
+// SendQueryDecision sends a packet to ask the coordinator for the decision of a prepared transaction.
+// caller is participant
+func (k Keeper) SendQueryDecision(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	txState, err := k.EnsureContractTransactionStatus(ctx, txID, txIndex, atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE)
+	if err != nil {
+		return err
+	}
+	ci := txState.CoordinatorChannel
+	c, found := k.ChannelKeeper().GetChannel(ctx, ci.Port, ci.Channel)
+	if !found {
+		return sdkerrors.Wrap(channeltypes.ErrChannelNotFound, ci.String())
+	}
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		// NOTE: a packet must have either a timeout height or a timeout timestamp
+		timeoutHeight = clienttypes.NewHeight(clienttypes.ParseChainID(ctx.ChainID()), math.MaxUint64)
+	}
+	return k.SendPacket(
+		ctx,
+		packetSender,
+		types.NewPacketDataQueryDecision(txID, txIndex),
+		ci.Port, ci.Channel,
+		c.Counterparty.PortId, c.Counterparty.ChannelId,
+		timeoutHeight, timeoutTimestamp,
+	)
+}
+
+// ReceivePacketQueryDecision returns the decision of the transaction.
+// If the coordinator hasn't decided yet, it returns COORDINATOR_DECISION_UNKNOWN.
+// caller is coordinator
+func (k Keeper) ReceivePacketQueryDecision(
+	ctx sdk.Context,
+	destPort,
+	destChannel string,
+	data types.PacketDataQueryDecision,
+) (*types.PacketAcknowledgementQueryDecision, error) {
+	cs, found := k.GetCoordinatorState(ctx, data.TxId)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", data.TxId)
+	} else if int(data.TxIndex) >= len(cs.Channels) {
+		return nil, fmt.Errorf("txIndex '%v' not found", data.TxIndex)
+	}
+	if ci := (xcctypes.ChannelInfo{Port: destPort, Channel: destChannel}); !cs.Channels[data.TxIndex].Equal(&ci) {
+		return nil, fmt.Errorf("expected channel is %v, but got %v", cs.Channels[data.TxIndex], ci)
+	}
+	switch cs.Phase {
+	case atomictypes.COORDINATOR_PHASE_COMMIT, atomictypes.COORDINATOR_PHASE_COMPLETED:
+		return types.NewPacketAcknowledgementQueryDecision(cs.Decision), nil
+	default:
+		return types.NewPacketAcknowledgementQueryDecision(atomictypes.COORDINATOR_DECISION_UNKNOWN), nil
+	}
+}
+
+// HandlePacketAcknowledgementQueryDecision commits or aborts the prepared transaction according to the decision of the coordinator.
+// caller is participant
+func (k Keeper) HandlePacketAcknowledgementQueryDecision(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	ack types.PacketAcknowledgementQueryDecision,
+) (*txtypes.ContractCallResult, error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			atomictypes.EventTypeDecisionReceived,
+			sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+			sdk.NewAttribute(atomictypes.AttributeKeyDecision, ack.Decision.String()),
+		),
+	)
+
+	var isCommittable bool
+	switch ack.Decision {
+	case atomictypes.COORDINATOR_DECISION_UNKNOWN:
+		// the coordinator hasn't decided yet
+		return nil, nil
+	case atomictypes.COORDINATOR_DECISION_COMMIT:
+		isCommittable = true
+	case atomictypes.COORDINATOR_DECISION_ABORT:
+		isCommittable = false
+	default:
+		return nil, fmt.Errorf("unexpected decision %v", ack.Decision)
+	}
+
+	txState, found := k.GetContractTransactionState(ctx, txID, txIndex)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", txID)
+	} else if txState.Status != atomictypes.CONTRACT_TRANSACTION_STATUS_PREPARE {
+		// the transaction has already been finalized by the commit packet
+		return nil, nil
+	}
+
+	// NOTE: a failure of the commit must not break the acknowledgement, so the participant can query the decision again
+	cacheCtx, writeFn := ctx.CacheContext()
+	res, err := k.finalize(cacheCtx, txID, txIndex, isCommittable)
+	if err != nil {
+		k.Logger(ctx).Error("failed to finalize the transaction", "txID", hex.EncodeToString(txID), "err", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				atomictypes.EventTypeCommitFailed,
+				sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(txID)),
+				sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(txIndex)),
+				sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, err.Error()),
+			),
+		)
+		return nil, nil
+	}
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return res, nil
+}
+
+// finalize commits or aborts the prepared transaction and updates its status
+func (k Keeper) finalize(
+	ctx sdk.Context,
+	txID crosstypes.TxID,
+	txIndex crosstypes.TxIndex,
+	isCommittable bool,
+) (*txtypes.ContractCallResult, error) {
+	if isCommittable {
+		res, err := k.cm.Commit(ctx, txID, txIndex)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.UpdateContractTransactionStatus(ctx, txID, txIndex, atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+	if err := k.cm.Abort(ctx, txID, txIndex); err != nil {
+		return nil, err
+	}
+	if err := k.UpdateContractTransactionStatus(ctx, txID, txIndex, atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
@@ -1,6 +1,9 @@
 package tpc
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -8,6 +11,7 @@ import (
 
 	tpckeeper "github.com/datachainlab/cross/x/core/atomic/protocol/tpc/keeper"
 	"github.com/datachainlab/cross/x/core/atomic/protocol/tpc/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
 	"github.com/datachainlab/cross/x/core/router"
 	"github.com/datachainlab/cross/x/packets"
 )
@@ -21,8 +25,8 @@ type PacketHandler struct {
 
 var _ router.PacketHandler = (*PacketHandler)(nil)
 
-func NewPacketHandler(cdc codec.Codec, k tpckeeper.Keeper) PacketHandler {
-	return PacketHandler{cdc: cdc, keeper: k}
+func NewPacketHandler(cdc codec.Codec, k tpckeeper.Keeper, packetMiddleware packets.PacketMiddleware) PacketHandler {
+	return PacketHandler{cdc: cdc, keeper: k, packetMiddleware: packetMiddleware}
 }
 
 func (h PacketHandler) HandlePacket(
@@ -67,6 +71,16 @@ func (h PacketHandler) HandlePacket(
 		} else {
 			data = nil
 		}
+	case *types.PacketDataQueryDecision:
+		ap, err := h.keeper.ReceivePacketQueryDecision(
+			ctx,
+			packet.DestinationPort, packet.DestinationChannel,
+			*payload,
+		)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceivePacketQueryDecision: %v", err)
+		}
+		ack = packets.NewOutgoingPacketAcknowledgement(nil, ap)
 	default:
 		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized packet type: %T", payload)
 	}
@@ -97,9 +111,80 @@ func (h PacketHandler) HandleACK(
 			*payload, pd.TxId, pd.TxIndex, ps,
 		)
 	case *types.PacketAcknowledgementCommit:
+		pd := ip.Payload().(*types.PacketDataCommit)
+		if err := h.keeper.ReceiveCommitAcknowledgement(ctx, pd.TxId, pd.TxIndex, *payload); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceiveCommitAcknowledgement: %v", err)
+		}
 		bz := h.cdc.MustMarshalJSON(payload)
 		return &sdk.Result{Data: bz, Events: ctx.EventManager().ABCIEvents()}, nil
+	case *types.PacketAcknowledgementQueryDecision:
+		pd := ip.Payload().(*types.PacketDataQueryDecision)
+		res, err := h.keeper.HandlePacketAcknowledgementQueryDecision(ctx, pd.TxId, pd.TxIndex, *payload)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to HandlePacketAcknowledgementQueryDecision: %v", err)
+		}
+		ctx.EventManager().EmitEvents(res.GetEvents())
+		return &sdk.Result{Data: res.GetData(), Events: ctx.EventManager().ABCIEvents()}, nil
 	default:
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ack type: %T", payload)
 	}
 }
+
+func (h PacketHandler) HandleErrorACK(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+	errMsg string,
+) (*sdk.Result, error) {
+	switch payload := ip.Payload().(type) {
+	case *types.PacketDataPrepare:
+		return h.keeper.HandlePacketErrorAcknowledgementPrepare(
+			ctx,
+			packet.SourcePort, packet.SourceChannel,
+			payload.TxId, payload.TxIndex, errMsg,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	case *types.PacketDataCommit:
+		// the participant failed to handle the commit packet, so it is recorded as a commit failure
+		ack := types.NewPacketAcknowledgementCommit(types.COMMIT_STATUS_FAILED)
+		ack.ErrorMessage = errMsg
+		if err := h.keeper.ReceiveCommitAcknowledgement(ctx, payload.TxId, payload.TxIndex, *ack); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "failed to ReceiveCommitAcknowledgement: %v", err)
+		}
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	case *types.PacketDataQueryDecision:
+		// the participant can query the decision again
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				atomictypes.EventTypeErrorACK,
+				sdk.NewAttribute(atomictypes.AttributeKeyTxID, hex.EncodeToString(payload.TxId)),
+				sdk.NewAttribute(atomictypes.AttributeKeyTxIndex, fmt.Sprint(payload.TxIndex)),
+				sdk.NewAttribute(atomictypes.AttributeKeyErrorMessage, errMsg),
+			),
+		)
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected packet type: %T", payload)
+	}
+}
+
+func (h PacketHandler) HandleTimeout(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+) (*sdk.Result, error) {
+	switch payload := ip.Payload().(type) {
+	case *types.PacketDataPrepare:
+		return h.keeper.HandlePacketTimeoutPrepare(
+			ctx,
+			packet.SourcePort, packet.SourceChannel,
+			payload.TxId, payload.TxIndex,
+			packets.NewBasicPacketSender(h.keeper.ChannelKeeper()),
+		)
+	case *types.PacketDataQueryDecision:
+		// nop: the participant can query the decision again
+		return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected timeout packet type: %T", payload)
+	}
+}
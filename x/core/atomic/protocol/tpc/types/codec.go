@@ -13,11 +13,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*packets.PacketDataPayload)(nil),
 		&PacketDataPrepare{},
 		&PacketDataCommit{},
+		&PacketDataQueryDecision{},
 	)
 	registry.RegisterImplementations(
 		(*packets.PacketAcknowledgementPayload)(nil),
 		&PacketAcknowledgementPrepare{},
 		&PacketAcknowledgementCommit{},
+		&PacketAcknowledgementQueryDecision{},
 	)
 }
 
@@ -82,3 +82,34 @@ func (PacketAcknowledgementCommit) ValidateBasic() error {
 func (PacketAcknowledgementCommit) Type() string {
 	return PacketType
 }
+
+var _ packets.PacketDataPayload = (*PacketDataQueryDecision)(nil)
+
+func NewPacketDataQueryDecision(txID crosstypes.TxID, txIndex crosstypes.TxIndex) *PacketDataQueryDecision {
+	return &PacketDataQueryDecision{
+		TxId:    txID,
+		TxIndex: txIndex,
+	}
+}
+
+func (PacketDataQueryDecision) ValidateBasic() error {
+	return nil
+}
+
+func (PacketDataQueryDecision) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketAcknowledgementPayload = (*PacketAcknowledgementQueryDecision)(nil)
+
+func NewPacketAcknowledgementQueryDecision(decision atomictypes.CoordinatorDecision) *PacketAcknowledgementQueryDecision {
+	return &PacketAcknowledgementQueryDecision{Decision: decision}
+}
+
+func (PacketAcknowledgementQueryDecision) ValidateBasic() error {
+	return nil
+}
+
+func (PacketAcknowledgementQueryDecision) Type() string {
+	return PacketType
+}
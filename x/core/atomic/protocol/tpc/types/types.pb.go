@@ -207,52 +207,132 @@ func (m *PacketAcknowledgementCommit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketAcknowledgementCommit proto.InternalMessageInfo
 
+type PacketDataQueryDecision struct {
+	TxId    github_com_datachainlab_cross_x_core_types.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	TxIndex github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"tx_index,omitempty"`
+}
+
+func (m *PacketDataQueryDecision) Reset()         { *m = PacketDataQueryDecision{} }
+func (m *PacketDataQueryDecision) String() string { return proto.CompactTextString(m) }
+func (*PacketDataQueryDecision) ProtoMessage()    {}
+func (*PacketDataQueryDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e47c0d3dab52b5, []int{4}
+}
+func (m *PacketDataQueryDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketDataQueryDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketDataQueryDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketDataQueryDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketDataQueryDecision.Merge(m, src)
+}
+func (m *PacketDataQueryDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketDataQueryDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketDataQueryDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketDataQueryDecision proto.InternalMessageInfo
+
+type PacketAcknowledgementQueryDecision struct {
+	Decision types1.CoordinatorDecision `protobuf:"varint,1,opt,name=decision,proto3,enum=cross.core.atomic.CoordinatorDecision" json:"decision,omitempty"`
+}
+
+func (m *PacketAcknowledgementQueryDecision) Reset()         { *m = PacketAcknowledgementQueryDecision{} }
+func (m *PacketAcknowledgementQueryDecision) String() string { return proto.CompactTextString(m) }
+func (*PacketAcknowledgementQueryDecision) ProtoMessage()    {}
+func (*PacketAcknowledgementQueryDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e47c0d3dab52b5, []int{5}
+}
+func (m *PacketAcknowledgementQueryDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketAcknowledgementQueryDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketAcknowledgementQueryDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketAcknowledgementQueryDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketAcknowledgementQueryDecision.Merge(m, src)
+}
+func (m *PacketAcknowledgementQueryDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketAcknowledgementQueryDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketAcknowledgementQueryDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketAcknowledgementQueryDecision proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cross.core.atomic.tpc.CommitStatus", CommitStatus_name, CommitStatus_value)
 	proto.RegisterType((*PacketDataPrepare)(nil), "cross.core.atomic.tpc.PacketDataPrepare")
 	proto.RegisterType((*PacketAcknowledgementPrepare)(nil), "cross.core.atomic.tpc.PacketAcknowledgementPrepare")
 	proto.RegisterType((*PacketDataCommit)(nil), "cross.core.atomic.tpc.PacketDataCommit")
 	proto.RegisterType((*PacketAcknowledgementCommit)(nil), "cross.core.atomic.tpc.PacketAcknowledgementCommit")
+	proto.RegisterType((*PacketDataQueryDecision)(nil), "cross.core.atomic.tpc.PacketDataQueryDecision")
+	proto.RegisterType((*PacketAcknowledgementQueryDecision)(nil), "cross.core.atomic.tpc.PacketAcknowledgementQueryDecision")
 }
 
 func init() { proto.RegisterFile("cross/core/atomic/tpc/types.proto", fileDescriptor_e0e47c0d3dab52b5) }
 
 var fileDescriptor_e0e47c0d3dab52b5 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0x87, 0x77, 0x62, 0xac, 0x75, 0x4c, 0x4a, 0x5c, 0x52, 0x48, 0xa3, 0x6e, 0x62, 0x8b, 0x10,
-	0x7a, 0xd8, 0x85, 0x14, 0x44, 0x14, 0xc4, 0xfc, 0x51, 0x0c, 0x35, 0x49, 0xdd, 0xa4, 0x08, 0x22,
-	0x2e, 0x93, 0xd9, 0x21, 0x5d, 0xba, 0xbb, 0x13, 0x66, 0xde, 0xe8, 0xfa, 0x01, 0x04, 0x8f, 0x7e,
-	0x04, 0xc1, 0x2f, 0x93, 0x63, 0x8f, 0x1e, 0x24, 0x68, 0x72, 0xf1, 0x23, 0x48, 0x4f, 0x92, 0xd9,
-	0x95, 0xa4, 0x98, 0x83, 0xde, 0xbc, 0x0d, 0xef, 0x3c, 0xf3, 0x9b, 0x79, 0x9f, 0xdd, 0x17, 0xdf,
-	0xa6, 0x82, 0x4b, 0x69, 0x51, 0x2e, 0x98, 0x45, 0x80, 0x07, 0x1e, 0xb5, 0x60, 0x44, 0x2d, 0x78,
-	0x37, 0x62, 0xd2, 0x1c, 0x09, 0x0e, 0x5c, 0xdf, 0x56, 0x88, 0xb9, 0x40, 0xcc, 0x18, 0x31, 0x61,
-	0x44, 0x8b, 0xf9, 0x21, 0x1f, 0x72, 0x45, 0x58, 0x8b, 0x55, 0x0c, 0x17, 0x77, 0x56, 0xf2, 0x20,
-	0x5a, 0xcd, 0x29, 0xde, 0x5a, 0x73, 0xd5, 0x72, 0x7b, 0xf7, 0x27, 0xc2, 0xd7, 0x8f, 0x08, 0x3d,
-	0x65, 0xd0, 0x24, 0x40, 0x8e, 0x04, 0x1b, 0x11, 0xc1, 0xf4, 0xa7, 0xf8, 0x32, 0x44, 0x8e, 0xe7,
-	0x16, 0x50, 0x19, 0x55, 0x32, 0xf5, 0x83, 0xf3, 0x69, 0xc9, 0x1a, 0x7a, 0x70, 0x32, 0x1e, 0x98,
-	0x94, 0x07, 0x96, 0x4b, 0x80, 0xd0, 0x13, 0xe2, 0x85, 0x3e, 0x19, 0x58, 0x71, 0x7e, 0x94, 0x5c,
-	0xae, 0xa2, 0xfb, 0x51, 0xab, 0x69, 0xa7, 0x21, 0x6a, 0xb9, 0xfa, 0x73, 0xbc, 0xb9, 0x48, 0x0a,
-	0x5d, 0x16, 0x15, 0x52, 0x65, 0x54, 0xc9, 0xd6, 0xef, 0x9e, 0x4f, 0x4b, 0xd5, 0x7f, 0x0b, 0x5b,
-	0x9c, 0xb6, 0xaf, 0x40, 0xbc, 0xd0, 0x1f, 0xe1, 0x14, 0x44, 0x85, 0x4b, 0x65, 0x54, 0xb9, 0x56,
-	0xdd, 0x37, 0x57, 0x34, 0x41, 0x64, 0xda, 0x4c, 0x72, 0xff, 0x0d, 0x73, 0x1b, 0x3c, 0x04, 0x41,
-	0x28, 0xf4, 0x05, 0x09, 0x25, 0xa1, 0xe0, 0xf1, 0xb0, 0x9e, 0x9e, 0x4c, 0x4b, 0x9a, 0x9d, 0x82,
-	0xe8, 0x7e, 0xfa, 0xc7, 0xa7, 0x92, 0xb6, 0xfb, 0x1a, 0xdf, 0x8c, 0x3b, 0xaf, 0xd1, 0xd3, 0x90,
-	0xbf, 0xf5, 0x99, 0x3b, 0x64, 0x01, 0x0b, 0xe1, 0xb7, 0x84, 0x7b, 0x78, 0x43, 0x30, 0x39, 0xf6,
-	0x41, 0x59, 0xd8, 0xaa, 0x96, 0xcd, 0x3f, 0x3f, 0x49, 0xc2, 0xda, 0x8a, 0xb3, 0x13, 0x3e, 0xc9,
-	0xff, 0x8a, 0x70, 0x6e, 0xa9, 0xb6, 0xc1, 0x83, 0xc0, 0x83, 0xff, 0xdb, 0xec, 0x1d, 0xbc, 0xe5,
-	0x49, 0x87, 0xaa, 0x97, 0x02, 0x19, 0xf8, 0x4c, 0x59, 0xde, 0xb4, 0xb3, 0x9e, 0x6c, 0x2c, 0x8b,
-	0x49, 0x7b, 0xef, 0x11, 0xbe, 0xb1, 0xd6, 0x5f, 0xd2, 0xe9, 0x03, 0xbc, 0x21, 0x81, 0xc0, 0x58,
-	0x26, 0xfa, 0xf6, 0xcc, 0xb5, 0x7f, 0xb4, 0x19, 0xe3, 0x3d, 0x85, 0xda, 0xc9, 0x11, 0x7d, 0x0f,
-	0x67, 0x99, 0x10, 0x5c, 0x38, 0x01, 0x93, 0x92, 0x0c, 0x99, 0xea, 0xf0, 0xaa, 0x9d, 0x51, 0xc5,
-	0x76, 0x5c, 0x8b, 0xdf, 0xb1, 0xef, 0xe0, 0xcc, 0x6a, 0x84, 0xbe, 0x83, 0xb7, 0x1b, 0xdd, 0x76,
-	0xbb, 0xd5, 0x77, 0x7a, 0xfd, 0x5a, 0xff, 0xb8, 0xe7, 0x1c, 0x77, 0x0e, 0x3b, 0xdd, 0x17, 0x9d,
-	0x9c, 0xa6, 0xe7, 0x71, 0xee, 0xe2, 0x56, 0xf7, 0x30, 0x87, 0xf4, 0x02, 0xce, 0x5f, 0xac, 0x3e,
-	0xa9, 0xb5, 0x9e, 0x3d, 0x6e, 0xe6, 0x52, 0xc5, 0xf4, 0x87, 0xcf, 0x86, 0x56, 0x7f, 0x35, 0xf9,
-	0x6e, 0x68, 0x93, 0x99, 0x81, 0xce, 0x66, 0x06, 0xfa, 0x36, 0x33, 0xd0, 0xc7, 0xb9, 0xa1, 0x9d,
-	0xcd, 0x0d, 0xed, 0xcb, 0xdc, 0xd0, 0x5e, 0x3e, 0xfc, 0x2b, 0xe1, 0xc9, 0xe4, 0xa9, 0x99, 0xa3,
-	0xdc, 0x5f, 0x4e, 0xfb, 0x60, 0x43, 0xd5, 0x0e, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xec,
-	0x0e, 0x03, 0x13, 0x04, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xdf, 0x6a, 0x13, 0x41,
+	0x14, 0xc6, 0x77, 0x62, 0xac, 0x75, 0x6c, 0x4b, 0x5c, 0x5a, 0x4c, 0xa3, 0x6e, 0x62, 0x8b, 0x52,
+	0x7a, 0xb1, 0x0b, 0x2d, 0x88, 0x28, 0x88, 0x4d, 0xa2, 0x18, 0x6a, 0xff, 0x6d, 0x52, 0x04, 0x11,
+	0x97, 0xc9, 0xec, 0x90, 0x2e, 0xdd, 0xdd, 0x09, 0x33, 0x27, 0xba, 0x7d, 0x00, 0xc1, 0x4b, 0x1f,
+	0x41, 0xf0, 0x35, 0x7c, 0x80, 0x5c, 0xf6, 0xd2, 0x0b, 0x09, 0x9a, 0xdc, 0xf8, 0x08, 0xd2, 0x2b,
+	0xc9, 0xec, 0xd4, 0x24, 0x98, 0x0b, 0xbd, 0xeb, 0xdd, 0xe1, 0xcc, 0x77, 0xbe, 0x73, 0xce, 0x6f,
+	0x97, 0x83, 0xef, 0x50, 0xc1, 0xa5, 0x74, 0x28, 0x17, 0xcc, 0x21, 0xc0, 0xa3, 0x80, 0x3a, 0xd0,
+	0xa6, 0x0e, 0x9c, 0xb4, 0x99, 0xb4, 0xdb, 0x82, 0x03, 0x37, 0x97, 0x94, 0xc4, 0x1e, 0x4a, 0xec,
+	0x54, 0x62, 0x43, 0x9b, 0x16, 0x16, 0x5b, 0xbc, 0xc5, 0x95, 0xc2, 0x19, 0x46, 0xa9, 0xb8, 0xb0,
+	0x3c, 0xe6, 0x07, 0xc9, 0xb8, 0x4f, 0xe1, 0xf6, 0x94, 0x56, 0xa3, 0xe7, 0x95, 0x5f, 0x08, 0x5f,
+	0xdf, 0x27, 0xf4, 0x98, 0x41, 0x95, 0x00, 0xd9, 0x17, 0xac, 0x4d, 0x04, 0x33, 0x9f, 0xe3, 0xcb,
+	0x90, 0x78, 0x81, 0x9f, 0x47, 0x25, 0xb4, 0x36, 0x57, 0xde, 0x3c, 0xeb, 0x15, 0x9d, 0x56, 0x00,
+	0x47, 0x9d, 0xa6, 0x4d, 0x79, 0xe4, 0xf8, 0x04, 0x08, 0x3d, 0x22, 0x41, 0x1c, 0x92, 0xa6, 0x93,
+	0xfa, 0x27, 0xba, 0xb9, 0xb2, 0x6e, 0x24, 0xb5, 0xaa, 0x9b, 0x85, 0xa4, 0xe6, 0x9b, 0x07, 0x78,
+	0x76, 0xe8, 0x14, 0xfb, 0x2c, 0xc9, 0x67, 0x4a, 0x68, 0x6d, 0xbe, 0x7c, 0xff, 0xac, 0x57, 0xdc,
+	0xf8, 0x3f, 0xb3, 0x61, 0xb5, 0x7b, 0x05, 0xd2, 0xc0, 0x7c, 0x82, 0x33, 0x90, 0xe4, 0x2f, 0x95,
+	0xd0, 0xda, 0xb5, 0x8d, 0x75, 0x7b, 0x0c, 0x13, 0x24, 0xb6, 0xcb, 0x24, 0x0f, 0xdf, 0x32, 0xbf,
+	0xc2, 0x63, 0x10, 0x84, 0x42, 0x43, 0x90, 0x58, 0x12, 0x0a, 0x01, 0x8f, 0xcb, 0xd9, 0x6e, 0xaf,
+	0x68, 0xb8, 0x19, 0x48, 0x1e, 0x66, 0x7f, 0x7e, 0x2a, 0x1a, 0x2b, 0x6f, 0xf0, 0xad, 0x74, 0xf3,
+	0x2d, 0x7a, 0x1c, 0xf3, 0x77, 0x21, 0xf3, 0x5b, 0x2c, 0x62, 0x31, 0x9c, 0x43, 0x78, 0x80, 0x67,
+	0x04, 0x93, 0x9d, 0x10, 0x14, 0x85, 0x85, 0x8d, 0x92, 0xfd, 0xf7, 0x27, 0xd1, 0x5a, 0x57, 0xe9,
+	0x5c, 0xad, 0xd7, 0xfe, 0xdf, 0x10, 0xce, 0x8d, 0xd0, 0x56, 0x78, 0x14, 0x05, 0x70, 0xb1, 0xc9,
+	0xde, 0xc5, 0x0b, 0x81, 0xf4, 0xa8, 0x9a, 0x14, 0x48, 0x33, 0x64, 0x8a, 0xf2, 0xac, 0x3b, 0x1f,
+	0xc8, 0xca, 0x28, 0xa9, 0xd7, 0x7b, 0x8f, 0xf0, 0xcd, 0xa9, 0xfc, 0xf4, 0xa6, 0x8f, 0xf0, 0x8c,
+	0x04, 0x02, 0x1d, 0xa9, 0xf1, 0xad, 0xda, 0x53, 0xff, 0x68, 0x3b, 0x95, 0xd7, 0x95, 0xd4, 0xd5,
+	0x25, 0xe6, 0x2a, 0x9e, 0x67, 0x42, 0x70, 0xe1, 0x45, 0x4c, 0x4a, 0xd2, 0x62, 0x6a, 0xc3, 0xab,
+	0xee, 0x9c, 0x4a, 0xee, 0xa4, 0x39, 0x3d, 0xc7, 0x17, 0x84, 0x6f, 0x8c, 0x30, 0x1f, 0x74, 0x98,
+	0x38, 0xa9, 0x32, 0x1a, 0xc8, 0x80, 0xc7, 0x17, 0x9a, 0xb6, 0x1e, 0x3f, 0xc6, 0x2b, 0x53, 0x29,
+	0x4e, 0x2e, 0x52, 0xc6, 0xb3, 0xbe, 0x8e, 0x35, 0xce, 0x7b, 0x53, 0x70, 0x56, 0x38, 0x17, 0x7e,
+	0x10, 0x13, 0xe0, 0xe2, 0xbc, 0xd2, 0xfd, 0x53, 0x97, 0xf6, 0x5b, 0xf7, 0xf0, 0xdc, 0x38, 0x71,
+	0x73, 0x19, 0x2f, 0x55, 0xf6, 0x76, 0x76, 0x6a, 0x0d, 0xaf, 0xde, 0xd8, 0x6a, 0x1c, 0xd6, 0xbd,
+	0xc3, 0xdd, 0xed, 0xdd, 0xbd, 0x97, 0xbb, 0x39, 0xc3, 0x5c, 0xc4, 0xb9, 0xc9, 0xa7, 0xbd, 0xed,
+	0x1c, 0x32, 0xf3, 0x78, 0x71, 0x32, 0xfb, 0x6c, 0xab, 0xf6, 0xe2, 0x69, 0x35, 0x97, 0x29, 0x64,
+	0x3f, 0x7c, 0xb6, 0x8c, 0xf2, 0xeb, 0xee, 0x0f, 0xcb, 0xe8, 0xf6, 0x2d, 0x74, 0xda, 0xb7, 0xd0,
+	0xf7, 0xbe, 0x85, 0x3e, 0x0e, 0x2c, 0xe3, 0x74, 0x60, 0x19, 0x5f, 0x07, 0x96, 0xf1, 0xea, 0xf1,
+	0x3f, 0x11, 0xd3, 0x87, 0x4a, 0x9d, 0x28, 0xca, 0xc3, 0xd1, 0x71, 0x6c, 0xce, 0xa8, 0xdc, 0xe6,
+	0xef, 0x01, 0x00, 0xe1, 0x8d, 0x04, 0x84, 0x42, 0x05, 0x00, 0x00,
 }
 
 func (m *PacketDataPrepare) Marshal() (dAtA []byte, err error) {
@@ -408,6 +488,69 @@ func (m *PacketAcknowledgementCommit) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketDataQueryDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketDataQueryDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketDataQueryDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketAcknowledgementQueryDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketAcknowledgementQueryDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketAcknowledgementQueryDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decision != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -484,6 +627,34 @@ func (m *PacketAcknowledgementCommit) Size() (n int) {
 	return n
 }
 
+func (m *PacketDataQueryDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *PacketAcknowledgementQueryDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Decision != 0 {
+		n += 1 + sovTypes(uint64(m.Decision))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -931,6 +1102,184 @@ func (m *PacketAcknowledgementCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PacketDataQueryDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketDataQueryDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketDataQueryDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketAcknowledgementQueryDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketAcknowledgementQueryDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketAcknowledgementQueryDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			m.Decision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decision |= types1.CoordinatorDecision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,19 @@
+package types
+
+// atomic module event types
+const (
+	EventTypeCommitFailed       = "commit_failed"
+	EventTypeTxCompleted        = "tx_completed"
+	EventTypePacketTimeout      = "packet_timeout"
+	EventTypeErrorACK           = "error_acknowledgement"
+	EventTypeTxResolved         = "tx_resolved"
+	EventTypeDecisionReceived   = "decision_received"
+	EventTypeTxTerminated       = "tx_terminated"
+	EventTypeStepFailed         = "step_failed"
+	EventTypeCompensationFailed = "compensation_failed"
+
+	AttributeKeyTxID         = "tx_id"
+	AttributeKeyTxIndex      = "tx_index"
+	AttributeKeyDecision     = "decision"
+	AttributeKeyErrorMessage = "error_message"
+)
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+)
+
+// NewGenesisState creates a new atomic GenesisState instance.
+func NewGenesisState(
+	coordinatorStates []IdentifiedCoordinatorState,
+	contractTransactionStates []IdentifiedContractTransactionState,
+	contractCallResults []txtypes.IdentifiedContractCallResult,
+) *GenesisState {
+	return &GenesisState{
+		CoordinatorStates:         coordinatorStates,
+		ContractTransactionStates: contractTransactionStates,
+		ContractCallResults:       contractCallResults,
+	}
+}
+
+// DefaultGenesis returns a GenesisState instance
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+// Every contract call result must belong to a contract transaction that is prepared (or pre-committed) but not committed or aborted yet.
+func (gs GenesisState) Validate() error {
+	coordinators := make(map[string]bool)
+	for _, cs := range gs.CoordinatorStates {
+		if len(cs.TxId) == 0 {
+			return fmt.Errorf("txID must not be empty")
+		} else if coordinators[string(cs.TxId)] {
+			return fmt.Errorf("duplicate coordinator state: txID=%x", cs.TxId)
+		} else if len(cs.CoordinatorState.Channels) == 0 {
+			return fmt.Errorf("coordinator state has no channels: txID=%x", cs.TxId)
+		}
+		coordinators[string(cs.TxId)] = true
+	}
+
+	txStates := make(map[string]ContractTransactionStatus)
+	for _, s := range gs.ContractTransactionStates {
+		if len(s.TxId) == 0 {
+			return fmt.Errorf("txID must not be empty")
+		}
+		key := makeTxKey(s.TxId, s.TxIndex)
+		if _, ok := txStates[key]; ok {
+			return fmt.Errorf("duplicate contract transaction state: txID=%x txIndex=%v", s.TxId, s.TxIndex)
+		}
+		txStates[key] = s.ContractTransactionState.Status
+	}
+
+	results := make(map[string]bool)
+	for _, r := range gs.ContractCallResults {
+		key := makeTxKey(r.TxId, r.TxIndex)
+		if results[key] {
+			return fmt.Errorf("duplicate contract call result: txID=%x txIndex=%v", r.TxId, r.TxIndex)
+		}
+		results[key] = true
+		status, ok := txStates[key]
+		if !ok {
+			return fmt.Errorf("contract call result doesn't belong to any contract transactions: txID=%x txIndex=%v", r.TxId, r.TxIndex)
+		} else if status != CONTRACT_TRANSACTION_STATUS_PREPARE && status != CONTRACT_TRANSACTION_STATUS_PRE_COMMIT {
+			return fmt.Errorf("contract call result belongs to a contract transaction that isn't prepared: txID=%x txIndex=%v status=%v", r.TxId, r.TxIndex, status)
+		}
+	}
+	return nil
+}
+
+func makeTxKey(txID crosstypes.TxID, txIndex crosstypes.TxIndex) string {
+	return fmt.Sprintf("%x/%v", txID, txIndex)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+)
+
+const (
+	// ProposalTypeResolveTx defines the type for a ResolveTxProposal
+	ProposalTypeResolveTx = "CrossResolveTx"
+)
+
+var _ govtypes.Content = (*ResolveTxProposal)(nil)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeResolveTx)
+	govtypes.RegisterProposalTypeCodec(&ResolveTxProposal{}, "cross/ResolveTxProposal")
+}
+
+// NewResolveTxProposal creates a new ResolveTxProposal instance
+func NewResolveTxProposal(title, description string, txID crosstypes.TxID) *ResolveTxProposal {
+	return &ResolveTxProposal{
+		Title:       title,
+		Description: description,
+		TxId:        txID,
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (p *ResolveTxProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p *ResolveTxProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p *ResolveTxProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p *ResolveTxProposal) ProposalType() string { return ProposalTypeResolveTx }
+
+// ValidateBasic implements govtypes.Content
+func (p *ResolveTxProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	} else if len(p.TxId) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing txID")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ResolveTxProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Resolve Tx Proposal:
+  Title:       %s
+  Description: %s
+  TxID:        %X
+`, p.Title, p.Description, p.TxId))
+	return b.String()
+}
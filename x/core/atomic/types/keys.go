@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	crosstypes "github.com/datachainlab/cross/x/core/types"
 	"github.com/datachainlab/cross/x/utils"
 )
@@ -10,14 +12,22 @@ import (
 const (
 	// ModuleName defines the module name
 	ModuleName = "cross-atomic"
+
+	// RouterKey defines the module's message routing key
+	// NOTE: baseapp only accepts alphanumeric route keys, so ModuleName cannot be used as is.
+	RouterKey = "crossatomic"
 )
 
 const (
 	KeyCoordinatorStatePrefix uint8 = iota
 	KeyContractTransactionStatePrefix
 	KeyContractCallResultPrefix
+	KeyTxTerminationPrefix
 )
 
+// MaxTerminatedTxsPerBlock is the maximum number of termination entries that are processed in a block
+const MaxTerminatedTxsPerBlock = 100
+
 // KeyPrefixBytes return the key prefix bytes from a URL string format
 func KeyPrefixBytes(prefix uint8) []byte {
 	return []byte(fmt.Sprintf("%d/", prefix))
@@ -39,3 +49,17 @@ func KeyContractTransactionState(txID crosstypes.TxID, txIndex crosstypes.TxInde
 		utils.Uint32ToBigEndian(txIndex)...,
 	)
 }
+
+// KeyTxTermination returns a key of the termination index by the deadline of the contract transaction
+func KeyTxTermination(timeoutTimestamp uint64, txID crosstypes.TxID, txIndex crosstypes.TxIndex) []byte {
+	return append(
+		append(
+			append(
+				KeyPrefixBytes(KeyTxTerminationPrefix),
+				sdk.Uint64ToBigEndian(timeoutTimestamp)...,
+			),
+			txID[:]...,
+		),
+		utils.Uint32ToBigEndian(txIndex)...,
+	)
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+)
+
+// msg types
+const (
+	TypeResolveTx     = "ResolveTx"
+	TypeQueryDecision = "QueryDecision"
+)
+
+var _ sdk.Msg = (*MsgResolveTx)(nil)
+
+// NewMsgResolveTx creates a new MsgResolveTx instance
+func NewMsgResolveTx(authority sdk.AccAddress, txID crosstypes.TxID) *MsgResolveTx {
+	return &MsgResolveTx{
+		Authority: authority.String(),
+		TxId:      txID,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgResolveTx) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgResolveTx) Type() string {
+	return TypeResolveTx
+}
+
+// ValidateBasic performs a basic check of the MsgResolveTx fields.
+func (msg MsgResolveTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %v", err)
+	} else if len(msg.TxId) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing txID")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgResolveTx) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgResolveTx) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+var _ sdk.Msg = (*MsgQueryDecision)(nil)
+
+// NewMsgQueryDecision creates a new MsgQueryDecision instance
+func NewMsgQueryDecision(
+	sender sdk.AccAddress, txID crosstypes.TxID, txIndex crosstypes.TxIndex,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *MsgQueryDecision {
+	return &MsgQueryDecision{
+		Sender:           sender.String(),
+		TxId:             txID,
+		TxIndex:          txIndex,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgQueryDecision) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgQueryDecision) Type() string {
+	return TypeQueryDecision
+}
+
+// ValidateBasic performs a basic check of the MsgQueryDecision fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+func (msg MsgQueryDecision) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	} else if len(msg.TxId) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing txID")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgQueryDecision) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgQueryDecision) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
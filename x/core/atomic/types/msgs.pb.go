@@ -0,0 +1,1072 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cross/core/atomic/msgs.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	github_com_datachainlab_cross_x_core_types "github.com/datachainlab/cross/x/core/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgResolveTx forces the coordinator to abort a transaction that is stuck in the prepare phase.
+// Only the authority of the module can submit it.
+type MsgResolveTx struct {
+	Authority string                                          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	TxId      github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+}
+
+func (m *MsgResolveTx) Reset()         { *m = MsgResolveTx{} }
+func (m *MsgResolveTx) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveTx) ProtoMessage()    {}
+func (*MsgResolveTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2758ccff110e988, []int{0}
+}
+func (m *MsgResolveTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveTx.Merge(m, src)
+}
+func (m *MsgResolveTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveTx proto.InternalMessageInfo
+
+// MsgResolveTxResponse defines the Msg/ResolveTx response type.
+type MsgResolveTxResponse struct {
+}
+
+func (m *MsgResolveTxResponse) Reset()         { *m = MsgResolveTxResponse{} }
+func (m *MsgResolveTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveTxResponse) ProtoMessage()    {}
+func (*MsgResolveTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2758ccff110e988, []int{1}
+}
+func (m *MsgResolveTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveTxResponse.Merge(m, src)
+}
+func (m *MsgResolveTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveTxResponse proto.InternalMessageInfo
+
+// MsgQueryDecision asks the coordinator for the decision of an in-doubt transaction that the participant has prepared.
+type MsgQueryDecision struct {
+	Sender  string                                             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TxId    github_com_datachainlab_cross_x_core_types.TxID    `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	TxIndex github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"tx_index,omitempty"`
+	// Timeout height of the packet.
+	// The timeout is disabled when set to 0.
+	TimeoutHeight types.Height `protobuf:"bytes,4,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// Timeout timestamp of the packet.
+	// The timeout is disabled when set to 0.
+	TimeoutTimestamp uint64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *MsgQueryDecision) Reset()         { *m = MsgQueryDecision{} }
+func (m *MsgQueryDecision) String() string { return proto.CompactTextString(m) }
+func (*MsgQueryDecision) ProtoMessage()    {}
+func (*MsgQueryDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2758ccff110e988, []int{2}
+}
+func (m *MsgQueryDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgQueryDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgQueryDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgQueryDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgQueryDecision.Merge(m, src)
+}
+func (m *MsgQueryDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgQueryDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgQueryDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgQueryDecision proto.InternalMessageInfo
+
+// MsgQueryDecisionResponse defines the Msg/QueryDecision response type.
+type MsgQueryDecisionResponse struct {
+}
+
+func (m *MsgQueryDecisionResponse) Reset()         { *m = MsgQueryDecisionResponse{} }
+func (m *MsgQueryDecisionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgQueryDecisionResponse) ProtoMessage()    {}
+func (*MsgQueryDecisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2758ccff110e988, []int{3}
+}
+func (m *MsgQueryDecisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgQueryDecisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgQueryDecisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgQueryDecisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgQueryDecisionResponse.Merge(m, src)
+}
+func (m *MsgQueryDecisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgQueryDecisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgQueryDecisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgQueryDecisionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgResolveTx)(nil), "cross.core.atomic.MsgResolveTx")
+	proto.RegisterType((*MsgResolveTxResponse)(nil), "cross.core.atomic.MsgResolveTxResponse")
+	proto.RegisterType((*MsgQueryDecision)(nil), "cross.core.atomic.MsgQueryDecision")
+	proto.RegisterType((*MsgQueryDecisionResponse)(nil), "cross.core.atomic.MsgQueryDecisionResponse")
+}
+
+func init() { proto.RegisterFile("cross/core/atomic/msgs.proto", fileDescriptor_f2758ccff110e988) }
+
+var fileDescriptor_f2758ccff110e988 = []byte{
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xbf, 0x6b, 0xdb, 0x40,
+	0x14, 0xc7, 0x75, 0x89, 0x93, 0xc6, 0xd7, 0xb8, 0x24, 0x22, 0x2d, 0xc2, 0xb8, 0x92, 0x50, 0x0b,
+	0x15, 0x14, 0x24, 0xec, 0x40, 0x87, 0x8c, 0x22, 0x43, 0x3c, 0x98, 0x12, 0xe1, 0x2e, 0x5d, 0xd2,
+	0xd3, 0xe9, 0x90, 0x0e, 0x2c, 0x9d, 0xd1, 0x9d, 0x8d, 0xbc, 0x75, 0xec, 0xd8, 0x3f, 0x21, 0xff,
+	0x49, 0x57, 0x8f, 0x19, 0x3b, 0x89, 0xd6, 0x5e, 0x3a, 0x67, 0xcc, 0x50, 0x8a, 0x74, 0x72, 0x63,
+	0x37, 0x85, 0xa6, 0x90, 0x49, 0x4f, 0xef, 0x7d, 0xde, 0x0f, 0xe9, 0xfb, 0x1e, 0xec, 0xe0, 0x8c,
+	0x71, 0xee, 0x62, 0x96, 0x11, 0x17, 0x09, 0x96, 0x50, 0xec, 0x26, 0x3c, 0xe2, 0xce, 0x38, 0x63,
+	0x82, 0xa9, 0x87, 0x55, 0xd4, 0x29, 0xa3, 0x8e, 0x8c, 0xb6, 0x8f, 0x22, 0x16, 0xb1, 0x2a, 0xea,
+	0x96, 0x96, 0x04, 0xdb, 0x06, 0x0d, 0xb0, 0x2c, 0x82, 0x47, 0x94, 0xa4, 0xc2, 0x9d, 0x76, 0x6b,
+	0x4b, 0x02, 0xd6, 0x47, 0x00, 0xf7, 0x07, 0x3c, 0xf2, 0x09, 0x67, 0xa3, 0x29, 0x19, 0xe6, 0x6a,
+	0x07, 0x36, 0xd1, 0x44, 0xc4, 0x2c, 0xa3, 0x62, 0xa6, 0x01, 0x13, 0xd8, 0x4d, 0xff, 0xd6, 0xa1,
+	0x9e, 0xc1, 0x1d, 0x91, 0x5f, 0xd0, 0x50, 0xdb, 0x32, 0x81, 0xbd, 0xef, 0x1d, 0xdf, 0x14, 0x86,
+	0x1b, 0x51, 0x11, 0x4f, 0x02, 0x07, 0xb3, 0xc4, 0x0d, 0x91, 0x40, 0x38, 0x46, 0x34, 0x1d, 0xa1,
+	0xc0, 0x95, 0x5f, 0x90, 0xcb, 0xf6, 0x62, 0x36, 0x26, 0xdc, 0x19, 0xe6, 0xfd, 0x53, 0xbf, 0x21,
+	0xf2, 0x7e, 0x78, 0xb2, 0xf7, 0xe9, 0xd2, 0x50, 0x7e, 0x5c, 0x1a, 0x8a, 0x65, 0xc2, 0xa3, 0xf5,
+	0x09, 0x7c, 0xc2, 0xc7, 0x2c, 0xe5, 0x64, 0x8d, 0xf8, 0xb9, 0x05, 0x0f, 0x06, 0x3c, 0x3a, 0x9f,
+	0x90, 0x6c, 0x76, 0x4a, 0x30, 0xe5, 0x94, 0xa5, 0xea, 0x33, 0xb8, 0xcb, 0x49, 0x1a, 0x92, 0xac,
+	0x9e, 0xb2, 0x7e, 0x7b, 0xb8, 0x11, 0xd5, 0x73, 0xb8, 0x57, 0x56, 0x4a, 0x43, 0x92, 0x6b, 0xdb,
+	0x26, 0xb0, 0x5b, 0xde, 0x9b, 0x9b, 0xc2, 0xe8, 0xfd, 0x5f, 0xb1, 0x32, 0xdb, 0x7f, 0x24, 0xa4,
+	0xa1, 0x7e, 0x80, 0x4f, 0x04, 0x4d, 0x08, 0x9b, 0x88, 0x8b, 0x98, 0xd0, 0x28, 0x16, 0x5a, 0xc3,
+	0x04, 0xf6, 0xe3, 0x5e, 0xdb, 0xa1, 0x01, 0x96, 0x7a, 0xd6, 0xf2, 0x4c, 0xbb, 0xce, 0x59, 0x45,
+	0x78, 0xcf, 0xe7, 0x85, 0xa1, 0x5c, 0x17, 0xc6, 0xd3, 0x19, 0x4a, 0x46, 0x27, 0xd6, 0x66, 0xbe,
+	0xe5, 0xb7, 0x6a, 0x87, 0xa4, 0xd5, 0x3e, 0x3c, 0x5c, 0x11, 0xe5, 0x93, 0x0b, 0x94, 0x8c, 0xb5,
+	0x1d, 0x13, 0xd8, 0x0d, 0xaf, 0x73, 0x5d, 0x18, 0xda, 0x66, 0x91, 0xdf, 0x88, 0xe5, 0x1f, 0xd4,
+	0xbe, 0xe1, 0xca, 0xb5, 0x26, 0xc0, 0x4b, 0xa8, 0xfd, 0xf9, 0xff, 0xef, 0xca, 0xd4, 0xfb, 0x02,
+	0xe0, 0xf6, 0x80, 0x47, 0xea, 0x3b, 0xd8, 0xbc, 0xdd, 0x27, 0xc3, 0xb9, 0xb3, 0xab, 0xce, 0xba,
+	0xdc, 0xed, 0x57, 0xff, 0x00, 0x56, 0x8d, 0x54, 0x04, 0x5b, 0x9b, 0x1b, 0xf0, 0xe2, 0xef, 0x99,
+	0x1b, 0x50, 0xfb, 0xf5, 0x3d, 0xa0, 0x55, 0x0b, 0xef, 0xed, 0xfc, 0xbb, 0xae, 0xcc, 0x17, 0x3a,
+	0xb8, 0x5a, 0xe8, 0xe0, 0xdb, 0x42, 0x07, 0x9f, 0x97, 0xba, 0x72, 0xb5, 0xd4, 0x95, 0xaf, 0x4b,
+	0x5d, 0x79, 0xdf, 0xbd, 0x97, 0xf2, 0xf5, 0xb5, 0x56, 0x0b, 0x10, 0xec, 0x56, 0x57, 0x76, 0xfc,
+	0x6b, 0x00, 0xef, 0x33, 0xd0, 0x47, 0xcf, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ResolveTx defines a rpc handler method for MsgResolveTx.
+	ResolveTx(ctx context.Context, in *MsgResolveTx, opts ...grpc.CallOption) (*MsgResolveTxResponse, error)
+	// QueryDecision defines a rpc handler method for MsgQueryDecision.
+	QueryDecision(ctx context.Context, in *MsgQueryDecision, opts ...grpc.CallOption) (*MsgQueryDecisionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ResolveTx(ctx context.Context, in *MsgResolveTx, opts ...grpc.CallOption) (*MsgResolveTxResponse, error) {
+	out := new(MsgResolveTxResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.atomic.Msg/ResolveTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) QueryDecision(ctx context.Context, in *MsgQueryDecision, opts ...grpc.CallOption) (*MsgQueryDecisionResponse, error) {
+	out := new(MsgQueryDecisionResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.atomic.Msg/QueryDecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ResolveTx defines a rpc handler method for MsgResolveTx.
+	ResolveTx(context.Context, *MsgResolveTx) (*MsgResolveTxResponse, error)
+	// QueryDecision defines a rpc handler method for MsgQueryDecision.
+	QueryDecision(context.Context, *MsgQueryDecision) (*MsgQueryDecisionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ResolveTx(ctx context.Context, req *MsgResolveTx) (*MsgResolveTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTx not implemented")
+}
+func (*UnimplementedMsgServer) QueryDecision(ctx context.Context, req *MsgQueryDecision) (*MsgQueryDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDecision not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ResolveTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.atomic.Msg/ResolveTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveTx(ctx, req.(*MsgResolveTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_QueryDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgQueryDecision)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).QueryDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.atomic.Msg/QueryDecision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).QueryDecision(ctx, req.(*MsgQueryDecision))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cross.core.atomic.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ResolveTx",
+			Handler:    _Msg_ResolveTx_Handler,
+		},
+		{
+			MethodName: "QueryDecision",
+			Handler:    _Msg_QueryDecision_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cross/core/atomic/msgs.proto",
+}
+
+func (m *MsgResolveTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgQueryDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgQueryDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgQueryDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TxIndex != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgQueryDecisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgQueryDecisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgQueryDecisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgResolveTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgQueryDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovMsgs(uint64(m.TxIndex))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovMsgs(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MsgQueryDecisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgResolveTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgQueryDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgQueryDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgQueryDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgQueryDecisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgQueryDecisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgQueryDecisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgs = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	github_com_datachainlab_cross_x_core_types "github.com/datachainlab/cross/x/core/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -104,37 +105,388 @@ func (m *QueryCoordinatorStateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCoordinatorStateResponse proto.InternalMessageInfo
 
+type QueryTxFinalizationRequest struct {
+	TxId github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+}
+
+func (m *QueryTxFinalizationRequest) Reset()         { *m = QueryTxFinalizationRequest{} }
+func (m *QueryTxFinalizationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxFinalizationRequest) ProtoMessage()    {}
+func (*QueryTxFinalizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c8df456f56ba8a, []int{2}
+}
+func (m *QueryTxFinalizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxFinalizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxFinalizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxFinalizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxFinalizationRequest.Merge(m, src)
+}
+func (m *QueryTxFinalizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxFinalizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxFinalizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxFinalizationRequest proto.InternalMessageInfo
+
+type QueryTxFinalizationResponse struct {
+	// completed indicates whether the coordinator has received all acknowledgements of the commit
+	Completed      bool                `protobuf:"varint,1,opt,name=completed,proto3" json:"completed,omitempty"`
+	Phase          CoordinatorPhase    `protobuf:"varint,2,opt,name=phase,proto3,enum=cross.core.atomic.CoordinatorPhase" json:"phase,omitempty"`
+	Decision       CoordinatorDecision `protobuf:"varint,3,opt,name=decision,proto3,enum=cross.core.atomic.CoordinatorDecision" json:"decision,omitempty"`
+	CommitFailures []CommitFailure     `protobuf:"bytes,4,rep,name=commit_failures,json=commitFailures,proto3" json:"commit_failures"`
+}
+
+func (m *QueryTxFinalizationResponse) Reset()         { *m = QueryTxFinalizationResponse{} }
+func (m *QueryTxFinalizationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxFinalizationResponse) ProtoMessage()    {}
+func (*QueryTxFinalizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c8df456f56ba8a, []int{3}
+}
+func (m *QueryTxFinalizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxFinalizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxFinalizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxFinalizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxFinalizationResponse.Merge(m, src)
+}
+func (m *QueryTxFinalizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxFinalizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxFinalizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxFinalizationResponse proto.InternalMessageInfo
+
+type QueryCoordinatorStatesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// phase filters the states by the phase if it isn't COORDINATOR_PHASE_UNKNOWN
+	Phase CoordinatorPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=cross.core.atomic.CoordinatorPhase" json:"phase,omitempty"`
+	// decision filters the states by the decision if it isn't COORDINATOR_DECISION_UNKNOWN
+	Decision CoordinatorDecision `protobuf:"varint,3,opt,name=decision,proto3,enum=cross.core.atomic.CoordinatorDecision" json:"decision,omitempty"`
+}
+
+func (m *QueryCoordinatorStatesRequest) Reset()         { *m = QueryCoordinatorStatesRequest{} }
+func (m *QueryCoordinatorStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCoordinatorStatesRequest) ProtoMessage()    {}
+func (*QueryCoordinatorStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c8df456f56ba8a, []int{4}
+}
+func (m *QueryCoordinatorStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoordinatorStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoordinatorStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCoordinatorStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoordinatorStatesRequest.Merge(m, src)
+}
+func (m *QueryCoordinatorStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoordinatorStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoordinatorStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCoordinatorStatesRequest proto.InternalMessageInfo
+
+type QueryCoordinatorStatesResponse struct {
+	CoordinatorStates []IdentifiedCoordinatorState `protobuf:"bytes,1,rep,name=coordinator_states,json=coordinatorStates,proto3" json:"coordinator_states"`
+	Pagination        *query.PageResponse          `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCoordinatorStatesResponse) Reset()         { *m = QueryCoordinatorStatesResponse{} }
+func (m *QueryCoordinatorStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCoordinatorStatesResponse) ProtoMessage()    {}
+func (*QueryCoordinatorStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c8df456f56ba8a, []int{5}
+}
+func (m *QueryCoordinatorStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCoordinatorStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCoordinatorStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCoordinatorStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCoordinatorStatesResponse.Merge(m, src)
+}
+func (m *QueryCoordinatorStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCoordinatorStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCoordinatorStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCoordinatorStatesResponse proto.InternalMessageInfo
+
+type QueryContractTransactionStateRequest struct {
+	TxId    github_com_datachainlab_cross_x_core_types.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	TxIndex github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"tx_index,omitempty"`
+}
+
+func (m *QueryContractTransactionStateRequest) Reset()         { *m = QueryContractTransactionStateRequest{} }
+func (m *QueryContractTransactionStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTransactionStateRequest) ProtoMessage()    {}
+func (*QueryContractTransactionStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c8df456f56ba8a, []int{6}
+}
+func (m *QueryContractTransactionStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTransactionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTransactionStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTransactionStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTransactionStateRequest.Merge(m, src)
+}
+func (m *QueryContractTransactionStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTransactionStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTransactionStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTransactionStateRequest proto.InternalMessageInfo
+
+type QueryContractTransactionStateResponse struct {
+	ContractTransactionState ContractTransactionState `protobuf:"bytes,1,opt,name=contract_transaction_state,json=contractTransactionState,proto3" json:"contract_transaction_state"`
+}
+
+func (m *QueryContractTransactionStateResponse) Reset()         { *m = QueryContractTransactionStateResponse{} }
+func (m *QueryContractTransactionStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTransactionStateResponse) ProtoMessage()    {}
+func (*QueryContractTransactionStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c8df456f56ba8a, []int{7}
+}
+func (m *QueryContractTransactionStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTransactionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTransactionStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTransactionStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTransactionStateResponse.Merge(m, src)
+}
+func (m *QueryContractTransactionStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTransactionStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTransactionStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTransactionStateResponse proto.InternalMessageInfo
+
+type QueryContractTransactionStatesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status filters the states by the status if it isn't CONTRACT_TRANSACTION_STATUS_UNKNOWN
+	Status ContractTransactionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cross.core.atomic.ContractTransactionStatus" json:"status,omitempty"`
+}
+
+func (m *QueryContractTransactionStatesRequest) Reset()         { *m = QueryContractTransactionStatesRequest{} }
+func (m *QueryContractTransactionStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTransactionStatesRequest) ProtoMessage()    {}
+func (*QueryContractTransactionStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c8df456f56ba8a, []int{8}
+}
+func (m *QueryContractTransactionStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTransactionStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTransactionStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTransactionStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTransactionStatesRequest.Merge(m, src)
+}
+func (m *QueryContractTransactionStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTransactionStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTransactionStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTransactionStatesRequest proto.InternalMessageInfo
+
+type QueryContractTransactionStatesResponse struct {
+	ContractTransactionStates []IdentifiedContractTransactionState `protobuf:"bytes,1,rep,name=contract_transaction_states,json=contractTransactionStates,proto3" json:"contract_transaction_states"`
+	Pagination                *query.PageResponse                  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractTransactionStatesResponse) Reset() {
+	*m = QueryContractTransactionStatesResponse{}
+}
+func (m *QueryContractTransactionStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTransactionStatesResponse) ProtoMessage()    {}
+func (*QueryContractTransactionStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c8df456f56ba8a, []int{9}
+}
+func (m *QueryContractTransactionStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTransactionStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTransactionStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTransactionStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTransactionStatesResponse.Merge(m, src)
+}
+func (m *QueryContractTransactionStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTransactionStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTransactionStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTransactionStatesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryCoordinatorStateRequest)(nil), "cross.core.atomic.QueryCoordinatorStateRequest")
 	proto.RegisterType((*QueryCoordinatorStateResponse)(nil), "cross.core.atomic.QueryCoordinatorStateResponse")
+	proto.RegisterType((*QueryTxFinalizationRequest)(nil), "cross.core.atomic.QueryTxFinalizationRequest")
+	proto.RegisterType((*QueryTxFinalizationResponse)(nil), "cross.core.atomic.QueryTxFinalizationResponse")
+	proto.RegisterType((*QueryCoordinatorStatesRequest)(nil), "cross.core.atomic.QueryCoordinatorStatesRequest")
+	proto.RegisterType((*QueryCoordinatorStatesResponse)(nil), "cross.core.atomic.QueryCoordinatorStatesResponse")
+	proto.RegisterType((*QueryContractTransactionStateRequest)(nil), "cross.core.atomic.QueryContractTransactionStateRequest")
+	proto.RegisterType((*QueryContractTransactionStateResponse)(nil), "cross.core.atomic.QueryContractTransactionStateResponse")
+	proto.RegisterType((*QueryContractTransactionStatesRequest)(nil), "cross.core.atomic.QueryContractTransactionStatesRequest")
+	proto.RegisterType((*QueryContractTransactionStatesResponse)(nil), "cross.core.atomic.QueryContractTransactionStatesResponse")
 }
 
 func init() { proto.RegisterFile("cross/core/atomic/query.proto", fileDescriptor_54c8df456f56ba8a) }
 
 var fileDescriptor_54c8df456f56ba8a = []byte{
-	// 347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2e, 0xca, 0x2f,
-	0x2e, 0xd6, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0x2c, 0xc9, 0xcf, 0xcd, 0x4c, 0xd6, 0x2f, 0x2c,
-	0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x4b, 0xeb, 0x81, 0xa4,
-	0xf5, 0x20, 0xd2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x59, 0x7d, 0x10, 0x0b, 0xa2, 0x50,
-	0x0a, 0x8b, 0x39, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x50, 0x69, 0x99, 0xf4, 0xfc, 0xfc, 0xf4, 0x9c,
-	0x54, 0xfd, 0xc4, 0x82, 0x4c, 0xfd, 0xc4, 0xbc, 0xbc, 0xfc, 0x92, 0xc4, 0x92, 0xcc, 0xfc, 0x3c,
-	0xa8, 0xac, 0x52, 0x06, 0x97, 0x4c, 0x20, 0xc8, 0x52, 0xe7, 0xfc, 0xfc, 0xa2, 0x94, 0xcc, 0xbc,
-	0xc4, 0x92, 0xfc, 0xa2, 0xe0, 0x92, 0xc4, 0x92, 0xd4, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12,
-	0x21, 0x0f, 0x2e, 0xd6, 0x92, 0x8a, 0xf8, 0xcc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x1e, 0x27,
-	0xe3, 0x5f, 0xf7, 0xe4, 0xf5, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x53, 0x12, 0x4b, 0x12, 0x93, 0x33, 0x12, 0x33, 0xf3, 0x72, 0x12, 0x93, 0xf4, 0x21, 0xee, 0xa8,
-	0x80, 0xb8, 0x04, 0xe2, 0x84, 0x90, 0x0a, 0x4f, 0x97, 0x20, 0x96, 0x92, 0x0a, 0xcf, 0x14, 0xa5,
-	0x52, 0x2e, 0x59, 0x1c, 0x36, 0x15, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0x85, 0x70, 0x09, 0x24,
-	0xe7, 0xe7, 0x43, 0xa5, 0xe2, 0x8b, 0x41, 0x72, 0x60, 0x5b, 0xb9, 0x8d, 0x94, 0xf5, 0x30, 0xc2,
-	0x42, 0x0f, 0xdd, 0x18, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xf8, 0x11, 0x46, 0x80, 0x85,
-	0x8d, 0xd6, 0x32, 0x72, 0xb1, 0x82, 0xed, 0x15, 0x5a, 0xcc, 0xc8, 0x25, 0x80, 0xae, 0x4b, 0x48,
-	0x1f, 0x8b, 0xd1, 0xf8, 0x02, 0x44, 0xca, 0x80, 0x78, 0x0d, 0x10, 0x7f, 0x29, 0xe9, 0x34, 0x5d,
-	0x7e, 0x32, 0x99, 0x49, 0x4d, 0x48, 0x45, 0x1f, 0x33, 0xa2, 0x92, 0x11, 0x9a, 0x74, 0xc1, 0x3e,
-	0x76, 0xf2, 0x3f, 0xf1, 0x50, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
-	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
-	0xa2, 0x0c, 0x89, 0x0a, 0x7b, 0xe4, 0x54, 0x90, 0xc4, 0x06, 0x8e, 0x68, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xe1, 0x85, 0xe0, 0xae, 0x6f, 0x02, 0x00, 0x00,
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x4f, 0x33, 0x45,
+	0x18, 0xef, 0xf4, 0x85, 0xf7, 0xc5, 0x79, 0x95, 0xf7, 0x65, 0xe2, 0xa1, 0x2c, 0xb0, 0x34, 0x2b,
+	0x14, 0x82, 0x74, 0x97, 0x96, 0x80, 0x72, 0xad, 0x04, 0xe5, 0x04, 0xac, 0x3d, 0x79, 0x69, 0xa6,
+	0xb3, 0xd3, 0x76, 0x93, 0x76, 0xa7, 0xec, 0x4c, 0x4d, 0xd1, 0x9b, 0x17, 0x0f, 0x5e, 0x4c, 0xbc,
+	0x98, 0x78, 0xf4, 0xe0, 0x27, 0xf0, 0x13, 0xe8, 0xa1, 0x17, 0x13, 0x12, 0x2f, 0x9e, 0x88, 0x82,
+	0xf1, 0xe8, 0x07, 0xe0, 0x64, 0x76, 0x66, 0x4a, 0x0b, 0xec, 0xf6, 0x8f, 0xc1, 0x78, 0xdb, 0xec,
+	0xf3, 0xef, 0xf7, 0xfb, 0x3d, 0xcf, 0x33, 0x33, 0x70, 0x85, 0x84, 0x8c, 0x73, 0x87, 0xb0, 0x90,
+	0x3a, 0x58, 0xb0, 0x96, 0x4f, 0x9c, 0xf3, 0x0e, 0x0d, 0x2f, 0xec, 0x76, 0xc8, 0x04, 0x43, 0x0b,
+	0xd2, 0x6c, 0x47, 0x66, 0x5b, 0x99, 0x8d, 0xb7, 0xeb, 0xac, 0xce, 0xa4, 0xd5, 0x89, 0xbe, 0x94,
+	0xa3, 0x11, 0x93, 0x47, 0x5c, 0xb4, 0x29, 0xd7, 0xe6, 0xe5, 0x3a, 0x63, 0xf5, 0x26, 0x75, 0x70,
+	0xdb, 0x77, 0x70, 0x10, 0x30, 0x81, 0x85, 0xcf, 0x82, 0xbe, 0x75, 0x8b, 0x30, 0xde, 0x62, 0xdc,
+	0xa9, 0x62, 0x4e, 0x55, 0x79, 0xe7, 0xd3, 0x42, 0x95, 0x0a, 0x5c, 0x70, 0xda, 0xb8, 0xee, 0x07,
+	0xd2, 0x59, 0xf9, 0x5a, 0x0d, 0xb8, 0x7c, 0x16, 0x79, 0x7c, 0xc0, 0x58, 0xe8, 0x45, 0x26, 0x16,
+	0x7e, 0x2c, 0xb0, 0xa0, 0x2e, 0x3d, 0xef, 0x50, 0x2e, 0xd0, 0x47, 0x70, 0x56, 0x74, 0x2b, 0xbe,
+	0x97, 0x01, 0x59, 0xb0, 0xf9, 0x66, 0x69, 0xf7, 0xf6, 0x6a, 0xd5, 0xa9, 0xfb, 0xa2, 0xd1, 0xa9,
+	0xda, 0x84, 0xb5, 0x1c, 0x0f, 0x0b, 0x4c, 0x1a, 0xd8, 0x0f, 0x9a, 0xb8, 0xea, 0x28, 0xcc, 0x5d,
+	0x85, 0x5a, 0xc1, 0x2d, 0x77, 0x8f, 0x0f, 0xdd, 0x19, 0xd1, 0x3d, 0xf6, 0xac, 0x0e, 0x5c, 0x49,
+	0xa8, 0xc4, 0xdb, 0x2c, 0xe0, 0x14, 0x95, 0xe1, 0x6b, 0xc2, 0x98, 0x36, 0x55, 0x78, 0x64, 0x93,
+	0x55, 0x5f, 0x16, 0xdf, 0xb1, 0x1f, 0xe9, 0x66, 0x3f, 0x4c, 0x53, 0x9a, 0xe9, 0x5d, 0xad, 0xa6,
+	0xdc, 0x57, 0x83, 0x14, 0xf2, 0xb7, 0x55, 0x83, 0x86, 0x2c, 0x5b, 0xee, 0x1e, 0xf9, 0x01, 0x6e,
+	0xfa, 0x9f, 0x49, 0xf6, 0x4f, 0x4f, 0xef, 0xab, 0x34, 0x5c, 0x8a, 0x2d, 0xa4, 0xd9, 0x2d, 0xc3,
+	0x37, 0x08, 0x6b, 0xb5, 0x9b, 0x54, 0x50, 0x55, 0x6d, 0xce, 0x1d, 0xfc, 0x40, 0x07, 0x70, 0xb6,
+	0xdd, 0xc0, 0x9c, 0x66, 0xd2, 0x59, 0xb0, 0x39, 0x3f, 0x8e, 0xf0, 0x69, 0xe4, 0xea, 0xaa, 0x08,
+	0x54, 0x82, 0x73, 0x1e, 0x25, 0x3e, 0xf7, 0x59, 0x90, 0x79, 0x26, 0xa3, 0x73, 0xa3, 0xa3, 0x0f,
+	0xb5, 0xb7, 0x7b, 0x17, 0x87, 0x4e, 0xe0, 0x2b, 0xc2, 0x5a, 0x2d, 0x5f, 0x54, 0x6a, 0xd8, 0x6f,
+	0x76, 0x42, 0xca, 0x33, 0x33, 0xd9, 0x67, 0x9b, 0x2f, 0x8b, 0xd9, 0xd8, 0x54, 0x91, 0xe7, 0x91,
+	0x72, 0xd4, 0xb2, 0xcf, 0x93, 0xe1, 0x9f, 0xdc, 0xfa, 0x0b, 0x24, 0x74, 0x9b, 0xf7, 0x95, 0x3f,
+	0x82, 0x70, 0x30, 0x8c, 0xba, 0xcf, 0x39, 0x5b, 0x4d, 0xae, 0x1d, 0x4d, 0xae, 0xad, 0x16, 0x47,
+	0x4f, 0xae, 0x7d, 0x8a, 0xeb, 0xfd, 0xa1, 0x74, 0x87, 0x22, 0xff, 0x67, 0xe5, 0xac, 0x5f, 0x00,
+	0x34, 0x93, 0x88, 0xea, 0xce, 0x57, 0x21, 0x22, 0x03, 0xa3, 0x1a, 0x6c, 0x9e, 0x01, 0x52, 0xdf,
+	0x7c, 0x4c, 0xc1, 0x63, 0x8f, 0x06, 0xc2, 0xaf, 0xf9, 0xd4, 0x4b, 0x98, 0xf1, 0x05, 0xf2, 0xb0,
+	0x16, 0xfa, 0xf0, 0x9e, 0x9a, 0x69, 0xa9, 0xe6, 0xc6, 0x58, 0x35, 0x15, 0xc0, 0x61, 0x39, 0xad,
+	0x9f, 0x00, 0x5c, 0xd3, 0x7c, 0x02, 0x11, 0x62, 0x22, 0xca, 0x21, 0x0e, 0x38, 0x26, 0x91, 0xf1,
+	0xbf, 0x39, 0x18, 0xd0, 0x19, 0x9c, 0x8b, 0x32, 0x05, 0x1e, 0xed, 0x4a, 0xe4, 0x6f, 0x95, 0xf6,
+	0x6f, 0xaf, 0x56, 0x8b, 0xd3, 0x25, 0x8b, 0xa2, 0xdd, 0x17, 0x42, 0x7d, 0x58, 0xdf, 0x02, 0xb8,
+	0x3e, 0x86, 0x85, 0x6e, 0x0e, 0x83, 0x06, 0xd1, 0x3e, 0x15, 0x31, 0x70, 0xba, 0x77, 0xfc, 0xbc,
+	0x1b, 0x3b, 0x15, 0xf1, 0x89, 0x75, 0x8b, 0x32, 0x24, 0xc1, 0x6e, 0xfd, 0x38, 0x0e, 0xda, 0x93,
+	0x6f, 0xc8, 0x21, 0x7c, 0x1e, 0xb1, 0xe9, 0x70, 0xbd, 0x22, 0xdb, 0x93, 0xd3, 0xe9, 0x70, 0x57,
+	0xc7, 0x5a, 0x7f, 0x03, 0x98, 0x1b, 0x87, 0x5b, 0x6b, 0xfa, 0x39, 0x5c, 0x4a, 0xd6, 0xb4, 0x3f,
+	0xf9, 0x7b, 0x63, 0x26, 0x7f, 0xa4, 0xbc, 0x8b, 0x49, 0xf2, 0x3e, 0xdd, 0x26, 0x14, 0xbf, 0x7c,
+	0x01, 0x67, 0x25, 0x61, 0xf4, 0x3d, 0x80, 0xaf, 0x1f, 0xae, 0x22, 0x72, 0x62, 0xf0, 0x8f, 0xba,
+	0x49, 0x8d, 0x9d, 0xc9, 0x03, 0x14, 0x1a, 0x6b, 0xfb, 0x8b, 0x5f, 0xff, 0xfc, 0x26, 0x9d, 0x43,
+	0x6b, 0xce, 0xe3, 0xd7, 0xc0, 0xd0, 0x11, 0x90, 0x97, 0xba, 0xa2, 0xef, 0x00, 0x9c, 0xbf, 0x7f,
+	0xf7, 0xa0, 0x7c, 0x52, 0xc9, 0xd8, 0xcb, 0xd0, 0xb0, 0x27, 0x75, 0xd7, 0xf8, 0xb6, 0x24, 0xbe,
+	0x35, 0x64, 0xc5, 0xe0, 0x13, 0xdd, 0x7c, 0x6d, 0x18, 0xca, 0x0f, 0x00, 0x2e, 0x3c, 0x3a, 0x22,
+	0xd1, 0xc4, 0x9a, 0xf4, 0x97, 0xc2, 0x28, 0x4c, 0x11, 0xa1, 0x61, 0xe6, 0x25, 0xcc, 0x0d, 0xb4,
+	0x3e, 0x89, 0x8c, 0x1c, 0xfd, 0x0c, 0x60, 0x26, 0x69, 0xfc, 0xd0, 0x7b, 0xc9, 0xe5, 0x47, 0x1e,
+	0x97, 0xc6, 0xfb, 0xd3, 0x07, 0x6a, 0xf8, 0x7b, 0x12, 0xbe, 0x83, 0xf2, 0xb1, 0xf0, 0x55, 0x70,
+	0x7e, 0x68, 0xcd, 0xf4, 0x38, 0xf4, 0x00, 0x5c, 0x4c, 0x5c, 0x55, 0x34, 0x35, 0x9c, 0xbb, 0x06,
+	0x1c, 0xfc, 0x8b, 0x48, 0xcd, 0x64, 0x5f, 0x32, 0xd9, 0x41, 0xf6, 0x54, 0x4c, 0x78, 0xe9, 0xa4,
+	0xf7, 0x87, 0x99, 0xea, 0x5d, 0x9b, 0xe0, 0xf2, 0xda, 0x04, 0xbf, 0x5f, 0x9b, 0xe0, 0xeb, 0x1b,
+	0x33, 0x75, 0x79, 0x63, 0xa6, 0x7e, 0xbb, 0x31, 0x53, 0x9f, 0x14, 0x26, 0xba, 0x28, 0x86, 0x1f,
+	0xd1, 0xd5, 0xe7, 0xf2, 0xed, 0xbb, 0xfb, 0xcf, 0x00, 0x8d, 0x3f, 0x28, 0x43, 0xae, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -150,6 +502,10 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	CoordinatorState(ctx context.Context, in *QueryCoordinatorStateRequest, opts ...grpc.CallOption) (*QueryCoordinatorStateResponse, error)
+	TxFinalization(ctx context.Context, in *QueryTxFinalizationRequest, opts ...grpc.CallOption) (*QueryTxFinalizationResponse, error)
+	CoordinatorStates(ctx context.Context, in *QueryCoordinatorStatesRequest, opts ...grpc.CallOption) (*QueryCoordinatorStatesResponse, error)
+	ContractTransactionState(ctx context.Context, in *QueryContractTransactionStateRequest, opts ...grpc.CallOption) (*QueryContractTransactionStateResponse, error)
+	ContractTransactionStates(ctx context.Context, in *QueryContractTransactionStatesRequest, opts ...grpc.CallOption) (*QueryContractTransactionStatesResponse, error)
 }
 
 type queryClient struct {
@@ -169,9 +525,49 @@ func (c *queryClient) CoordinatorState(ctx context.Context, in *QueryCoordinator
 	return out, nil
 }
 
+func (c *queryClient) TxFinalization(ctx context.Context, in *QueryTxFinalizationRequest, opts ...grpc.CallOption) (*QueryTxFinalizationResponse, error) {
+	out := new(QueryTxFinalizationResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.atomic.Query/TxFinalization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CoordinatorStates(ctx context.Context, in *QueryCoordinatorStatesRequest, opts ...grpc.CallOption) (*QueryCoordinatorStatesResponse, error) {
+	out := new(QueryCoordinatorStatesResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.atomic.Query/CoordinatorStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractTransactionState(ctx context.Context, in *QueryContractTransactionStateRequest, opts ...grpc.CallOption) (*QueryContractTransactionStateResponse, error) {
+	out := new(QueryContractTransactionStateResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.atomic.Query/ContractTransactionState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractTransactionStates(ctx context.Context, in *QueryContractTransactionStatesRequest, opts ...grpc.CallOption) (*QueryContractTransactionStatesResponse, error) {
+	out := new(QueryContractTransactionStatesResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.atomic.Query/ContractTransactionStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	CoordinatorState(context.Context, *QueryCoordinatorStateRequest) (*QueryCoordinatorStateResponse, error)
+	TxFinalization(context.Context, *QueryTxFinalizationRequest) (*QueryTxFinalizationResponse, error)
+	CoordinatorStates(context.Context, *QueryCoordinatorStatesRequest) (*QueryCoordinatorStatesResponse, error)
+	ContractTransactionState(context.Context, *QueryContractTransactionStateRequest) (*QueryContractTransactionStateResponse, error)
+	ContractTransactionStates(context.Context, *QueryContractTransactionStatesRequest) (*QueryContractTransactionStatesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -181,6 +577,18 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) CoordinatorState(ctx context.Context, req *QueryCoordinatorStateRequest) (*QueryCoordinatorStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoordinatorState not implemented")
 }
+func (*UnimplementedQueryServer) TxFinalization(ctx context.Context, req *QueryTxFinalizationRequest) (*QueryTxFinalizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxFinalization not implemented")
+}
+func (*UnimplementedQueryServer) CoordinatorStates(ctx context.Context, req *QueryCoordinatorStatesRequest) (*QueryCoordinatorStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoordinatorStates not implemented")
+}
+func (*UnimplementedQueryServer) ContractTransactionState(ctx context.Context, req *QueryContractTransactionStateRequest) (*QueryContractTransactionStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractTransactionState not implemented")
+}
+func (*UnimplementedQueryServer) ContractTransactionStates(ctx context.Context, req *QueryContractTransactionStatesRequest) (*QueryContractTransactionStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractTransactionStates not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -204,41 +612,129 @@ func _Query_CoordinatorState_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cross.core.atomic.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CoordinatorState",
-			Handler:    _Query_CoordinatorState_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cross/core/atomic/query.proto",
+func _Query_TxFinalization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxFinalizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxFinalization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.atomic.Query/TxFinalization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxFinalization(ctx, req.(*QueryTxFinalizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCoordinatorStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CoordinatorStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCoordinatorStatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CoordinatorStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.atomic.Query/CoordinatorStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoordinatorStates(ctx, req.(*QueryCoordinatorStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCoordinatorStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ContractTransactionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractTransactionStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractTransactionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.atomic.Query/ContractTransactionState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractTransactionState(ctx, req.(*QueryContractTransactionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCoordinatorStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxId) > 0 {
-		i -= len(m.TxId)
+func _Query_ContractTransactionStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractTransactionStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractTransactionStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.atomic.Query/ContractTransactionStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractTransactionStates(ctx, req.(*QueryContractTransactionStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cross.core.atomic.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CoordinatorState",
+			Handler:    _Query_CoordinatorState_Handler,
+		},
+		{
+			MethodName: "TxFinalization",
+			Handler:    _Query_TxFinalization_Handler,
+		},
+		{
+			MethodName: "CoordinatorStates",
+			Handler:    _Query_CoordinatorStates_Handler,
+		},
+		{
+			MethodName: "ContractTransactionState",
+			Handler:    _Query_ContractTransactionState_Handler,
+		},
+		{
+			MethodName: "ContractTransactionStates",
+			Handler:    _Query_ContractTransactionStates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cross/core/atomic/query.proto",
+}
+
+func (m *QueryCoordinatorStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoordinatorStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoordinatorStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
 		copy(dAtA[i:], m.TxId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxId)))
 		i--
@@ -280,48 +776,1178 @@ func (m *QueryCoordinatorStateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTxFinalizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCoordinatorStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryTxFinalizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxFinalizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxFinalizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxFinalizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxFinalizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CommitFailures) > 0 {
+		for iNdEx := len(m.CommitFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Decision != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoordinatorStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoordinatorStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoordinatorStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decision != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCoordinatorStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCoordinatorStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCoordinatorStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CoordinatorStates) > 0 {
+		for iNdEx := len(m.CoordinatorStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoordinatorStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractTransactionStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractTransactionStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractTransactionStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractTransactionStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractTransactionStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractTransactionStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContractTransactionState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractTransactionStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractTransactionStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractTransactionStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractTransactionStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractTransactionStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractTransactionStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractTransactionStates) > 0 {
+		for iNdEx := len(m.ContractTransactionStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractTransactionStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCoordinatorStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCoordinatorStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoodinatorState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTxFinalizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxFinalizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Completed {
+		n += 2
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.Decision != 0 {
+		n += 1 + sovQuery(uint64(m.Decision))
+	}
+	if len(m.CommitFailures) > 0 {
+		for _, e := range m.CommitFailures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCoordinatorStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.Decision != 0 {
+		n += 1 + sovQuery(uint64(m.Decision))
+	}
+	return n
+}
+
+func (m *QueryCoordinatorStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CoordinatorStates) > 0 {
+		for _, e := range m.CoordinatorStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractTransactionStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *QueryContractTransactionStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractTransactionState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractTransactionStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryContractTransactionStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractTransactionStates) > 0 {
+		for _, e := range m.ContractTransactionStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCoordinatorStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoordinatorStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoordinatorStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCoordinatorStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoordinatorStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoordinatorStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoodinatorState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoodinatorState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxFinalizationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxFinalizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxFinalizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxFinalizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxFinalizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxFinalizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= CoordinatorPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			m.Decision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decision |= CoordinatorDecision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitFailures = append(m.CommitFailures, CommitFailure{})
+			if err := m.CommitFailures[len(m.CommitFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCoordinatorStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoordinatorStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoordinatorStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= CoordinatorPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			m.Decision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decision |= CoordinatorDecision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCoordinatorStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCoordinatorStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCoordinatorStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorStates = append(m.CoordinatorStates, IdentifiedCoordinatorState{})
+			if err := m.CoordinatorStates[len(m.CoordinatorStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryCoordinatorStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.CoodinatorState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryCoordinatorStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractTransactionStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -344,10 +1970,10 @@ func (m *QueryCoordinatorStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoordinatorStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractTransactionStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoordinatorStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractTransactionStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -384,6 +2010,25 @@ func (m *QueryCoordinatorStateRequest) Unmarshal(dAtA []byte) error {
 				m.TxId = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -408,7 +2053,7 @@ func (m *QueryCoordinatorStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCoordinatorStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractTransactionStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -431,15 +2076,15 @@ func (m *QueryCoordinatorStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCoordinatorStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractTransactionStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCoordinatorStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractTransactionStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoodinatorState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTransactionState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -466,7 +2111,238 @@ func (m *QueryCoordinatorStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoodinatorState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractTransactionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractTransactionStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractTransactionStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractTransactionStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ContractTransactionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractTransactionStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractTransactionStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractTransactionStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTransactionStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractTransactionStates = append(m.ContractTransactionStates, IdentifiedContractTransactionState{})
+			if err := m.ContractTransactionStates[len(m.ContractTransactionStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
 	crosstypes "github.com/datachainlab/cross/x/core/types"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
@@ -55,11 +57,31 @@ func (cs *CoordinatorState) AddAck(txIndex crosstypes.TxIndex) bool {
 	return true
 }
 
+// HasAck returns a boolean whether the ack of txIndex is already received
+func (cs CoordinatorState) HasAck(txIndex crosstypes.TxIndex) bool {
+	for _, id := range cs.Acks {
+		if txIndex == id {
+			return true
+		}
+	}
+	return false
+}
+
 // IsConfirmedALLCommits returns a boolean whether all acks are received
 func (cs *CoordinatorState) IsConfirmedALLCommits() bool {
 	return len(cs.Channels) == len(cs.Acks)
 }
 
+// AddCommitFailure records a failure of the commit reported by the participant
+func (cs *CoordinatorState) AddCommitFailure(txIndex crosstypes.TxIndex, errorMessage string) {
+	cs.CommitFailures = append(cs.CommitFailures, CommitFailure{TxIndex: txIndex, ErrorMessage: errorMessage})
+}
+
+// IsCompleted returns a boolean whether the transaction is finalized
+func (cs CoordinatorState) IsCompleted() bool {
+	return cs.Phase == COORDINATOR_PHASE_COMPLETED
+}
+
 // NewContractTransactionState creates a new instance of ContractTransactionState
 func NewContractTransactionState(status ContractTransactionStatus, prepareResult PrepareResult, coordinatorChannel xcctypes.ChannelInfo) ContractTransactionState {
 	return ContractTransactionState{
@@ -68,3 +90,50 @@ func NewContractTransactionState(status ContractTransactionStatus, prepareResult
 		CoordinatorChannel: coordinatorChannel,
 	}
 }
+
+// NewSagaState creates a new instance of SagaState whose steps haven't been started yet
+func NewSagaState(transactions []txtypes.ResolvedContractTransaction, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) SagaState {
+	steps := make([]SagaStep, len(transactions))
+	for i, tx := range transactions {
+		steps[i] = SagaStep{Tx: tx, Status: SAGA_STEP_STATUS_UNKNOWN}
+	}
+	return SagaState{
+		Steps:            steps,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// NextStep returns the first step that hasn't been started yet
+func (s SagaState) NextStep() (crosstypes.TxIndex, bool) {
+	for i, step := range s.Steps {
+		if step.Status == SAGA_STEP_STATUS_UNKNOWN {
+			return crosstypes.TxIndex(i), true
+		}
+	}
+	return 0, false
+}
+
+// LastCompletedStep returns the last step that is completed and hasn't been compensated yet
+func (s SagaState) LastCompletedStep() (crosstypes.TxIndex, bool) {
+	for i := len(s.Steps) - 1; i >= 0; i-- {
+		if s.Steps[i].Status == SAGA_STEP_STATUS_COMPLETED {
+			return crosstypes.TxIndex(i), true
+		}
+	}
+	return 0, false
+}
+
+// IsTimedOut returns a boolean whether the timeout of the tx has passed
+func (s SagaState) IsTimedOut(blockHeight uint64, blockTime uint64) bool {
+	return (!s.TimeoutHeight.IsZero() && blockHeight >= s.TimeoutHeight.GetRevisionHeight()) ||
+		(s.TimeoutTimestamp != 0 && blockTime >= s.TimeoutTimestamp)
+}
+
+// TxTermination is an entry of the termination index.
+// The participant terminates the contract transaction by itself when the deadline has passed.
+type TxTermination struct {
+	TimeoutTimestamp uint64
+	TxID             crosstypes.TxID
+	TxIndex          crosstypes.TxIndex
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types2 "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	types "github.com/datachainlab/cross/x/core/tx/types"
 	github_com_datachainlab_cross_x_core_types "github.com/datachainlab/cross/x/core/types"
 	types1 "github.com/datachainlab/cross/x/core/xcc/types"
@@ -26,24 +27,82 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type SagaStepStatus int32
+
+const (
+	// SAGA_STEP_STATUS_UNKNOWN indicates that the step hasn't been started yet
+	SAGA_STEP_STATUS_UNKNOWN             SagaStepStatus = 0
+	SAGA_STEP_STATUS_PENDING             SagaStepStatus = 1
+	SAGA_STEP_STATUS_COMPLETED           SagaStepStatus = 2
+	SAGA_STEP_STATUS_FAILED              SagaStepStatus = 3
+	SAGA_STEP_STATUS_COMPENSATING        SagaStepStatus = 4
+	SAGA_STEP_STATUS_COMPENSATED         SagaStepStatus = 5
+	SAGA_STEP_STATUS_COMPENSATION_FAILED SagaStepStatus = 6
+	// SAGA_STEP_STATUS_NOT_COMPENSABLE indicates that the step has been committed, but it has no compensation to undo it
+	SAGA_STEP_STATUS_NOT_COMPENSABLE SagaStepStatus = 7
+)
+
+var SagaStepStatus_name = map[int32]string{
+	0: "SAGA_STEP_STATUS_UNKNOWN",
+	1: "SAGA_STEP_STATUS_PENDING",
+	2: "SAGA_STEP_STATUS_COMPLETED",
+	3: "SAGA_STEP_STATUS_FAILED",
+	4: "SAGA_STEP_STATUS_COMPENSATING",
+	5: "SAGA_STEP_STATUS_COMPENSATED",
+	6: "SAGA_STEP_STATUS_COMPENSATION_FAILED",
+	7: "SAGA_STEP_STATUS_NOT_COMPENSABLE",
+}
+
+var SagaStepStatus_value = map[string]int32{
+	"SAGA_STEP_STATUS_UNKNOWN":             0,
+	"SAGA_STEP_STATUS_PENDING":             1,
+	"SAGA_STEP_STATUS_COMPLETED":           2,
+	"SAGA_STEP_STATUS_FAILED":              3,
+	"SAGA_STEP_STATUS_COMPENSATING":        4,
+	"SAGA_STEP_STATUS_COMPENSATED":         5,
+	"SAGA_STEP_STATUS_COMPENSATION_FAILED": 6,
+	"SAGA_STEP_STATUS_NOT_COMPENSABLE":     7,
+}
+
+func (x SagaStepStatus) String() string {
+	return proto.EnumName(SagaStepStatus_name, int32(x))
+}
+
+func (SagaStepStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d9baff137dd12b68, []int{0}
+}
+
 type CoordinatorPhase int32
 
 const (
 	COORDINATOR_PHASE_UNKNOWN CoordinatorPhase = 0
 	COORDINATOR_PHASE_PREPARE CoordinatorPhase = 1
 	COORDINATOR_PHASE_COMMIT  CoordinatorPhase = 2
+	// COORDINATOR_PHASE_COMPLETED indicates that the coordinator has received all acknowledgements of the commit
+	// The simple commit protocol enters this phase when the coordinator commits or aborts its own transactions.
+	COORDINATOR_PHASE_COMPLETED CoordinatorPhase = 3
+	// COORDINATOR_PHASE_PRE_COMMIT indicates that the coordinator has sent pre-commits to the participants
+	COORDINATOR_PHASE_PRE_COMMIT CoordinatorPhase = 4
+	// COORDINATOR_PHASE_COMPENSATE indicates that the coordinator is compensating the completed steps of the saga
+	COORDINATOR_PHASE_COMPENSATE CoordinatorPhase = 5
 )
 
 var CoordinatorPhase_name = map[int32]string{
 	0: "COORDINATOR_PHASE_UNKNOWN",
 	1: "COORDINATOR_PHASE_PREPARE",
 	2: "COORDINATOR_PHASE_COMMIT",
+	3: "COORDINATOR_PHASE_COMPLETED",
+	4: "COORDINATOR_PHASE_PRE_COMMIT",
+	5: "COORDINATOR_PHASE_COMPENSATE",
 }
 
 var CoordinatorPhase_value = map[string]int32{
-	"COORDINATOR_PHASE_UNKNOWN": 0,
-	"COORDINATOR_PHASE_PREPARE": 1,
-	"COORDINATOR_PHASE_COMMIT":  2,
+	"COORDINATOR_PHASE_UNKNOWN":    0,
+	"COORDINATOR_PHASE_PREPARE":    1,
+	"COORDINATOR_PHASE_COMMIT":     2,
+	"COORDINATOR_PHASE_COMPLETED":  3,
+	"COORDINATOR_PHASE_PRE_COMMIT": 4,
+	"COORDINATOR_PHASE_COMPENSATE": 5,
 }
 
 func (x CoordinatorPhase) String() string {
@@ -51,7 +110,44 @@ func (x CoordinatorPhase) String() string {
 }
 
 func (CoordinatorPhase) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d9baff137dd12b68, []int{0}
+	return fileDescriptor_d9baff137dd12b68, []int{1}
+}
+
+type AbortReason int32
+
+const (
+	ABORT_REASON_UNKNOWN           AbortReason = 0
+	ABORT_REASON_PREPARE_FAILED    AbortReason = 1
+	ABORT_REASON_TIMEOUT           AbortReason = 2
+	ABORT_REASON_MANUAL            AbortReason = 3
+	ABORT_REASON_PRE_COMMIT_FAILED AbortReason = 4
+	ABORT_REASON_STEP_FAILED       AbortReason = 5
+)
+
+var AbortReason_name = map[int32]string{
+	0: "ABORT_REASON_UNKNOWN",
+	1: "ABORT_REASON_PREPARE_FAILED",
+	2: "ABORT_REASON_TIMEOUT",
+	3: "ABORT_REASON_MANUAL",
+	4: "ABORT_REASON_PRE_COMMIT_FAILED",
+	5: "ABORT_REASON_STEP_FAILED",
+}
+
+var AbortReason_value = map[string]int32{
+	"ABORT_REASON_UNKNOWN":           0,
+	"ABORT_REASON_PREPARE_FAILED":    1,
+	"ABORT_REASON_TIMEOUT":           2,
+	"ABORT_REASON_MANUAL":            3,
+	"ABORT_REASON_PRE_COMMIT_FAILED": 4,
+	"ABORT_REASON_STEP_FAILED":       5,
+}
+
+func (x AbortReason) String() string {
+	return proto.EnumName(AbortReason_name, int32(x))
+}
+
+func (AbortReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d9baff137dd12b68, []int{2}
 }
 
 type CoordinatorDecision int32
@@ -79,16 +175,18 @@ func (x CoordinatorDecision) String() string {
 }
 
 func (CoordinatorDecision) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d9baff137dd12b68, []int{1}
+	return fileDescriptor_d9baff137dd12b68, []int{3}
 }
 
 type ContractTransactionStatus int32
 
 const (
-	CONTRACT_TRANSACTION_STATUS_UNKNOWN ContractTransactionStatus = 0
-	CONTRACT_TRANSACTION_STATUS_PREPARE ContractTransactionStatus = 1
-	CONTRACT_TRANSACTION_STATUS_COMMIT  ContractTransactionStatus = 2
-	CONTRACT_TRANSACTION_STATUS_ABORT   ContractTransactionStatus = 3
+	CONTRACT_TRANSACTION_STATUS_UNKNOWN     ContractTransactionStatus = 0
+	CONTRACT_TRANSACTION_STATUS_PREPARE     ContractTransactionStatus = 1
+	CONTRACT_TRANSACTION_STATUS_COMMIT      ContractTransactionStatus = 2
+	CONTRACT_TRANSACTION_STATUS_ABORT       ContractTransactionStatus = 3
+	CONTRACT_TRANSACTION_STATUS_PRE_COMMIT  ContractTransactionStatus = 4
+	CONTRACT_TRANSACTION_STATUS_COMPENSATED ContractTransactionStatus = 5
 )
 
 var ContractTransactionStatus_name = map[int32]string{
@@ -96,13 +194,17 @@ var ContractTransactionStatus_name = map[int32]string{
 	1: "CONTRACT_TRANSACTION_STATUS_PREPARE",
 	2: "CONTRACT_TRANSACTION_STATUS_COMMIT",
 	3: "CONTRACT_TRANSACTION_STATUS_ABORT",
+	4: "CONTRACT_TRANSACTION_STATUS_PRE_COMMIT",
+	5: "CONTRACT_TRANSACTION_STATUS_COMPENSATED",
 }
 
 var ContractTransactionStatus_value = map[string]int32{
-	"CONTRACT_TRANSACTION_STATUS_UNKNOWN": 0,
-	"CONTRACT_TRANSACTION_STATUS_PREPARE": 1,
-	"CONTRACT_TRANSACTION_STATUS_COMMIT":  2,
-	"CONTRACT_TRANSACTION_STATUS_ABORT":   3,
+	"CONTRACT_TRANSACTION_STATUS_UNKNOWN":     0,
+	"CONTRACT_TRANSACTION_STATUS_PREPARE":     1,
+	"CONTRACT_TRANSACTION_STATUS_COMMIT":      2,
+	"CONTRACT_TRANSACTION_STATUS_ABORT":       3,
+	"CONTRACT_TRANSACTION_STATUS_PRE_COMMIT":  4,
+	"CONTRACT_TRANSACTION_STATUS_COMPENSATED": 5,
 }
 
 func (x ContractTransactionStatus) String() string {
@@ -110,7 +212,7 @@ func (x ContractTransactionStatus) String() string {
 }
 
 func (ContractTransactionStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d9baff137dd12b68, []int{2}
+	return fileDescriptor_d9baff137dd12b68, []int{4}
 }
 
 type PrepareResult int32
@@ -138,7 +240,7 @@ func (x PrepareResult) String() string {
 }
 
 func (PrepareResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d9baff137dd12b68, []int{3}
+	return fileDescriptor_d9baff137dd12b68, []int{5}
 }
 
 type CoordinatorState struct {
@@ -148,6 +250,16 @@ type CoordinatorState struct {
 	Decision     CoordinatorDecision                                  `protobuf:"varint,4,opt,name=decision,proto3,enum=cross.core.atomic.CoordinatorDecision" json:"decision,omitempty"`
 	ConfirmedTxs []github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,5,rep,packed,name=confirmed_txs,json=confirmedTxs,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"confirmed_txs,omitempty"`
 	Acks         []github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,6,rep,packed,name=acks,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"acks,omitempty"`
+	// commit_failures is a list of the participants that failed to commit
+	CommitFailures []CommitFailure `protobuf:"bytes,7,rep,name=commit_failures,json=commitFailures,proto3" json:"commit_failures"`
+	// abort_reason indicates why the coordinator decided to abort the tx
+	AbortReason AbortReason `protobuf:"varint,8,opt,name=abort_reason,json=abortReason,proto3,enum=cross.core.atomic.AbortReason" json:"abort_reason,omitempty"`
+	// timeout_timestamp is the deadline (in nanoseconds) of the current phase.
+	// It is used only by the three-phase commit.
+	TimeoutTimestamp uint64 `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// saga is the progress of the steps of the saga.
+	// It is used only by the saga.
+	Saga *SagaState `protobuf:"bytes,10,opt,name=saga,proto3" json:"saga,omitempty"`
 }
 
 func (m *CoordinatorState) Reset()         { *m = CoordinatorState{} }
@@ -183,17 +295,182 @@ func (m *CoordinatorState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CoordinatorState proto.InternalMessageInfo
 
+// SagaState defines the progress of the steps of the saga
+type SagaState struct {
+	// steps are the steps of the saga. The i-th step corresponds to the contract transaction of txIndex i.
+	Steps []SagaStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps"`
+	// timeout_height and timeout_timestamp (in seconds) are the timeouts of the tx, which are checked before each step is started.
+	// The timeout_timestamp is also applied to the packet of each step.
+	TimeoutHeight    types2.Height `protobuf:"bytes,2,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	TimeoutTimestamp uint64        `protobuf:"varint,3,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *SagaState) Reset()         { *m = SagaState{} }
+func (m *SagaState) String() string { return proto.CompactTextString(m) }
+func (*SagaState) ProtoMessage()    {}
+func (*SagaState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9baff137dd12b68, []int{1}
+}
+func (m *SagaState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SagaState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SagaState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SagaState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SagaState.Merge(m, src)
+}
+func (m *SagaState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SagaState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SagaState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SagaState proto.InternalMessageInfo
+
+// SagaStep defines a step of the saga
+type SagaStep struct {
+	Tx     types.ResolvedContractTransaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	Status SagaStepStatus                    `protobuf:"varint,2,opt,name=status,proto3,enum=cross.core.atomic.SagaStepStatus" json:"status,omitempty"`
+	// error_message is the reason why the step or its compensation failed
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *SagaStep) Reset()         { *m = SagaStep{} }
+func (m *SagaStep) String() string { return proto.CompactTextString(m) }
+func (*SagaStep) ProtoMessage()    {}
+func (*SagaStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9baff137dd12b68, []int{2}
+}
+func (m *SagaStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SagaStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SagaStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SagaStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SagaStep.Merge(m, src)
+}
+func (m *SagaStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *SagaStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_SagaStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SagaStep proto.InternalMessageInfo
+
+// IdentifiedCoordinatorState defines a CoordinatorState with its txID
+type IdentifiedCoordinatorState struct {
+	TxId             github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	CoordinatorState CoordinatorState                                `protobuf:"bytes,2,opt,name=coordinator_state,json=coordinatorState,proto3" json:"coordinator_state"`
+}
+
+func (m *IdentifiedCoordinatorState) Reset()         { *m = IdentifiedCoordinatorState{} }
+func (m *IdentifiedCoordinatorState) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedCoordinatorState) ProtoMessage()    {}
+func (*IdentifiedCoordinatorState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9baff137dd12b68, []int{3}
+}
+func (m *IdentifiedCoordinatorState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedCoordinatorState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedCoordinatorState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedCoordinatorState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedCoordinatorState.Merge(m, src)
+}
+func (m *IdentifiedCoordinatorState) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedCoordinatorState) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedCoordinatorState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedCoordinatorState proto.InternalMessageInfo
+
+// CommitFailure defines a failure of the commit reported by the participant
+type CommitFailure struct {
+	TxIndex      github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,1,opt,name=tx_index,json=txIndex,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"tx_index,omitempty"`
+	ErrorMessage string                                             `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (m *CommitFailure) Reset()         { *m = CommitFailure{} }
+func (m *CommitFailure) String() string { return proto.CompactTextString(m) }
+func (*CommitFailure) ProtoMessage()    {}
+func (*CommitFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9baff137dd12b68, []int{4}
+}
+func (m *CommitFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitFailure.Merge(m, src)
+}
+func (m *CommitFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitFailure proto.InternalMessageInfo
+
 type ContractTransactionState struct {
 	Status             ContractTransactionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cross.core.atomic.ContractTransactionStatus" json:"status,omitempty"`
 	PrepareResult      PrepareResult             `protobuf:"varint,2,opt,name=prepare_result,json=prepareResult,proto3,enum=cross.core.atomic.PrepareResult" json:"prepare_result,omitempty"`
 	CoordinatorChannel types1.ChannelInfo        `protobuf:"bytes,3,opt,name=coordinator_channel,json=coordinatorChannel,proto3" json:"coordinator_channel"`
+	// timeout_timestamp is the deadline (in nanoseconds) at which the participant terminates the tx by itself.
+	// It is used only by the three-phase commit.
+	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 }
 
 func (m *ContractTransactionState) Reset()         { *m = ContractTransactionState{} }
 func (m *ContractTransactionState) String() string { return proto.CompactTextString(m) }
 func (*ContractTransactionState) ProtoMessage()    {}
 func (*ContractTransactionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9baff137dd12b68, []int{1}
+	return fileDescriptor_d9baff137dd12b68, []int{5}
 }
 func (m *ContractTransactionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,62 +499,193 @@ func (m *ContractTransactionState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractTransactionState proto.InternalMessageInfo
 
+// IdentifiedContractTransactionState defines a ContractTransactionState with its txID and txIndex
+type IdentifiedContractTransactionState struct {
+	TxId                     github_com_datachainlab_cross_x_core_types.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	TxIndex                  github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"tx_index,omitempty"`
+	ContractTransactionState ContractTransactionState                           `protobuf:"bytes,3,opt,name=contract_transaction_state,json=contractTransactionState,proto3" json:"contract_transaction_state"`
+}
+
+func (m *IdentifiedContractTransactionState) Reset()         { *m = IdentifiedContractTransactionState{} }
+func (m *IdentifiedContractTransactionState) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedContractTransactionState) ProtoMessage()    {}
+func (*IdentifiedContractTransactionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9baff137dd12b68, []int{6}
+}
+func (m *IdentifiedContractTransactionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedContractTransactionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedContractTransactionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedContractTransactionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedContractTransactionState.Merge(m, src)
+}
+func (m *IdentifiedContractTransactionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedContractTransactionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedContractTransactionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedContractTransactionState proto.InternalMessageInfo
+
+// GenesisState defines the atomic module's genesis state
+type GenesisState struct {
+	CoordinatorStates         []IdentifiedCoordinatorState         `protobuf:"bytes,1,rep,name=coordinator_states,json=coordinatorStates,proto3" json:"coordinator_states"`
+	ContractTransactionStates []IdentifiedContractTransactionState `protobuf:"bytes,2,rep,name=contract_transaction_states,json=contractTransactionStates,proto3" json:"contract_transaction_states"`
+	ContractCallResults       []types.IdentifiedContractCallResult `protobuf:"bytes,3,rep,name=contract_call_results,json=contractCallResults,proto3" json:"contract_call_results"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9baff137dd12b68, []int{7}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("cross.core.atomic.SagaStepStatus", SagaStepStatus_name, SagaStepStatus_value)
 	proto.RegisterEnum("cross.core.atomic.CoordinatorPhase", CoordinatorPhase_name, CoordinatorPhase_value)
+	proto.RegisterEnum("cross.core.atomic.AbortReason", AbortReason_name, AbortReason_value)
 	proto.RegisterEnum("cross.core.atomic.CoordinatorDecision", CoordinatorDecision_name, CoordinatorDecision_value)
 	proto.RegisterEnum("cross.core.atomic.ContractTransactionStatus", ContractTransactionStatus_name, ContractTransactionStatus_value)
 	proto.RegisterEnum("cross.core.atomic.PrepareResult", PrepareResult_name, PrepareResult_value)
 	proto.RegisterType((*CoordinatorState)(nil), "cross.core.atomic.CoordinatorState")
+	proto.RegisterType((*SagaState)(nil), "cross.core.atomic.SagaState")
+	proto.RegisterType((*SagaStep)(nil), "cross.core.atomic.SagaStep")
+	proto.RegisterType((*IdentifiedCoordinatorState)(nil), "cross.core.atomic.IdentifiedCoordinatorState")
+	proto.RegisterType((*CommitFailure)(nil), "cross.core.atomic.CommitFailure")
 	proto.RegisterType((*ContractTransactionState)(nil), "cross.core.atomic.ContractTransactionState")
+	proto.RegisterType((*IdentifiedContractTransactionState)(nil), "cross.core.atomic.IdentifiedContractTransactionState")
+	proto.RegisterType((*GenesisState)(nil), "cross.core.atomic.GenesisState")
 }
 
 func init() { proto.RegisterFile("cross/core/atomic/types.proto", fileDescriptor_d9baff137dd12b68) }
 
 var fileDescriptor_d9baff137dd12b68 = []byte{
-	// 678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x4f, 0xd4, 0x4c,
-	0x18, 0x6f, 0x77, 0x17, 0x42, 0xe6, 0x7d, 0x97, 0x2c, 0x83, 0x98, 0x6e, 0x81, 0xee, 0x0a, 0x11,
-	0x09, 0x31, 0xdd, 0x80, 0x89, 0x89, 0x26, 0x1e, 0xba, 0xdd, 0x55, 0x2b, 0xd0, 0x6e, 0xa6, 0x25,
-	0x26, 0x7a, 0x68, 0x86, 0xd9, 0xc2, 0x36, 0xee, 0x76, 0x36, 0x9d, 0xd9, 0xa4, 0x5e, 0xbc, 0x78,
-	0xf1, 0xe8, 0x47, 0x30, 0xf1, 0x1b, 0xf8, 0x11, 0x3c, 0x71, 0xe4, 0xe8, 0x89, 0x28, 0x5c, 0xfc,
-	0x0c, 0x9e, 0xcc, 0xb6, 0x05, 0x0a, 0x54, 0x24, 0xf1, 0xd6, 0xf6, 0xf7, 0xe7, 0x79, 0xe6, 0xf7,
-	0x3c, 0x1d, 0xb0, 0x48, 0x42, 0xca, 0x58, 0x83, 0xd0, 0xd0, 0x6b, 0x60, 0x4e, 0x07, 0x3e, 0x69,
-	0xf0, 0xb7, 0x43, 0x8f, 0xa9, 0xc3, 0x90, 0x72, 0x0a, 0x67, 0x62, 0x58, 0x1d, 0xc3, 0x6a, 0x02,
-	0xcb, 0xb7, 0xf6, 0xe9, 0x3e, 0x8d, 0xd1, 0xc6, 0xf8, 0x29, 0x21, 0xca, 0xd5, 0x8c, 0x0f, 0x8f,
-	0xb2, 0x1e, 0xb2, 0x9c, 0x81, 0x22, 0x72, 0xc1, 0x7f, 0xe9, 0x4b, 0x11, 0x54, 0x74, 0x4a, 0xc3,
-	0xae, 0x1f, 0x60, 0x4e, 0x43, 0x9b, 0x63, 0xee, 0xc1, 0x75, 0x50, 0x1a, 0x73, 0x24, 0xb1, 0x2e,
-	0xae, 0x4e, 0x6f, 0x2c, 0xaa, 0x99, 0x1e, 0x78, 0xa4, 0xea, 0x74, 0x30, 0xf0, 0x79, 0x67, 0x2c,
-	0x27, 0xb4, 0x8f, 0x62, 0x2a, 0x7c, 0x02, 0xa6, 0x48, 0x0f, 0x07, 0x81, 0xd7, 0x67, 0x52, 0xa1,
-	0x5e, 0x5c, 0xfd, 0x6f, 0x63, 0x3e, 0x2b, 0x8b, 0x08, 0x51, 0xf5, 0x04, 0x37, 0x82, 0x3d, 0xda,
-	0x2c, 0x1d, 0x1c, 0xd5, 0x04, 0x74, 0x26, 0x81, 0x8f, 0xc0, 0xc4, 0xb0, 0x87, 0x99, 0x27, 0x15,
-	0xe3, 0x92, 0xcb, 0xea, 0x95, 0x63, 0xab, 0x99, 0x2e, 0x3b, 0x63, 0x2a, 0x4a, 0x14, 0xb0, 0x09,
-	0xa6, 0xba, 0x1e, 0xf1, 0x99, 0x4f, 0x03, 0xa9, 0x14, 0xab, 0x57, 0xae, 0x57, 0xb7, 0x52, 0x36,
-	0x3a, 0xd3, 0xc1, 0xd7, 0xa0, 0x4c, 0x68, 0xb0, 0xe7, 0x87, 0x03, 0xaf, 0xeb, 0xf2, 0x88, 0x49,
-	0x13, 0xf5, 0xe2, 0x6a, 0xb9, 0xf9, 0xf0, 0xd7, 0x51, 0x6d, 0x63, 0xdf, 0xe7, 0xbd, 0xd1, 0xae,
-	0x4a, 0xe8, 0xa0, 0xd1, 0xc5, 0x1c, 0x93, 0x1e, 0xf6, 0x83, 0x3e, 0xde, 0x6d, 0x24, 0xa1, 0x46,
-	0x69, 0xe2, 0x71, 0xa4, 0x4e, 0x64, 0x04, 0x5d, 0x2f, 0x42, 0xff, 0x9f, 0x99, 0x39, 0x11, 0x83,
-	0x2f, 0x40, 0x09, 0x93, 0x37, 0x4c, 0x9a, 0xfc, 0x27, 0xcf, 0xd8, 0xe3, 0x71, 0xe9, 0xe7, 0xa7,
-	0x9a, 0xb0, 0xf4, 0xbe, 0x00, 0x24, 0x9d, 0x06, 0x3c, 0xc4, 0x84, 0x3b, 0x21, 0x0e, 0x18, 0x26,
-	0xdc, 0xa7, 0x41, 0x32, 0xbc, 0x16, 0x98, 0x64, 0x1c, 0xf3, 0x11, 0x4b, 0xc7, 0x77, 0x3f, 0x37,
-	0x8d, 0x5c, 0xf1, 0x88, 0xa1, 0x54, 0x0b, 0x9f, 0x81, 0xe9, 0x61, 0xe8, 0x0d, 0x71, 0xe8, 0xb9,
-	0xa1, 0xc7, 0x46, 0x7d, 0x2e, 0x15, 0x62, 0xb7, 0x7a, 0x8e, 0x5b, 0x27, 0x21, 0xa2, 0x98, 0x87,
-	0xca, 0xc3, 0xec, 0x2b, 0x44, 0x60, 0x96, 0x9c, 0x67, 0xef, 0xa6, 0x13, 0x8f, 0xe7, 0x7c, 0xa3,
-	0x1d, 0x81, 0x19, 0x75, 0x8a, 0x26, 0x29, 0xac, 0xf1, 0x0b, 0x9b, 0x1b, 0xef, 0x04, 0x5c, 0x04,
-	0x55, 0xdd, 0xb2, 0x50, 0xcb, 0x30, 0x35, 0xc7, 0x42, 0x6e, 0xe7, 0xb9, 0x66, 0xb7, 0xdd, 0x1d,
-	0x73, 0xd3, 0xb4, 0x5e, 0x9a, 0x15, 0x21, 0x1f, 0xee, 0xa0, 0x76, 0x47, 0x43, 0xed, 0x8a, 0x08,
-	0x17, 0x80, 0x74, 0x15, 0xd6, 0xad, 0xed, 0x6d, 0xc3, 0xa9, 0x14, 0xe4, 0xd2, 0x87, 0xcf, 0x8a,
-	0xb0, 0xf6, 0x0e, 0xcc, 0xe6, 0xec, 0x12, 0xac, 0x83, 0x85, 0xac, 0xb4, 0xd5, 0xd6, 0x0d, 0xdb,
-	0xb0, 0xcc, 0x4c, 0xed, 0x1a, 0x98, 0xcf, 0x65, 0xa4, 0xfe, 0x22, 0x54, 0x80, 0x9c, 0x4b, 0xd0,
-	0x9a, 0x16, 0x3a, 0xaf, 0xff, 0x55, 0x04, 0xd5, 0x3f, 0x8e, 0x0f, 0xde, 0x03, 0xcb, 0xba, 0x65,
-	0x3a, 0x48, 0xd3, 0x1d, 0xd7, 0x41, 0x9a, 0x69, 0x6b, 0xba, 0x33, 0xf6, 0xb0, 0x1d, 0xcd, 0xd9,
-	0xb1, 0x33, 0xdd, 0xfc, 0x85, 0x78, 0x9e, 0xc9, 0x0a, 0x58, 0xba, 0x8e, 0x78, 0x9a, 0x0e, 0xbc,
-	0x0b, 0xee, 0x5c, 0xc7, 0x4b, 0x0e, 0x51, 0x4c, 0x0f, 0x41, 0x40, 0xf9, 0xc2, 0xd2, 0x40, 0x19,
-	0xdc, 0x4e, 0x4b, 0xba, 0xa8, 0x6d, 0xef, 0x6c, 0x39, 0x99, 0x56, 0xe7, 0xc0, 0xcc, 0x25, 0xcc,
-	0xda, 0xac, 0x88, 0xb0, 0x0a, 0xe6, 0x2e, 0x7d, 0x7e, 0xaa, 0x19, 0x5b, 0xed, 0xd6, 0x69, 0x52,
-	0x4d, 0xeb, 0xe0, 0x87, 0x22, 0x1c, 0x1c, 0x2b, 0xe2, 0xe1, 0xb1, 0x22, 0x7e, 0x3f, 0x56, 0xc4,
-	0x8f, 0x27, 0x8a, 0x70, 0x78, 0xa2, 0x08, 0xdf, 0x4e, 0x14, 0xe1, 0xd5, 0xfa, 0x8d, 0xfe, 0xc1,
-	0xec, 0x8d, 0xbc, 0x3b, 0x19, 0x5f, 0x99, 0x0f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x0d,
-	0xed, 0x89, 0xb3, 0x05, 0x00, 0x00,
+	// 1369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdf, 0x6f, 0xdb, 0x54,
+	0x1b, 0x8e, 0x9d, 0xb4, 0xeb, 0xde, 0xfe, 0xf8, 0xdc, 0xd3, 0xed, 0x9b, 0x9b, 0xae, 0x69, 0xd6,
+	0xed, 0xdb, 0xaa, 0xee, 0x23, 0xa1, 0x9d, 0x00, 0x0d, 0x09, 0x09, 0x27, 0xf1, 0xba, 0xb0, 0x36,
+	0x0e, 0x8e, 0x0b, 0x12, 0x5c, 0x58, 0x8e, 0x73, 0x9a, 0x5a, 0x24, 0x76, 0xe4, 0x73, 0x3a, 0x19,
+	0x21, 0x71, 0x0b, 0x97, 0xdc, 0x73, 0x83, 0x04, 0xdc, 0x23, 0xae, 0xb8, 0x44, 0x82, 0x8b, 0x5d,
+	0xee, 0x12, 0x24, 0x34, 0xc1, 0x76, 0xc3, 0xdf, 0xb0, 0x2b, 0xe4, 0xe3, 0x93, 0xc4, 0x49, 0x9c,
+	0x74, 0xc0, 0xae, 0xea, 0x9e, 0xe7, 0x79, 0x7f, 0x3d, 0xef, 0xfb, 0x1e, 0x3b, 0xb0, 0x69, 0xfb,
+	0x1e, 0x21, 0x45, 0xdb, 0xf3, 0x71, 0xd1, 0xa2, 0x5e, 0xd7, 0xb1, 0x8b, 0xf4, 0xe3, 0x1e, 0x26,
+	0x85, 0x9e, 0xef, 0x51, 0x0f, 0xad, 0x32, 0xb8, 0x10, 0xc2, 0x85, 0x08, 0xce, 0x5e, 0x6a, 0x7b,
+	0x6d, 0x8f, 0xa1, 0xc5, 0xf0, 0x29, 0x22, 0x66, 0xd7, 0x63, 0x7e, 0x68, 0x10, 0xf7, 0x91, 0xcd,
+	0xc6, 0xa0, 0xc0, 0x1e, 0xf1, 0x9f, 0xdd, 0x72, 0x9a, 0x76, 0x84, 0xd8, 0x1d, 0x07, 0xbb, 0xb4,
+	0xf8, 0x70, 0x8f, 0x3f, 0x45, 0x84, 0xed, 0x6f, 0xe7, 0x40, 0x2a, 0x7b, 0x9e, 0xdf, 0x72, 0x5c,
+	0x8b, 0x7a, 0x7e, 0x83, 0x5a, 0x14, 0xa3, 0x3d, 0xc8, 0x84, 0x4e, 0x64, 0x21, 0x2f, 0xec, 0xac,
+	0xec, 0x6f, 0x16, 0x62, 0x49, 0xd2, 0xa0, 0x50, 0xf6, 0xba, 0x5d, 0x87, 0xd6, 0x43, 0x73, 0xdb,
+	0xeb, 0xe8, 0x8c, 0x8a, 0xde, 0x82, 0x05, 0xfb, 0xd4, 0x72, 0x5d, 0xdc, 0x21, 0xb2, 0x98, 0x4f,
+	0xef, 0x2c, 0xee, 0x6f, 0xc4, 0xcd, 0x02, 0xdb, 0x2e, 0x94, 0x23, 0xbc, 0xea, 0x9e, 0x78, 0xa5,
+	0xcc, 0xa3, 0x27, 0x5b, 0x29, 0x7d, 0x60, 0x82, 0xee, 0xc2, 0x5c, 0xef, 0xd4, 0x22, 0x58, 0x4e,
+	0xb3, 0x90, 0xd7, 0x0b, 0x13, 0xba, 0x14, 0x62, 0x59, 0xd6, 0x43, 0xaa, 0x1e, 0x59, 0xa0, 0x12,
+	0x2c, 0xb4, 0xb0, 0xed, 0x10, 0xc7, 0x73, 0xe5, 0x0c, 0xb3, 0xbe, 0x39, 0xdb, 0xba, 0xc2, 0xd9,
+	0xfa, 0xc0, 0x0e, 0x7d, 0x08, 0xcb, 0xb6, 0xe7, 0x9e, 0x38, 0x7e, 0x17, 0xb7, 0x4c, 0x1a, 0x10,
+	0x79, 0x2e, 0x9f, 0xde, 0x59, 0x2e, 0xbd, 0xfe, 0xfc, 0xc9, 0xd6, 0x7e, 0xdb, 0xa1, 0xa7, 0x67,
+	0xcd, 0x82, 0xed, 0x75, 0x8b, 0x2d, 0x8b, 0x5a, 0xf6, 0xa9, 0xe5, 0xb8, 0x1d, 0xab, 0x59, 0x8c,
+	0x54, 0x0f, 0x78, 0x4b, 0x98, 0xe6, 0x46, 0x50, 0x75, 0x5b, 0x38, 0xd0, 0x97, 0x06, 0xce, 0x8c,
+	0x80, 0xa0, 0x77, 0x20, 0x63, 0xd9, 0x1f, 0x11, 0x79, 0xfe, 0x5f, 0xf9, 0x64, 0x3e, 0x90, 0x06,
+	0xff, 0xb1, 0x99, 0xfc, 0xe6, 0x89, 0xe5, 0x74, 0xce, 0x7c, 0x4c, 0xe4, 0x0b, 0x4c, 0xed, 0x7c,
+	0x62, 0xcd, 0x21, 0xf3, 0x5e, 0x44, 0xe4, 0x92, 0xaf, 0xd8, 0xf1, 0x43, 0x82, 0x14, 0x58, 0xb2,
+	0x9a, 0x9e, 0x4f, 0x4d, 0x1f, 0x5b, 0xc4, 0x73, 0xe5, 0x05, 0xa6, 0x60, 0x2e, 0xc1, 0x9b, 0x12,
+	0xd2, 0x74, 0xc6, 0xd2, 0x17, 0xad, 0xe1, 0x3f, 0xe8, 0x36, 0xac, 0x52, 0xa7, 0x8b, 0xbd, 0x33,
+	0x6a, 0x86, 0x7f, 0x09, 0xb5, 0xba, 0x3d, 0xf9, 0x62, 0x5e, 0xd8, 0xc9, 0xe8, 0x12, 0x07, 0x8c,
+	0xfe, 0x39, 0x7a, 0x15, 0x32, 0xc4, 0x6a, 0x5b, 0x32, 0xe4, 0x85, 0x9d, 0xc5, 0xfd, 0xab, 0x09,
+	0x71, 0x1a, 0x56, 0xdb, 0x62, 0x63, 0xa8, 0x33, 0xe6, 0x9b, 0x99, 0x3f, 0xbf, 0xda, 0x4a, 0x6d,
+	0xff, 0x28, 0xc0, 0xc5, 0x01, 0x82, 0xde, 0x80, 0x39, 0x42, 0x71, 0x8f, 0xc8, 0xc2, 0xe4, 0xa8,
+	0x8d, 0xb8, 0xc1, 0x3d, 0x5e, 0x77, 0xc4, 0x47, 0x07, 0xb0, 0xd2, 0xcf, 0xf5, 0x14, 0x3b, 0xed,
+	0x53, 0x2a, 0x8b, 0x2c, 0x91, 0x6c, 0xc1, 0x69, 0xda, 0x91, 0x3d, 0x5f, 0x8f, 0x87, 0x7b, 0x85,
+	0xfb, 0x8c, 0xc1, 0x1d, 0x2c, 0x73, 0xbb, 0xe8, 0x30, 0xb9, 0xe8, 0x74, 0x72, 0xd1, 0xbc, 0x84,
+	0xef, 0x05, 0x58, 0xe8, 0x67, 0x85, 0xde, 0x06, 0x91, 0x06, 0x6c, 0xc1, 0x16, 0xf7, 0x77, 0xc7,
+	0x16, 0x4c, 0xc7, 0xc4, 0xeb, 0x3c, 0xc4, 0xad, 0xb2, 0xe7, 0x52, 0xdf, 0xb2, 0xa9, 0xe1, 0x5b,
+	0x2e, 0xb1, 0x6c, 0xea, 0x78, 0x2e, 0x4f, 0x46, 0xa4, 0x01, 0xba, 0x0b, 0xf3, 0x84, 0x5a, 0xf4,
+	0x8c, 0xb0, 0x12, 0x56, 0xf6, 0xaf, 0xcd, 0x10, 0xa1, 0xc1, 0x88, 0x3a, 0x37, 0x40, 0xd7, 0x61,
+	0x19, 0xfb, 0xbe, 0xe7, 0x9b, 0x5d, 0x4c, 0x88, 0xd5, 0x8e, 0xb6, 0xee, 0xa2, 0xbe, 0xc4, 0x0e,
+	0x8f, 0xa2, 0x33, 0x9e, 0xf4, 0xcf, 0x02, 0x64, 0xab, 0x2d, 0xec, 0x52, 0xe7, 0xc4, 0xc1, 0xad,
+	0xd8, 0x16, 0x45, 0x8d, 0xb8, 0x0f, 0x73, 0x34, 0x30, 0x9d, 0x16, 0xab, 0x64, 0xa9, 0x74, 0xe7,
+	0xf9, 0x93, 0xad, 0xe2, 0xdf, 0x1b, 0xee, 0x8a, 0x9e, 0xa1, 0x41, 0xb5, 0x85, 0xde, 0x83, 0x55,
+	0x7b, 0xe8, 0xdd, 0x0c, 0x33, 0xc5, 0xbc, 0x39, 0xe7, 0xdc, 0x06, 0x2c, 0x13, 0x2e, 0x8c, 0x64,
+	0x8f, 0x9d, 0xf3, 0x32, 0x3e, 0x13, 0x60, 0x79, 0x64, 0x1d, 0xd0, 0xbb, 0xb0, 0x10, 0x66, 0x1e,
+	0xee, 0x16, 0x4b, 0xfe, 0x9f, 0x6f, 0xe6, 0x05, 0x1a, 0x3d, 0x4c, 0xca, 0x2a, 0x4e, 0xca, 0xba,
+	0xfd, 0x9d, 0x08, 0x72, 0x42, 0x63, 0x23, 0x39, 0x2b, 0x83, 0x9e, 0x46, 0x57, 0xef, 0xff, 0x13,
+	0x2b, 0x4f, 0x34, 0x8e, 0xb5, 0xf7, 0x00, 0x56, 0x7a, 0x3e, 0xee, 0x59, 0x3e, 0x36, 0x7d, 0x4c,
+	0xce, 0x3a, 0x94, 0x4f, 0x48, 0xd2, 0x1d, 0x51, 0x8f, 0x88, 0x3a, 0xe3, 0xe9, 0xcb, 0xbd, 0xf8,
+	0xbf, 0x48, 0x87, 0xb5, 0x78, 0x4f, 0xf8, 0x6d, 0xcd, 0xa6, 0xe5, 0x85, 0xee, 0x77, 0x14, 0xb3,
+	0xe6, 0x68, 0xf2, 0xe2, 0x64, 0x66, 0x2e, 0xce, 0x0f, 0x22, 0x6c, 0xc7, 0x67, 0x70, 0x8a, 0x78,
+	0x2f, 0x6f, 0x16, 0xe3, 0xb3, 0x21, 0xbe, 0x9c, 0xd9, 0xf0, 0x20, 0x6b, 0xf3, 0xc4, 0x4d, 0x3a,
+	0xcc, 0x9c, 0xcf, 0x79, 0xa4, 0xe8, 0xed, 0x17, 0xef, 0x76, 0x7f, 0xde, 0x65, 0x7b, 0x0a, 0xce,
+	0xa5, 0xfb, 0x4d, 0x84, 0xa5, 0x03, 0xec, 0x62, 0xe2, 0x90, 0x48, 0xa4, 0x26, 0xa0, 0x89, 0x35,
+	0xeb, 0x5f, 0xa3, 0xaf, 0x24, 0xc4, 0x9f, 0xbe, 0xfb, 0x3c, 0x83, 0xd5, 0xf1, 0x8d, 0x23, 0xe8,
+	0x13, 0xd8, 0x98, 0x5e, 0x6b, 0xff, 0xf3, 0xe0, 0xb5, 0x73, 0x82, 0xcd, 0x2c, 0x7b, 0x7d, 0x5a,
+	0xd9, 0x04, 0x61, 0xb8, 0x3c, 0x08, 0x6e, 0x5b, 0x9d, 0x0e, 0x5f, 0x01, 0x22, 0xa7, 0xf3, 0xe9,
+	0x71, 0x8d, 0x69, 0x90, 0x10, 0xb2, 0x6c, 0x75, 0x3a, 0xd1, 0xfc, 0xf3, 0x60, 0x6b, 0xf6, 0x04,
+	0x42, 0x22, 0x79, 0x77, 0xbf, 0x11, 0x61, 0x65, 0xf4, 0x8e, 0x45, 0x57, 0x41, 0x6e, 0x28, 0x07,
+	0x8a, 0xd9, 0x30, 0xd4, 0xba, 0xd9, 0x30, 0x14, 0xe3, 0xb8, 0x61, 0x1e, 0xd7, 0x1e, 0xd4, 0xb4,
+	0xf7, 0x6b, 0x52, 0x2a, 0x11, 0xad, 0xab, 0xb5, 0x4a, 0xb5, 0x76, 0x20, 0x09, 0x28, 0x07, 0xd9,
+	0x09, 0xb4, 0xac, 0x1d, 0xd5, 0x0f, 0x55, 0x43, 0xad, 0x48, 0x22, 0xda, 0x80, 0x2b, 0x13, 0xf8,
+	0x3d, 0xa5, 0x7a, 0xa8, 0x56, 0xa4, 0x34, 0xba, 0x06, 0x9b, 0x89, 0xc6, 0x6a, 0xad, 0xa1, 0x18,
+	0xa1, 0xff, 0x0c, 0xca, 0xc3, 0xd5, 0xe9, 0x14, 0xb5, 0x22, 0xcd, 0xa1, 0x1d, 0xb8, 0x31, 0xc3,
+	0x89, 0x56, 0xeb, 0x87, 0x9b, 0x47, 0x37, 0x20, 0x3f, 0xc1, 0xac, 0x69, 0xc6, 0x80, 0x5d, 0x3a,
+	0x54, 0xa5, 0x0b, 0xd9, 0xcc, 0xe7, 0x5f, 0xe7, 0x52, 0xbb, 0xbf, 0x0a, 0x20, 0x8d, 0x7f, 0xbe,
+	0xa1, 0x4d, 0x58, 0x2f, 0x6b, 0x9a, 0x5e, 0xa9, 0xd6, 0x14, 0x43, 0xd3, 0xcd, 0xfa, 0x7d, 0xa5,
+	0xa1, 0xc6, 0x94, 0x4a, 0x84, 0xeb, 0xba, 0x5a, 0x57, 0x74, 0x55, 0x12, 0x42, 0x21, 0x27, 0xe1,
+	0xb2, 0x76, 0x74, 0x54, 0x35, 0x24, 0x11, 0x6d, 0xc1, 0x46, 0x22, 0xca, 0x95, 0x4c, 0x87, 0x4a,
+	0x24, 0x7a, 0xef, 0xbb, 0xc8, 0x24, 0x33, 0x86, 0x62, 0x49, 0x73, 0xbc, 0xb6, 0x9f, 0x04, 0x58,
+	0x8c, 0x7d, 0x1a, 0x21, 0x19, 0x2e, 0x29, 0x25, 0x4d, 0x37, 0x4c, 0x5d, 0x55, 0x1a, 0x5a, 0x2d,
+	0x56, 0xd1, 0x16, 0x6c, 0x8c, 0x20, 0xbc, 0x98, 0xbe, 0xa4, 0xc2, 0x84, 0xa9, 0x51, 0x3d, 0x52,
+	0xb5, 0xe3, 0xb0, 0x9e, 0x2b, 0xb0, 0x36, 0x82, 0x1c, 0x29, 0xb5, 0x63, 0xe5, 0x50, 0x4a, 0xa3,
+	0x6d, 0xc8, 0x8d, 0xfb, 0xe4, 0x25, 0xf4, 0xdd, 0x66, 0x42, 0xa9, 0x46, 0x38, 0xac, 0x63, 0x1c,
+	0xed, 0x57, 0xf1, 0x29, 0xac, 0x25, 0x7c, 0x21, 0x8f, 0x8b, 0x50, 0x51, 0xcb, 0xd5, 0x46, 0x75,
+	0xbc, 0xa8, 0x44, 0x06, 0xd7, 0x91, 0xcd, 0x74, 0x22, 0x81, 0xa5, 0x24, 0x89, 0x3c, 0xfe, 0x97,
+	0x22, 0xac, 0x4f, 0x7d, 0xb1, 0xa1, 0x5b, 0x70, 0xbd, 0xac, 0xd5, 0x0c, 0x5d, 0x29, 0x1b, 0xa6,
+	0xa1, 0x2b, 0xb5, 0x86, 0x52, 0x66, 0xc3, 0x38, 0xb1, 0x5e, 0xe7, 0x10, 0x87, 0xe3, 0x73, 0x13,
+	0xb6, 0x67, 0x11, 0x07, 0x83, 0xf4, 0x3f, 0xb8, 0x36, 0x8b, 0x17, 0x15, 0x91, 0x46, 0xbb, 0x70,
+	0xf3, 0x9c, 0xb8, 0xc3, 0xc1, 0xba, 0x0d, 0xb7, 0xce, 0x09, 0x3d, 0xdc, 0x47, 0xae, 0x8e, 0x0d,
+	0xcb, 0x23, 0xef, 0x69, 0x94, 0x85, 0xff, 0xf6, 0xa7, 0x47, 0x57, 0x1b, 0xc7, 0x87, 0x46, 0x4c,
+	0x83, 0xcb, 0xb0, 0x3a, 0x86, 0x69, 0x0f, 0x24, 0x01, 0xad, 0xc3, 0xe5, 0xb1, 0x63, 0x3e, 0x02,
+	0xbc, 0x05, 0x25, 0xed, 0xd1, 0x1f, 0xb9, 0xd4, 0xa3, 0xa7, 0x39, 0xe1, 0xf1, 0xd3, 0x9c, 0xf0,
+	0xfb, 0xd3, 0x9c, 0xf0, 0xc5, 0xb3, 0x5c, 0xea, 0xf1, 0xb3, 0x5c, 0xea, 0x97, 0x67, 0xb9, 0xd4,
+	0x07, 0x7b, 0x2f, 0xf4, 0xf2, 0x8b, 0xff, 0xc2, 0x6d, 0xce, 0xb3, 0x5f, 0x98, 0x77, 0xfe, 0x1a,
+	0x00, 0x40, 0xc0, 0x0c, 0x2c, 0x03, 0x0f, 0x00, 0x00,
 }
 
 func (m *CoordinatorState) Marshal() (dAtA []byte, err error) {
@@ -300,39 +708,75 @@ func (m *CoordinatorState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Saga != nil {
+		{
+			size, err := m.Saga.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AbortReason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AbortReason))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.CommitFailures) > 0 {
+		for iNdEx := len(m.CommitFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Acks) > 0 {
-		dAtA2 := make([]byte, len(m.Acks)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Acks)*10)
+		var j2 int
 		for _, num := range m.Acks {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTypes(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x32
 	}
 	if len(m.ConfirmedTxs) > 0 {
-		dAtA4 := make([]byte, len(m.ConfirmedTxs)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.ConfirmedTxs)*10)
+		var j4 int
 		for _, num := range m.ConfirmedTxs {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTypes(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTypes(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -368,7 +812,7 @@ func (m *CoordinatorState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractTransactionState) Marshal() (dAtA []byte, err error) {
+func (m *SagaState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -378,18 +822,23 @@ func (m *ContractTransactionState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractTransactionState) MarshalTo(dAtA []byte) (int, error) {
+func (m *SagaState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractTransactionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SagaState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
-		size, err := m.CoordinatorChannel.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -397,51 +846,333 @@ func (m *ContractTransactionState) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.PrepareResult != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.PrepareResult))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	dAtA[i] = 0x12
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SagaStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CoordinatorState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SagaStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SagaStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovTypes(uint64(m.Type))
-	}
-	if len(m.Channels) > 0 {
-		for _, e := range m.Channels {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Phase != 0 {
-		n += 1 + sovTypes(uint64(m.Phase))
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Decision != 0 {
-		n += 1 + sovTypes(uint64(m.Decision))
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedCoordinatorState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedCoordinatorState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedCoordinatorState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CoordinatorState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractTransactionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractTransactionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractTransactionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.CoordinatorChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PrepareResult != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PrepareResult))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedContractTransactionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedContractTransactionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedContractTransactionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContractTransactionState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractCallResults) > 0 {
+		for iNdEx := len(m.ContractCallResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCallResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractTransactionStates) > 0 {
+		for iNdEx := len(m.ContractTransactionStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractTransactionStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CoordinatorStates) > 0 {
+		for iNdEx := len(m.CoordinatorStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoordinatorStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CoordinatorState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Phase != 0 {
+		n += 1 + sovTypes(uint64(m.Phase))
+	}
+	if m.Decision != 0 {
+		n += 1 + sovTypes(uint64(m.Decision))
 	}
 	if len(m.ConfirmedTxs) > 0 {
 		l = 0
@@ -457,33 +1188,1213 @@ func (m *CoordinatorState) Size() (n int) {
 		}
 		n += 1 + sovTypes(uint64(l)) + l
 	}
+	if len(m.CommitFailures) > 0 {
+		for _, e := range m.CommitFailures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.AbortReason != 0 {
+		n += 1 + sovTypes(uint64(m.AbortReason))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutTimestamp))
+	}
+	if m.Saga != nil {
+		l = m.Saga.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
-func (m *ContractTransactionState) Size() (n int) {
+func (m *SagaState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *SagaStep) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	if m.Status != 0 {
 		n += 1 + sovTypes(uint64(m.Status))
 	}
-	if m.PrepareResult != 0 {
-		n += 1 + sovTypes(uint64(m.PrepareResult))
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
-	l = m.CoordinatorChannel.Size()
-	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *IdentifiedCoordinatorState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.CoordinatorState.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *CommitFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractTransactionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.PrepareResult != 0 {
+		n += 1 + sovTypes(uint64(m.PrepareResult))
+	}
+	l = m.CoordinatorChannel.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *IdentifiedContractTransactionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	l = m.ContractTransactionState.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CoordinatorStates) > 0 {
+		for _, e := range m.CoordinatorStates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ContractTransactionStates) > 0 {
+		for _, e := range m.ContractTransactionStates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ContractCallResults) > 0 {
+		for _, e := range m.ContractCallResults {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CoordinatorState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoordinatorState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoordinatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.CommitProtocol(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, types1.ChannelInfo{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= CoordinatorPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			m.Decision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decision |= CoordinatorDecision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v github_com_datachainlab_cross_x_core_types.TxIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConfirmedTxs = append(m.ConfirmedTxs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ConfirmedTxs) == 0 {
+					m.ConfirmedTxs = make([]github_com_datachainlab_cross_x_core_types.TxIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_datachainlab_cross_x_core_types.TxIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConfirmedTxs = append(m.ConfirmedTxs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedTxs", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v github_com_datachainlab_cross_x_core_types.TxIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Acks = append(m.Acks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Acks) == 0 {
+					m.Acks = make([]github_com_datachainlab_cross_x_core_types.TxIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_datachainlab_cross_x_core_types.TxIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Acks = append(m.Acks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acks", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitFailures = append(m.CommitFailures, CommitFailure{})
+			if err := m.CommitFailures[len(m.CommitFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortReason", wireType)
+			}
+			m.AbortReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbortReason |= AbortReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Saga", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Saga == nil {
+				m.Saga = &SagaState{}
+			}
+			if err := m.Saga.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SagaState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SagaState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SagaState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, SagaStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SagaStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SagaStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SagaStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SagaStepStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedCoordinatorState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedCoordinatorState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedCoordinatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoordinatorState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractTransactionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractTransactionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractTransactionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ContractTransactionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareResult", wireType)
+			}
+			m.PrepareResult = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepareResult |= PrepareResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoordinatorChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CoordinatorState) Unmarshal(dAtA []byte) error {
+func (m *IdentifiedContractTransactionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -506,36 +2417,17 @@ func (m *CoordinatorState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoordinatorState: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedContractTransactionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoordinatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedContractTransactionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.CommitProtocol(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -545,31 +2437,31 @@ func (m *CoordinatorState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channels = append(m.Channels, types1.ChannelInfo{})
-			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			m.Phase = 0
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -579,16 +2471,16 @@ func (m *CoordinatorState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Phase |= CoordinatorPhase(b&0x7F) << shift
+				m.TxIndex |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTransactionState", wireType)
 			}
-			m.Decision = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -598,163 +2490,25 @@ func (m *CoordinatorState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decision |= CoordinatorDecision(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType == 0 {
-				var v github_com_datachainlab_cross_x_core_types.TxIndex
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ConfirmedTxs = append(m.ConfirmedTxs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ConfirmedTxs) == 0 {
-					m.ConfirmedTxs = make([]github_com_datachainlab_cross_x_core_types.TxIndex, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v github_com_datachainlab_cross_x_core_types.TxIndex
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ConfirmedTxs = append(m.ConfirmedTxs, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedTxs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 6:
-			if wireType == 0 {
-				var v github_com_datachainlab_cross_x_core_types.TxIndex
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Acks = append(m.Acks, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Acks) == 0 {
-					m.Acks = make([]github_com_datachainlab_cross_x_core_types.TxIndex, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v github_com_datachainlab_cross_x_core_types.TxIndex
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Acks = append(m.Acks, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acks", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.ContractTransactionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -779,7 +2533,7 @@ func (m *CoordinatorState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractTransactionState) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -802,17 +2556,17 @@ func (m *ContractTransactionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractTransactionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractTransactionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorStates", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -822,16 +2576,31 @@ func (m *ContractTransactionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ContractTransactionStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorStates = append(m.CoordinatorStates, IdentifiedCoordinatorState{})
+			if err := m.CoordinatorStates[len(m.CoordinatorStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepareResult", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTransactionStates", wireType)
 			}
-			m.PrepareResult = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -841,14 +2610,29 @@ func (m *ContractTransactionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrepareResult |= PrepareResult(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractTransactionStates = append(m.ContractTransactionStates, IdentifiedContractTransactionState{})
+			if err := m.ContractTransactionStates[len(m.ContractTransactionStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -875,7 +2659,8 @@ func (m *ContractTransactionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoordinatorChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractCallResults = append(m.ContractCallResults, types.IdentifiedContractCallResult{})
+			if err := m.ContractCallResults[len(m.ContractCallResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -36,6 +36,11 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewIBCSignTxCmd(),
+		NewRevokeSignCmd(),
+		NewIBCRevokeSignCmd(),
+		NewGrantSignCmd(),
+		NewRevokeSignGrantCmd(),
+		NewExtAccountCmd(),
 	)
 
 	return txCmd
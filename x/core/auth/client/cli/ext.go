@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
+)
+
+const (
+	extTypeSecp256k1 = "secp256k1"
+	extTypeEd25519   = "ed25519"
+	extTypeEthereum  = "ethereum"
+)
+
+// NewExtAccountCmd returns the command to build an account with a built-in auth extension
+func NewExtAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ext-account [secp256k1|ed25519|ethereum] [public key or ethereum address: hex encoding]",
+		Short: "Build an account that is authenticated with a built-in auth extension",
+		Long: strings.TrimSpace(`Build an account that is authenticated with a built-in auth extension.
+The output can be used as a signer of a contract transaction.
+
+secp256k1: a compressed public key is required, and the account ID is the address of the key
+ed25519:   a public key is required, and the account ID is the address of the key
+ethereum:  an address is required, and the signer has to sign with personal_sign`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			bz, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return err
+			}
+			acc, err := buildExtAccount(args[0], bz)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(acc)
+		},
+	}
+	return cmd
+}
+
+func buildExtAccount(extType string, bz []byte) (*authtypes.Account, error) {
+	var (
+		ext authtypes.AuthExtensionVerifier
+		id  authtypes.AccountID
+		err error
+	)
+	switch extType {
+	case extTypeSecp256k1:
+		e := authtypes.NewSecp256K1AuthExtension(bz)
+		ext = e
+		id, err = e.AccountID()
+	case extTypeEd25519:
+		e := authtypes.NewEd25519AuthExtension(bz)
+		ext = e
+		id, err = e.AccountID()
+	case extTypeEthereum:
+		if len(bz) != authtypes.EthereumAddressLength {
+			err = fmt.Errorf("invalid ethereum address length: expected=%v actual=%v", authtypes.EthereumAddressLength, len(bz))
+		}
+		ext, id = &authtypes.EthereumAuthExtension{}, bz
+	default:
+		return nil, fmt.Errorf("unknown extension type: %v", extType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	acc := authtypes.NewAccount(id, authtypes.NewAuthTypeExtension(ext))
+	return &acc, nil
+}
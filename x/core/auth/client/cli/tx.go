@@ -10,7 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
 	"github.com/spf13/cobra"
@@ -19,6 +19,7 @@ import (
 
 	"github.com/datachainlab/cross/x/core/auth/types"
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
+	txcli "github.com/datachainlab/cross/x/core/tx/client/cli"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 )
 
@@ -54,13 +55,105 @@ func NewIBCSignTxCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			version := clienttypes.ParseChainID(h.Header.ChainID)
+			timeoutHeight, timeoutTimestamp, err := txcli.QueryDefaultTimeout(clientCtx, h, height)
+			if err != nil {
+				return err
+			}
 			msg := types.NewMsgIBCSignTx(
 				anyXCC,
 				txID,
 				[]authtypes.AccountID{signer},
-				clienttypes.NewHeight(version, uint64(height)+100),
-				0,
+				timeoutHeight,
+				timeoutTimestamp,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagTxID, "", "hex encoding of the TxID")
+	cmd.Flags().String(flagInitiatorChainChannel, "", "channel info: '<channelID>:<portID>' or chain ID: '<chainID>'")
+	cmd.MarkFlagRequired(flagTxID)
+	cmd.MarkFlagRequired(flagInitiatorChainChannel)
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRevokeSignCmd() *cobra.Command {
+	const flagTxID = "tx-id"
+
+	cmd := &cobra.Command{
+		Use:   "revoke-sign",
+		Short: "Revoke the sign of the cross-chain transaction before the authentication is completed",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			signer := authtypes.AccountIDFromAccAddress(clientCtx.FromAddress)
+			txID, err := hex.DecodeString(viper.GetString(flagTxID))
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRevokeSign(txID, []authtypes.AccountID{signer})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagTxID, "", "hex encoding of the TxID")
+	cmd.MarkFlagRequired(flagTxID)
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewIBCRevokeSignCmd() *cobra.Command {
+	const (
+		flagTxID                  = "tx-id"
+		flagInitiatorChainChannel = "initiator-chain-channel"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "ibc-revoke-sign",
+		Short: "Revoke the sign of the cross-chain transaction on other chain via the chain",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithOutputFormat("json")
+			anyXCC, err := resolveXCC(
+				channeltypes.NewQueryClient(clientCtx),
+				viper.GetString(flagInitiatorChainChannel),
+			)
+			if err != nil {
+				return err
+			}
+			signer := authtypes.AccountIDFromAccAddress(clientCtx.FromAddress)
+			txID, err := hex.DecodeString(viper.GetString(flagTxID))
+			if err != nil {
+				return err
+			}
+			h, height, err := QueryTendermintHeader(clientCtx)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, timeoutTimestamp, err := txcli.QueryDefaultTimeout(clientCtx, h, height)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgIBCRevokeSign(
+				anyXCC,
+				txID,
+				[]authtypes.AccountID{signer},
+				timeoutHeight,
+				timeoutTimestamp,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -69,7 +162,7 @@ func NewIBCSignTxCmd() *cobra.Command {
 		},
 	}
 	cmd.Flags().String(flagTxID, "", "hex encoding of the TxID")
-	cmd.Flags().String(flagInitiatorChainChannel, "", "channel info: '<channelID>:<portID>'")
+	cmd.Flags().String(flagInitiatorChainChannel, "", "channel info: '<channelID>:<portID>' or chain ID: '<chainID>'")
 	cmd.MarkFlagRequired(flagTxID)
 	cmd.MarkFlagRequired(flagInitiatorChainChannel)
 
@@ -77,7 +170,94 @@ func NewIBCSignTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewGrantSignCmd() *cobra.Command {
+	const (
+		flagCrossChainChannel = "cross-chain-channel"
+		flagCallInfoPrefix    = "call-info-prefix"
+		flagExpiration        = "expiration-timestamp"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "grant-sign [grantee]",
+		Short: "Authorize the grantee to sign cross-chain transactions on behalf of the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			var anyXCC *codectypes.Any
+			if s := viper.GetString(flagCrossChainChannel); s != "" {
+				anyXCC, err = resolveXCC(channeltypes.NewQueryClient(clientCtx), s)
+				if err != nil {
+					return err
+				}
+			}
+			prefix, err := hex.DecodeString(viper.GetString(flagCallInfoPrefix))
+			if err != nil {
+				return err
+			}
+			expiration := viper.GetUint64(flagExpiration)
+			msg := types.NewMsgGrantSign(
+				authtypes.AccountIDFromAccAddress(clientCtx.FromAddress),
+				authtypes.AccountIDFromAccAddress(grantee),
+				anyXCC,
+				prefix,
+				expiration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(flagCrossChainChannel, "", "channel info or chain ID that restricts the contract transactions: '<channelID>:<portID>' or '<chainID>'")
+	cmd.Flags().String(flagCallInfoPrefix, "", "hex encoding of the prefix that restricts the call info of the contract transactions")
+	cmd.Flags().Uint64(flagExpiration, 0, "unix timestamp (in seconds) when the grant expires. The grant never expires when set to 0")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRevokeSignGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-sign-grant [grantee]",
+		Short: "Revoke the sign grant from the grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRevokeSignGrant(
+				authtypes.AccountIDFromAccAddress(clientCtx.FromAddress),
+				authtypes.AccountIDFromAccAddress(grantee),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// resolveXCC returns a xcc from a given string
+// A string that doesn't contain ':' is regarded as a chain ID.
 func resolveXCC(queryClient channeltypes.QueryClient, s string) (*codectypes.Any, error) {
+	if !strings.Contains(s, ":") {
+		return xcctypes.PackCrossChainChannel(xcctypes.NewChainInfo(s))
+	}
 	ci, err := parseChannelInfoFromString(s)
 	if err != nil {
 		return nil, err
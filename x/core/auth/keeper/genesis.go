@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/datachainlab/cross/x/core/auth/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+)
+
+// InitGenesis initializes the auth states, the records of IBCSignTx and the sign grants
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	if err := gs.Validate(); err != nil {
+		return err
+	}
+	for _, s := range gs.TxAuthStates {
+		if err := k.setAuthState(ctx, s.TxId, s.TxAuthState); err != nil {
+			return err
+		}
+	}
+	for _, r := range gs.IbcSignTxRecords {
+		k.setIBCSignTxRecord(ctx, r)
+	}
+	for _, g := range gs.SignGrants {
+		k.setSignGrant(ctx, g)
+	}
+	return nil
+}
+
+// ExportGenesis exports the auth states, the records of IBCSignTx and the sign grants
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	var gs types.GenesisState
+
+	stateIter := prefix.NewStore(k.store(ctx), types.KeyTxAuthState()).Iterator(nil, nil)
+	defer stateIter.Close()
+	for ; stateIter.Valid(); stateIter.Next() {
+		var state types.TxAuthState
+		if err := k.m.Unmarshal(stateIter.Value(), &state); err != nil {
+			return nil, err
+		}
+		gs.TxAuthStates = append(gs.TxAuthStates, types.IdentifiedTxAuthState{
+			TxId:        append(crosstypes.TxID{}, stateIter.Key()...),
+			TxAuthState: state,
+		})
+	}
+
+	recordIter := prefix.NewStore(k.store(ctx), types.KeyPrefixBytes(types.KeyIBCSignTxRecordPrefix)).Iterator(nil, nil)
+	defer recordIter.Close()
+	for ; recordIter.Valid(); recordIter.Next() {
+		var record types.IBCSignTxRecord
+		if err := k.m.Unmarshal(recordIter.Value(), &record); err != nil {
+			return nil, err
+		}
+		gs.IbcSignTxRecords = append(gs.IbcSignTxRecords, record)
+	}
+
+	grantIter := prefix.NewStore(k.store(ctx), types.KeyPrefixBytes(types.KeySignGrantPrefix)).Iterator(nil, nil)
+	defer grantIter.Close()
+	for ; grantIter.Valid(); grantIter.Next() {
+		var grant types.SignGrant
+		if err := k.m.Unmarshal(grantIter.Value(), &grant); err != nil {
+			return nil, err
+		}
+		gs.SignGrants = append(gs.SignGrants, grant)
+	}
+	return &gs, nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/datachainlab/cross/x/core/auth/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+)
+
+// Grant stores a given grant that authorizes the grantee to sign txs on behalf of the granter
+// If the grant between the granter and grantee already exists, it is overwritten.
+func (k Keeper) Grant(ctx sdk.Context, grant types.SignGrant) error {
+	if err := grant.ValidateBasic(); err != nil {
+		return err
+	}
+	if grant.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("the grant is already expired: expiration=%v", grant.ExpirationTimestamp)
+	}
+	k.setSignGrant(ctx, grant)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantSign,
+			sdk.NewAttribute(types.AttributeKeyGranter, hex.EncodeToString(grant.Granter)),
+			sdk.NewAttribute(types.AttributeKeyGrantee, hex.EncodeToString(grant.Grantee)),
+		),
+	)
+	return nil
+}
+
+// RevokeGrant deletes the grant between a given granter and grantee
+func (k Keeper) RevokeGrant(ctx sdk.Context, granter, grantee types.AccountID) error {
+	if _, found := k.GetSignGrant(ctx, granter, grantee); !found {
+		return fmt.Errorf("grant not found: granter=%X grantee=%X", granter, grantee)
+	}
+	k.store(ctx).Delete(types.KeySignGrant(granter, grantee))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeSignGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, hex.EncodeToString(granter)),
+			sdk.NewAttribute(types.AttributeKeyGrantee, hex.EncodeToString(grantee)),
+		),
+	)
+	return nil
+}
+
+// GetSignGrant returns the grant between a given granter and grantee
+func (k Keeper) GetSignGrant(ctx sdk.Context, granter, grantee types.AccountID) (*types.SignGrant, bool) {
+	bz := k.store(ctx).Get(types.KeySignGrant(granter, grantee))
+	if bz == nil {
+		return nil, false
+	}
+	var grant types.SignGrant
+	k.m.MustUnmarshal(bz, &grant)
+	return &grant, true
+}
+
+// GetSignGrants returns the grants of a given granter
+func (k Keeper) GetSignGrants(ctx sdk.Context, granter types.AccountID) []types.SignGrant {
+	store := prefix.NewStore(k.store(ctx), types.KeySignGrantsByGranter(granter))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var grants []types.SignGrant
+	for ; iter.Valid(); iter.Next() {
+		var grant types.SignGrant
+		k.m.MustUnmarshal(iter.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// ResolveGranters returns the local accounts of the granters if any of the grantees is authorized to sign the tx on behalf of each granter
+func (k Keeper) ResolveGranters(ctx sdk.Context, txID crosstypes.TxID, grantees []types.AccountID, granters []types.AccountID) ([]types.Account, error) {
+	if len(granters) == 0 {
+		return nil, nil
+	} else if k.txManager == nil {
+		return nil, fmt.Errorf("txManager is not set")
+	}
+	var accounts []types.Account
+	for _, granter := range granters {
+		acc := types.NewLocalAccount(granter)
+		targets, err := k.txManager.GetSignTargets(ctx, txID, acc)
+		if err != nil {
+			return nil, err
+		}
+		grantee, err := k.findAcceptableGrantee(ctx, granter, grantees, targets)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, acc)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSignByGrant,
+				sdk.NewAttribute(types.AttributeKeyTxID, hex.EncodeToString(txID)),
+				sdk.NewAttribute(types.AttributeKeyGranter, hex.EncodeToString(granter)),
+				sdk.NewAttribute(types.AttributeKeyGrantee, hex.EncodeToString(grantee)),
+			),
+		)
+	}
+	return accounts, nil
+}
+
+func (k Keeper) findAcceptableGrantee(ctx sdk.Context, granter types.AccountID, grantees []types.AccountID, targets []types.SignTarget) (types.AccountID, error) {
+	var lastErr error
+	for _, grantee := range grantees {
+		grant, found := k.GetSignGrant(ctx, granter, grantee)
+		if !found {
+			continue
+		}
+		if err := grant.Accept(ctx.BlockTime(), targets); err != nil {
+			lastErr = err
+			continue
+		}
+		return grantee, nil
+	}
+	if lastErr != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, lastErr.Error())
+	}
+	return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no grants of the granter '%X' are found", granter)
+}
+
+func (k Keeper) setSignGrant(ctx sdk.Context, grant types.SignGrant) {
+	k.store(ctx).Set(types.KeySignGrant(grant.Granter, grant.Grantee), k.m.MustMarshal(&grant))
+}
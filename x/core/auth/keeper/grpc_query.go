@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/datachainlab/cross/x/core/auth/types"
@@ -17,3 +18,22 @@ func (q Keeper) TxAuthState(c context.Context, req *types.QueryTxAuthStateReques
 	}
 	return &types.QueryTxAuthStateResponse{TxAuthState: state}, nil
 }
+
+func (q Keeper) IBCSignTxRecord(c context.Context, req *types.QueryIBCSignTxRecordRequest) (*types.QueryIBCSignTxRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := q.GetIBCSignTxRecord(ctx, req.TxID, req.Signer)
+	if !found {
+		return nil, fmt.Errorf("record not found: txID=%x signer=%x", req.TxID, req.Signer)
+	}
+	return &types.QueryIBCSignTxRecordResponse{Record: record}, nil
+}
+
+func (q Keeper) IBCSignTxRecords(c context.Context, req *types.QueryIBCSignTxRecordsRequest) (*types.QueryIBCSignTxRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryIBCSignTxRecordsResponse{Records: q.GetIBCSignTxRecords(ctx, req.TxID)}, nil
+}
+
+func (q Keeper) SignGrants(c context.Context, req *types.QuerySignGrantsRequest) (*types.QuerySignGrantsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QuerySignGrantsResponse{Grants: q.GetSignGrants(ctx, req.Granter)}, nil
+}
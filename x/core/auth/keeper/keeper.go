@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 
@@ -54,6 +55,16 @@ func (k *Keeper) SetTxManager(txm types.TxManager) {
 
 // InitAuthState implements the TxAuthenticator interface
 func (k Keeper) InitAuthState(ctx sdk.Context, txID crosstypes.TxID, signers []authtypes.Account) error {
+	return k.InitAuthStateWithSignerGroups(ctx, txID, signers, nil)
+}
+
+// InitAuthStateWithSignerGroups implements the TxAuthenticator interface
+func (k Keeper) InitAuthStateWithSignerGroups(ctx sdk.Context, txID crosstypes.TxID, signers []authtypes.Account, groups []authtypes.SignerGroup) error {
+	for _, g := range groups {
+		if err := g.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	_, err := k.getAuthState(ctx, txID)
 	if err == nil {
 		return fmt.Errorf("id '%x' already exists", txID)
@@ -61,7 +72,18 @@ func (k Keeper) InitAuthState(ctx sdk.Context, txID crosstypes.TxID, signers []a
 		return err
 	}
 
-	return k.setAuthState(ctx, txID, types.TxAuthState{RemainingSigners: signers})
+	signers, err = authtypes.NormalizeChannelAccounts(ctx, k.m, k.xccResolver, signers)
+	if err != nil {
+		return err
+	}
+	for i := range groups {
+		groups[i].Members, err = authtypes.NormalizeChannelAccounts(ctx, k.m, k.xccResolver, groups[i].Members)
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.setAuthState(ctx, txID, types.NewTxAuthState(signers, groups))
 }
 
 // IsCompletedAuth implements the TxAuthenticator interface
@@ -76,7 +98,15 @@ func (k Keeper) IsCompletedAuth(ctx sdk.Context, txID crosstypes.TxID) (bool, er
 // Sign implements the TxAuthenticator interface
 func (k Keeper) Sign(ctx sdk.Context, txID crosstypes.TxID, signers []authtypes.Account) (bool, error) {
 	state, err := k.getAuthState(ctx, txID)
-	if err != nil {
+	if errors.As(err, &types.ErrIDNotFound{}) && k.txManager != nil {
+		// the auth state is deleted when the tx is cancelled or expired
+		if active, aerr := k.txManager.IsActive(ctx, txID); aerr != nil {
+			return false, aerr
+		} else if !active {
+			return false, fmt.Errorf("txID '%x' is not active: the tx may have been cancelled or expired", txID)
+		}
+		return false, err
+	} else if err != nil {
 		return false, err
 	}
 	if state.IsCompleted() {
@@ -93,6 +123,60 @@ func (k Keeper) Sign(ctx sdk.Context, txID crosstypes.TxID, signers []authtypes.
 	return state.IsCompleted(), nil
 }
 
+// Revoke cancels the signs of given signers if the authentication of the tx is not completed yet
+func (k Keeper) Revoke(ctx sdk.Context, txID crosstypes.TxID, signers []authtypes.Account) error {
+	state, err := k.getAuthState(ctx, txID)
+	if err != nil {
+		return err
+	}
+	if state.IsCompleted() {
+		return fmt.Errorf("id '%x' is already completed", txID)
+	}
+	if !state.RevokeSigners(signers) {
+		return fmt.Errorf("no signers have signed the tx '%x'", txID)
+	}
+	if err := k.setAuthState(ctx, txID, *state); err != nil {
+		return err
+	}
+	for _, signer := range signers {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRevokeSign,
+				sdk.NewAttribute(types.AttributeKeyTxID, hex.EncodeToString(txID)),
+				sdk.NewAttribute(types.AttributeKeySigner, hex.EncodeToString(signer.Id)),
+			),
+		)
+	}
+	return nil
+}
+
+// DeleteAuthState implements the TxAuthenticator interface
+func (k Keeper) DeleteAuthState(ctx sdk.Context, txID crosstypes.TxID) error {
+	if _, err := k.getAuthState(ctx, txID); err != nil {
+		return err
+	}
+	prefix.NewStore(k.store(ctx), types.KeyTxAuthState()).Delete(txID)
+	return nil
+}
+
+// GetRemainingSigners implements the TxAuthenticator interface
+func (k Keeper) GetRemainingSigners(ctx sdk.Context, txID crosstypes.TxID) ([]authtypes.Account, error) {
+	state, err := k.getAuthState(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+	return state.RemainingAccounts(), nil
+}
+
+// GetSignedSigners implements the TxAuthenticator interface
+func (k Keeper) GetSignedSigners(ctx sdk.Context, txID crosstypes.TxID) ([]authtypes.Account, error) {
+	state, err := k.getAuthState(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+	return state.SignedSigners, nil
+}
+
 func (k Keeper) getAuthState(ctx sdk.Context, id []byte) (*types.TxAuthState, error) {
 	store := prefix.NewStore(k.store(ctx), types.KeyTxAuthState())
 	bz := store.Get(id)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
@@ -14,6 +15,7 @@ import (
 	crosstypes "github.com/datachainlab/cross/x/core/types"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 	ibctesting "github.com/datachainlab/cross/x/ibc/testing"
+	"github.com/datachainlab/cross/x/packets"
 )
 
 type KeeperTestSuite struct {
@@ -88,6 +90,201 @@ func (suite *KeeperTestSuite) TestAuth() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRevokeSign() {
+	// setup channels
+	_, _, connAB, connBA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelAB, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connAB, connBA, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+	chAB := xcctypes.ChannelInfo{Port: channelAB.PortID, Channel: channelAB.ID}
+
+	akA := suite.chainA.App.CrossKeeper.AuthKeeper()
+
+	accA := authtypes.NewLocalAccount(suite.chainA.SenderAccount.GetAddress().Bytes())
+	accB := authtypes.NewAccount(suite.chainB.SenderAccount.GetAddress().Bytes(), authtypes.NewAuthTypeChannel(&chAB))
+
+	var txID = []byte("tx0")
+	suite.Require().NoError(
+		akA.InitAuthState(suite.chainA.GetContext(), txID, []authtypes.Account{accA, accB}),
+	)
+
+	revokeB := func() error {
+		return akA.ReceiveIBCRevokeSign(
+			suite.chainA.GetContext(),
+			channelAB.PortID, chAB.Channel,
+			authtypes.NewPacketDataIBCRevokeSign(txID, []authtypes.AccountID{accB.Id}, clienttypes.NewHeight(0, 100), 0),
+		)
+	}
+	signB := func() (bool, error) {
+		return akA.ReceiveIBCSignTx(
+			suite.chainA.GetContext(),
+			channelAB.PortID, chAB.Channel,
+			authtypes.NewPacketDataIBCSignTx(txID, []authtypes.AccountID{accB.Id}, clienttypes.NewHeight(0, 100), 0),
+		)
+	}
+
+	// the signer that hasn't signed yet cannot revoke
+	suite.Require().Error(revokeB())
+
+	completed, err := signB()
+	suite.Require().NoError(err)
+	suite.Require().False(completed)
+
+	signed, err := akA.GetSignedSigners(suite.chainA.GetContext(), txID)
+	suite.Require().NoError(err)
+	suite.Require().Len(signed, 1)
+	suite.Require().True(signed[0].Equal(accB))
+
+	// the revoked signer is required again
+	suite.Require().NoError(revokeB())
+	signed, err = akA.GetSignedSigners(suite.chainA.GetContext(), txID)
+	suite.Require().NoError(err)
+	suite.Require().Len(signed, 0)
+	remaining, err := akA.GetRemainingSigners(suite.chainA.GetContext(), txID)
+	suite.Require().NoError(err)
+	suite.Require().Len(remaining, 2)
+	suite.Require().True(remaining[0].Equal(accA))
+	suite.Require().True(remaining[1].Equal(accB))
+
+	completed, err = akA.Sign(suite.chainA.GetContext(), txID, []authtypes.Account{accA})
+	suite.Require().NoError(err)
+	suite.Require().False(completed)
+	completed, err = signB()
+	suite.Require().NoError(err)
+	suite.Require().True(completed)
+
+	// the signs cannot be revoked after the authentication is completed
+	suite.Require().Error(revokeB())
+	suite.Require().Error(akA.Revoke(suite.chainA.GetContext(), txID, []authtypes.Account{accA}))
+}
+
+func (suite *KeeperTestSuite) TestIBCSignTxRecord() {
+	// setup channels
+	_, _, connAB, connBA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelAB, channelBA := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connAB, connBA, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+	chAB := xcctypes.ChannelInfo{Port: channelAB.PortID, Channel: channelAB.ID}
+	chBA := xcctypes.ChannelInfo{Port: channelBA.PortID, Channel: channelBA.ID}
+
+	akA := suite.chainA.App.CrossKeeper.AuthKeeper()
+	akB := suite.chainB.App.CrossKeeper.AuthKeeper()
+
+	signer0 := authtypes.AccountID(suite.chainB.SenderAccount.GetAddress())
+	signer1 := authtypes.AccountID("signer1")
+	accB := authtypes.NewAccount(signer0, authtypes.NewAuthTypeChannel(&chAB))
+
+	var txID = []byte("tx0")
+	suite.Require().NoError(
+		akA.InitAuthState(suite.chainA.GetContext(), txID, []authtypes.Account{accB}),
+	)
+
+	// chainB sends the signs to chainA
+	ps := ibctesting.NewCapturePacketSender(
+		packets.NewBasicPacketSender(suite.chainB.App.IBCKeeper.ChannelKeeper),
+	)
+	timeoutHeight := clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100)
+	suite.Require().NoError(
+		akB.SendIBCSignTx(suite.chainB.GetContext(), ps, &chBA, txID, []authtypes.AccountID{signer0}, timeoutHeight, 0),
+	)
+	suite.Require().NoError(
+		akB.SendIBCSignTx(suite.chainB.GetContext(), ps, &chBA, txID, []authtypes.AccountID{signer1}, timeoutHeight, 0),
+	)
+	suite.chainB.NextBlock()
+	suite.Require().Len(ps.Packets(), 2)
+
+	records := akB.GetIBCSignTxRecords(suite.chainB.GetContext(), txID)
+	suite.Require().Len(records, 2)
+	for _, r := range records {
+		suite.Require().Equal(authtypes.IBC_SIGN_TX_RECORD_STATUS_PENDING, r.Status)
+	}
+
+	// chainA accepts the sign of signer0
+	completed, err := akA.ReceiveIBCSignTx(
+		suite.chainA.GetContext(),
+		chAB.Port, chAB.Channel,
+		authtypes.NewPacketDataIBCSignTx(txID, []authtypes.AccountID{signer0}, timeoutHeight, 0),
+	)
+	suite.Require().NoError(err)
+	suite.Require().True(completed)
+
+	ctxB := suite.chainB.GetContext()
+	akB.HandleIBCSignTxAcknowledgement(
+		ctxB,
+		authtypes.NewPacketDataIBCSignTx(txID, []authtypes.AccountID{signer0}, timeoutHeight, 0),
+		authtypes.PacketAcknowledgementIBCSignTx{Status: authtypes.IBC_SIGN_TX_STATUS_OK},
+	)
+	akB.HandleIBCSignTxTimeout(
+		ctxB,
+		authtypes.NewPacketDataIBCSignTx(txID, []authtypes.AccountID{signer1}, timeoutHeight, 0),
+	)
+	suite.Require().Len(ctxB.EventManager().Events(), 2)
+	suite.Require().Equal(authtypes.EventTypeIBCSignTx, ctxB.EventManager().Events()[0].Type)
+
+	record, found := akB.GetIBCSignTxRecord(ctxB, txID, signer0)
+	suite.Require().True(found)
+	suite.Require().Equal(authtypes.IBC_SIGN_TX_RECORD_STATUS_OK, record.Status)
+	record, found = akB.GetIBCSignTxRecord(ctxB, txID, signer1)
+	suite.Require().True(found)
+	suite.Require().Equal(authtypes.IBC_SIGN_TX_RECORD_STATUS_TIMEOUT, record.Status)
+
+	// an error acknowledgement is recorded with the error message
+	akB.HandleIBCSignTxErrorAcknowledgement(
+		ctxB,
+		authtypes.NewPacketDataIBCSignTx(txID, []authtypes.AccountID{signer1}, timeoutHeight, 0),
+		"failed",
+	)
+	record, found = akB.GetIBCSignTxRecord(ctxB, txID, signer1)
+	suite.Require().True(found)
+	suite.Require().Equal(authtypes.IBC_SIGN_TX_RECORD_STATUS_FAILED, record.Status)
+	suite.Require().Equal("failed", record.ErrorMessage)
+
+	// a failed acknowledgement is recorded with the failure reason
+	akB.HandleIBCSignTxAcknowledgement(
+		ctxB,
+		authtypes.NewPacketDataIBCSignTx(txID, []authtypes.AccountID{signer1}, timeoutHeight, 0),
+		authtypes.PacketAcknowledgementIBCSignTx{Status: authtypes.IBC_SIGN_TX_STATUS_FAILED, ErrorMessage: "unknown signer"},
+	)
+	record, found = akB.GetIBCSignTxRecord(ctxB, txID, signer1)
+	suite.Require().True(found)
+	suite.Require().Equal(authtypes.IBC_SIGN_TX_RECORD_STATUS_FAILED, record.Status)
+	suite.Require().Equal("unknown signer", record.ErrorMessage)
+
+	_, found = akB.GetIBCSignTxRecord(ctxB, []byte("tx1"), signer0)
+	suite.Require().False(found)
+
+	// the record is kept if the counterparty chain fails to revoke the sign
+	revokeData := authtypes.NewPacketDataIBCRevokeSign(txID, []authtypes.AccountID{signer1}, timeoutHeight, 0)
+	akB.HandleIBCRevokeSignAcknowledgement(ctxB, revokeData, authtypes.PacketAcknowledgementIBCRevokeSign{Status: authtypes.IBC_SIGN_TX_STATUS_FAILED})
+	record, found = akB.GetIBCSignTxRecord(ctxB, txID, signer1)
+	suite.Require().True(found)
+	suite.Require().Equal(authtypes.IBC_SIGN_TX_RECORD_STATUS_FAILED, record.Status)
+
+	akB.HandleIBCRevokeSignAcknowledgement(ctxB, revokeData, authtypes.PacketAcknowledgementIBCRevokeSign{Status: authtypes.IBC_SIGN_TX_STATUS_OK})
+	record, found = akB.GetIBCSignTxRecord(ctxB, txID, signer1)
+	suite.Require().True(found)
+	suite.Require().Equal(authtypes.IBC_SIGN_TX_RECORD_STATUS_REVOKED, record.Status)
+	suite.Require().Equal(uint64(ctxB.BlockTime().Unix()), record.UpdatedAt)
+
+	// the records are pruned once the retention has passed since their last update
+	const retention = 100
+	updatedAt := ctxB.BlockTime()
+	ctxB = ctxB.WithBlockTime(updatedAt.Add((retention - 1) * time.Second))
+	suite.Require().Equal(0, akB.PruneIBCSignTxRecords(ctxB, retention, authtypes.MaxPrunedIBCSignTxRecordsPerBlock))
+	akB.HandleIBCSignTxAcknowledgement(
+		ctxB,
+		authtypes.NewPacketDataIBCSignTx(txID, []authtypes.AccountID{signer0}, timeoutHeight, 0),
+		authtypes.PacketAcknowledgementIBCSignTx{Status: authtypes.IBC_SIGN_TX_STATUS_OK},
+	)
+
+	ctxB = ctxB.WithBlockTime(updatedAt.Add(retention * time.Second))
+	suite.Require().Equal(1, akB.PruneIBCSignTxRecords(ctxB, retention, authtypes.MaxPrunedIBCSignTxRecordsPerBlock))
+	_, found = akB.GetIBCSignTxRecord(ctxB, txID, signer1)
+	suite.Require().False(found)
+	_, found = akB.GetIBCSignTxRecord(ctxB, txID, signer0)
+	suite.Require().True(found)
+
+	ctxB = ctxB.WithBlockTime(updatedAt.Add((2*retention - 1) * time.Second))
+	suite.Require().Equal(1, akB.PruneIBCSignTxRecords(ctxB, retention, authtypes.MaxPrunedIBCSignTxRecordsPerBlock))
+	suite.Require().Empty(akB.GetIBCSignTxRecords(ctxB, txID))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -20,6 +20,11 @@ func (k Keeper) SignTx(goCtx context.Context, msg *types.MsgSignTx) (*types.MsgS
 	for _, addr := range msg.Signers {
 		accounts = append(accounts, authtypes.NewAccount(addr, authtypes.NewAuthTypeLocal()))
 	}
+	granters, err := k.ResolveGranters(ctx, msg.TxID, msg.Signers, msg.Granters)
+	if err != nil {
+		return nil, err
+	}
+	accounts = append(accounts, granters...)
 	completed, err := k.Sign(ctx, msg.TxID, accounts)
 	if err != nil {
 		return nil, err
@@ -77,3 +82,64 @@ func (k Keeper) ExtSignTx(goCtx context.Context, msg *types.MsgExtSignTx) (*type
 	}
 	return &types.MsgExtSignTxResponse{}, nil
 }
+
+// RevokeSign defines a rpc handler method for MsgRevokeSign.
+func (k Keeper) RevokeSign(goCtx context.Context, msg *types.MsgRevokeSign) (*types.MsgRevokeSignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var accounts []authtypes.Account
+	for _, addr := range msg.Signers {
+		accounts = append(accounts, authtypes.NewAccount(addr, authtypes.NewAuthTypeLocal()))
+	}
+	if err := k.Revoke(ctx, msg.TxID, accounts); err != nil {
+		return nil, err
+	}
+	return &types.MsgRevokeSignResponse{}, nil
+}
+
+// IBCRevokeSign defines a rpc handler method for MsgIBCRevokeSign.
+func (k Keeper) IBCRevokeSign(goCtx context.Context, msg *types.MsgIBCRevokeSign) (*types.MsgIBCRevokeSignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	xcc, err := xcctypes.UnpackCrossChainChannel(k.m, *msg.CrossChainChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run packet middlewares
+
+	ctx, ps, err := k.packetMiddleware.HandleMsg(ctx, msg, packets.NewBasicPacketSender(k.channelKeeper))
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SendIBCRevokeSign(
+		ctx,
+		ps,
+		xcc,
+		msg.TxID,
+		msg.Signers,
+		msg.TimeoutHeight,
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgIBCRevokeSignResponse{}, nil
+}
+
+// GrantSign defines a rpc handler method for MsgGrantSign.
+func (k Keeper) GrantSign(goCtx context.Context, msg *types.MsgGrantSign) (*types.MsgGrantSignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Grant(ctx, msg.Grant()); err != nil {
+		return nil, err
+	}
+	return &types.MsgGrantSignResponse{}, nil
+}
+
+// RevokeSignGrant defines a rpc handler method for MsgRevokeSignGrant.
+func (k Keeper) RevokeSignGrant(goCtx context.Context, msg *types.MsgRevokeSignGrant) (*types.MsgRevokeSignGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.RevokeGrant(ctx, msg.Granter, msg.Grantee); err != nil {
+		return nil, err
+	}
+	return &types.MsgRevokeSignGrantResponse{}, nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/hex"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
@@ -22,33 +24,49 @@ func (p Keeper) HandlePacket(
 	}
 
 	var (
-		status types.IBCSignTxStatus
-		log    string
-	)
-	data := *ip.Payload().(*types.PacketDataIBCSignTx)
-	completed, err := p.ReceiveIBCSignTx(
-		ctx,
-		packet.DestinationPort, packet.DestinationChannel,
-		data,
+		payload packets.PacketAcknowledgementPayload
+		log     string
 	)
-	switch {
-	case err == nil && completed:
-		status = types.IBC_SIGN_TX_STATUS_OK
-		if err := p.txManager.OnPostAuth(ctx, data.TxID); err != nil {
-			p.Logger(ctx).Error("failed to call PostAuth", "err", err)
+	switch data := ip.Payload().(type) {
+	case *types.PacketDataIBCSignTx:
+		var status types.IBCSignTxStatus
+		completed, err := p.ReceiveIBCSignTx(
+			ctx,
+			packet.DestinationPort, packet.DestinationChannel,
+			*data,
+		)
+		var errMsg string
+		switch {
+		case err == nil && completed:
+			status = types.IBC_SIGN_TX_STATUS_OK
+			if err := p.txManager.OnPostAuth(ctx, data.TxID); err != nil {
+				p.Logger(ctx).Error("failed to call PostAuth", "err", err)
+				log = err.Error()
+			}
+		case err == nil:
+			status = types.IBC_SIGN_TX_STATUS_OK
+		default:
+			status = types.IBC_SIGN_TX_STATUS_FAILED
+			log = err.Error()
+			errMsg = err.Error()
+		}
+		payload = &types.PacketAcknowledgementIBCSignTx{Status: status, ErrorMessage: errMsg}
+	case *types.PacketDataIBCRevokeSign:
+		status := types.IBC_SIGN_TX_STATUS_OK
+		if err := p.ReceiveIBCRevokeSign(
+			ctx,
+			packet.DestinationPort, packet.DestinationChannel,
+			*data,
+		); err != nil {
+			status = types.IBC_SIGN_TX_STATUS_FAILED
 			log = err.Error()
 		}
-	case err == nil:
-		status = types.IBC_SIGN_TX_STATUS_OK
+		payload = &types.PacketAcknowledgementIBCRevokeSign{Status: status}
 	default:
-		status = types.IBC_SIGN_TX_STATUS_FAILED
-		log = err.Error()
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected packet type: %T", data)
 	}
 
-	ack := packets.NewOutgoingPacketAcknowledgement(
-		nil,
-		&types.PacketAcknowledgementIBCSignTx{Status: status},
-	)
+	ack := packets.NewOutgoingPacketAcknowledgement(nil, payload)
 	if err = as.SendACK(ctx, ack); err != nil {
 		return nil, nil, err
 	}
@@ -63,6 +81,69 @@ func (p Keeper) HandleACK(
 	ip packets.IncomingPacket,
 	ipa packets.IncomingPacketAcknowledgement,
 ) (*sdk.Result, error) {
-	// TODO handle an acknowledgement data
+	ctx, _, err := p.packetMiddleware.HandleACK(ctx, ip, ipa, packets.NewBasicPacketSender(p.channelKeeper))
+	if err != nil {
+		return nil, err
+	}
+
+	switch payload := ipa.Payload().(type) {
+	case *types.PacketAcknowledgementIBCSignTx:
+		data, ok := ip.Payload().(*types.PacketDataIBCSignTx)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected packet type: %T", ip.Payload())
+		}
+		p.HandleIBCSignTxAcknowledgement(ctx, *data, *payload)
+	case *types.PacketAcknowledgementIBCRevokeSign:
+		data, ok := ip.Payload().(*types.PacketDataIBCRevokeSign)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected packet type: %T", ip.Payload())
+		}
+		p.HandleIBCRevokeSignAcknowledgement(ctx, *data, *payload)
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ack type: %T", payload)
+	}
+	return &sdk.Result{Data: nil, Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func (p Keeper) HandleTimeout(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+) (*sdk.Result, error) {
+	switch data := ip.Payload().(type) {
+	case *types.PacketDataIBCSignTx:
+		p.HandleIBCSignTxTimeout(ctx, *data)
+	case *types.PacketDataIBCRevokeSign:
+		// nop: the signs are kept on the counterparty chain
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected timeout packet type: %T", data)
+	}
+	return &sdk.Result{Data: nil, Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func (p Keeper) HandleErrorACK(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ip packets.IncomingPacket,
+	errMsg string,
+) (*sdk.Result, error) {
+	var txID []byte
+	switch data := ip.Payload().(type) {
+	case *types.PacketDataIBCSignTx:
+		txID = data.TxID
+		p.HandleIBCSignTxErrorAcknowledgement(ctx, *data, errMsg)
+	case *types.PacketDataIBCRevokeSign:
+		txID = data.TxID
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected packet type: %T", data)
+	}
+	p.Logger(ctx).Info("received an error acknowledgement", "txID", hex.EncodeToString(txID), "error", errMsg)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeErrorACK,
+			sdk.NewAttribute(types.AttributeKeyTxID, hex.EncodeToString(txID)),
+			sdk.NewAttribute(types.AttributeKeyErrorMessage, errMsg),
+		),
+	)
 	return &sdk.Result{Data: nil, Events: ctx.EventManager().ABCIEvents()}, nil
 }
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/datachainlab/cross/x/core/auth/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+)
+
+// HandleIBCSignTxAcknowledgement records the result of the signs that are sent to other chain
+func (k Keeper) HandleIBCSignTxAcknowledgement(ctx sdk.Context, data types.PacketDataIBCSignTx, ack types.PacketAcknowledgementIBCSignTx) {
+	status := types.IBC_SIGN_TX_RECORD_STATUS_FAILED
+	if ack.Status == types.IBC_SIGN_TX_STATUS_OK {
+		status = types.IBC_SIGN_TX_RECORD_STATUS_OK
+	}
+	k.updateIBCSignTxRecords(ctx, data.TxID, data.Signers, status, ack.ErrorMessage)
+}
+
+// HandleIBCSignTxErrorAcknowledgement records the signs that are sent to other chain as failed
+func (k Keeper) HandleIBCSignTxErrorAcknowledgement(ctx sdk.Context, data types.PacketDataIBCSignTx, errMsg string) {
+	k.updateIBCSignTxRecords(ctx, data.TxID, data.Signers, types.IBC_SIGN_TX_RECORD_STATUS_FAILED, errMsg)
+}
+
+// HandleIBCSignTxTimeout records the signs that are sent to other chain as timed out
+func (k Keeper) HandleIBCSignTxTimeout(ctx sdk.Context, data types.PacketDataIBCSignTx) {
+	k.updateIBCSignTxRecords(ctx, data.TxID, data.Signers, types.IBC_SIGN_TX_RECORD_STATUS_TIMEOUT, "")
+}
+
+// HandleIBCRevokeSignAcknowledgement records the signs that are revoked on other chain
+// The records are kept as they are if the counterparty chain fails to revoke the signs.
+func (k Keeper) HandleIBCRevokeSignAcknowledgement(ctx sdk.Context, data types.PacketDataIBCRevokeSign, ack types.PacketAcknowledgementIBCRevokeSign) {
+	if ack.Status != types.IBC_SIGN_TX_STATUS_OK {
+		return
+	}
+	k.updateIBCSignTxRecords(ctx, data.TxID, data.Signers, types.IBC_SIGN_TX_RECORD_STATUS_REVOKED, "")
+}
+
+// PruneIBCSignTxRecords deletes the records that haven't been updated for the given retention (in seconds)
+// It deletes at most `limit` records and returns the number of deleted records.
+func (k Keeper) PruneIBCSignTxRecords(ctx sdk.Context, retention uint64, limit int) int {
+	now := uint64(ctx.BlockTime().Unix())
+	if now < retention {
+		return 0
+	}
+	store := prefix.NewStore(k.store(ctx), types.KeyPrefixBytes(types.KeyIBCSignTxRecordUpdatedAtPrefix))
+	// end is exclusive, so the records that are updated exactly `retention` seconds ago are included
+	it := store.Iterator(nil, sdk.Uint64ToBigEndian(now-retention+1))
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid() && len(keys) < limit; it.Next() {
+		keys = append(keys, append([]byte{}, it.Key()...))
+	}
+	for _, key := range keys {
+		store.Delete(key)
+		// the key consists of updatedAt, the length-prefixed txID and the signer
+		txIDLen := int(key[8])
+		k.store(ctx).Delete(types.KeyIBCSignTxRecord(key[9:9+txIDLen], key[9+txIDLen:]))
+	}
+	return len(keys)
+}
+
+// GetIBCSignTxRecord returns the record of the sign that is sent to other chain
+func (k Keeper) GetIBCSignTxRecord(ctx sdk.Context, txID crosstypes.TxID, signer types.AccountID) (*types.IBCSignTxRecord, bool) {
+	bz := k.store(ctx).Get(types.KeyIBCSignTxRecord(txID, signer))
+	if bz == nil {
+		return nil, false
+	}
+	var record types.IBCSignTxRecord
+	k.m.MustUnmarshal(bz, &record)
+	return &record, true
+}
+
+// GetIBCSignTxRecords returns the records of the signs that are sent to other chain for a given txID
+func (k Keeper) GetIBCSignTxRecords(ctx sdk.Context, txID crosstypes.TxID) []types.IBCSignTxRecord {
+	store := prefix.NewStore(k.store(ctx), types.KeyIBCSignTxRecordsByTxID(txID))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var records []types.IBCSignTxRecord
+	for ; iter.Valid(); iter.Next() {
+		var record types.IBCSignTxRecord
+		k.m.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// setIBCSignTxRecord sets the record and updates the index for pruning
+func (k Keeper) setIBCSignTxRecord(ctx sdk.Context, record types.IBCSignTxRecord) {
+	if prev, found := k.GetIBCSignTxRecord(ctx, record.TxID, record.Signer); found {
+		k.store(ctx).Delete(types.KeyIBCSignTxRecordUpdatedAt(prev.UpdatedAt, prev.TxID, prev.Signer))
+	}
+	k.store(ctx).Set(types.KeyIBCSignTxRecord(record.TxID, record.Signer), k.m.MustMarshal(&record))
+	k.store(ctx).Set(types.KeyIBCSignTxRecordUpdatedAt(record.UpdatedAt, record.TxID, record.Signer), []byte{1})
+}
+
+func (k Keeper) updateIBCSignTxRecords(ctx sdk.Context, txID crosstypes.TxID, signers []types.AccountID, status types.IBCSignTxRecordStatus, errMsg string) {
+	for _, signer := range signers {
+		record := types.NewIBCSignTxRecord(txID, signer, status)
+		record.ErrorMessage = errMsg
+		record.UpdatedAt = uint64(ctx.BlockTime().Unix())
+		k.setIBCSignTxRecord(ctx, record)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeIBCSignTx,
+				sdk.NewAttribute(types.AttributeKeyTxID, hex.EncodeToString(txID)),
+				sdk.NewAttribute(types.AttributeKeySigner, hex.EncodeToString(signer)),
+				sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+			),
+		)
+	}
+}
@@ -21,6 +21,37 @@ func (k Keeper) SendIBCSignTx(
 	signers []authtypes.AccountID,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+) error {
+	payload := types.NewPacketDataIBCSignTx(txID, signers, timeoutHeight, timeoutTimestamp)
+	if err := k.sendPacketToXCC(ctx, packetSender, xcc, &payload, timeoutHeight, timeoutTimestamp); err != nil {
+		return err
+	}
+	// the records are updated when the acknowledgement or timeout is received
+	k.updateIBCSignTxRecords(ctx, txID, signers, types.IBC_SIGN_TX_RECORD_STATUS_PENDING, "")
+	return nil
+}
+
+// SendIBCRevokeSign sends PacketDataIBCRevokeSign
+func (k Keeper) SendIBCRevokeSign(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	xcc xcctypes.XCC,
+	txID crosstypes.TxID,
+	signers []authtypes.AccountID,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	payload := types.NewPacketDataIBCRevokeSign(txID, signers, timeoutHeight, timeoutTimestamp)
+	return k.sendPacketToXCC(ctx, packetSender, xcc, &payload, timeoutHeight, timeoutTimestamp)
+}
+
+func (k Keeper) sendPacketToXCC(
+	ctx sdk.Context,
+	packetSender packets.PacketSender,
+	xcc xcctypes.XCC,
+	payload packets.PacketDataPayload,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
 ) error {
 	ci, err := k.xccResolver.ResolveCrossChainChannel(ctx, xcc)
 	if err != nil {
@@ -32,11 +63,10 @@ func (k Keeper) SendIBCSignTx(
 		return fmt.Errorf("channel '%v' not found", ci.String())
 	}
 
-	payload := types.NewPacketDataIBCSignTx(txID, signers, timeoutHeight, timeoutTimestamp)
 	return k.SendPacket(
 		ctx,
 		packetSender,
-		&payload,
+		payload,
 		ci.Port, ci.Channel,
 		c.Counterparty.PortId, c.Counterparty.ChannelId,
 		timeoutHeight,
@@ -79,6 +109,27 @@ func (k Keeper) ReceiveIBCSignTx(
 	return true, nil
 }
 
+// ReceiveIBCRevokeSign receives PacketDataIBCRevokeSign to revoke the signs of the signers
+func (k Keeper) ReceiveIBCRevokeSign(
+	ctx sdk.Context,
+	destPort,
+	destChannel string,
+	data types.PacketDataIBCRevokeSign,
+) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+	_, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return fmt.Errorf("channel(port=%v channel=%v) not found", destPort, destChannel)
+	}
+	xcc, err := k.xccResolver.ResolveChannel(ctx, &xcctypes.ChannelInfo{Port: destPort, Channel: destChannel})
+	if err != nil {
+		return err
+	}
+	return k.Revoke(ctx, data.TxID, makeChannelSigners(xcc, data.Signers))
+}
+
 func makeChannelSigners(xcc xcctypes.XCC, signers []authtypes.AccountID) []authtypes.Account {
 	var accs []authtypes.Account
 	for _, id := range signers {
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
@@ -105,3 +106,36 @@ func NewAuthTypeExtension(extension AuthExtensionVerifier) AuthType {
 		Option: any,
 	}
 }
+
+// NormalizeChannelAccounts converts the accounts authenticated with ChainInfo into the ones authenticated with the registered channel
+// because the signers of an IBCSignTx packet are identified by the channel that receives it.
+// The accounts of the self chain are converted into local accounts.
+// The returned accounts keep the order of given accounts.
+func NormalizeChannelAccounts(ctx sdk.Context, cdc codec.Codec, xccResolver xcctypes.XCCResolver, accs []Account) ([]Account, error) {
+	var normalized []Account
+	for _, acc := range accs {
+		if acc.AuthType.Mode != AuthMode_AUTH_MODE_CHANNEL || acc.AuthType.Option == nil {
+			normalized = append(normalized, acc)
+			continue
+		}
+		xcc, err := xcctypes.UnpackCrossChainChannel(cdc, *acc.AuthType.Option)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := xcc.(*xcctypes.ChainInfo); !ok {
+			normalized = append(normalized, acc)
+			continue
+		}
+		if xccResolver.IsSelfCrossChainChannel(ctx, xcc) {
+			// the account on the self chain must sign the tx locally
+			normalized = append(normalized, NewLocalAccount(acc.Id))
+			continue
+		}
+		ci, err := xccResolver.ResolveCrossChainChannel(ctx, xcc)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, NewAccount(acc.Id, NewAuthTypeChannel(ci)))
+	}
+	return normalized, nil
+}
@@ -15,18 +15,30 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSignTx{},
 		&MsgIBCSignTx{},
 		&MsgExtSignTx{},
+		&MsgRevokeSign{},
+		&MsgIBCRevokeSign{},
+		&MsgGrantSign{},
+		&MsgRevokeSignGrant{},
 	)
 	registry.RegisterImplementations(
 		(*ExtAuthMsg)(nil),
 		&MsgExtSignTx{},
 	)
+	registry.RegisterImplementations(
+		(*AuthExtensionVerifier)(nil),
+		&Secp256K1AuthExtension{},
+		&Ed25519AuthExtension{},
+		&EthereumAuthExtension{},
+	)
 	registry.RegisterImplementations(
 		(*packets.PacketDataPayload)(nil),
 		&PacketDataIBCSignTx{},
+		&PacketDataIBCRevokeSign{},
 	)
 	registry.RegisterImplementations(
 		(*packets.PacketAcknowledgementPayload)(nil),
 		&PacketAcknowledgementIBCSignTx{},
+		&PacketAcknowledgementIBCRevokeSign{},
 	)
 }
 
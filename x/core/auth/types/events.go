@@ -0,0 +1,18 @@
+package types
+
+// auth module event types
+const (
+	EventTypeErrorACK        = "error_acknowledgement"
+	EventTypeRevokeSign      = "revoke_sign"
+	EventTypeIBCSignTx       = "ibc_sign_tx"
+	EventTypeGrantSign       = "grant_sign"
+	EventTypeRevokeSignGrant = "revoke_sign_grant"
+	EventTypeSignByGrant     = "sign_by_grant"
+
+	AttributeKeyTxID         = "tx_id"
+	AttributeKeyErrorMessage = "error_message"
+	AttributeKeySigner       = "signer"
+	AttributeKeyStatus       = "status"
+	AttributeKeyGranter      = "granter"
+	AttributeKeyGrantee      = "grantee"
+)
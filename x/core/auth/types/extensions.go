@@ -0,0 +1,193 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/crypto/sha3"
+)
+
+// secp256k1HalfN is the half of the order of the secp256k1 curve
+var secp256k1HalfN = new(big.Int).Rsh(btcec.S256().N, 1)
+
+var (
+	_ AuthExtensionVerifier = (*Secp256K1AuthExtension)(nil)
+	_ AuthExtensionVerifier = (*Ed25519AuthExtension)(nil)
+	_ AuthExtensionVerifier = (*EthereumAuthExtension)(nil)
+)
+
+// EthereumAddressLength is the length of an Ethereum address
+const EthereumAddressLength = 20
+
+// NewSecp256K1AuthExtension creates a new instance of Secp256K1AuthExtension
+func NewSecp256K1AuthExtension(pubKey []byte) *Secp256K1AuthExtension {
+	return &Secp256K1AuthExtension{PubKey: pubKey}
+}
+
+// AccountID returns the account ID that is bound to the public key
+func (ext Secp256K1AuthExtension) AccountID() (AccountID, error) {
+	if len(ext.PubKey) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid secp256k1 public key length: expected=%v actual=%v", secp256k1.PubKeySize, len(ext.PubKey))
+	}
+	pk := secp256k1.PubKey{Key: ext.PubKey}
+	return AccountID(pk.Address()), nil
+}
+
+// Verify implements AuthExtensionVerifier
+func (ext Secp256K1AuthExtension) Verify(ctx sdk.Context, signer Account, signature signing.SignatureV2, tx sdk.Tx) error {
+	id, err := ext.AccountID()
+	if err != nil {
+		return err
+	} else if !bytes.Equal(id, signer.Id) {
+		return fmt.Errorf("the account ID doesn't match the public key: expected=%X actual=%X", id, signer.Id)
+	}
+	sig, err := getSingleSignature(signature)
+	if err != nil {
+		return err
+	}
+	signBytes, err := GetExtAuthSignBytes(ctx.ChainID(), tx)
+	if err != nil {
+		return err
+	}
+	pk := secp256k1.PubKey{Key: ext.PubKey}
+	if !pk.VerifySignature(signBytes, sig) {
+		return fmt.Errorf("failed to verify the secp256k1 signature: signer=%X", signer.Id)
+	}
+	return nil
+}
+
+// NewEd25519AuthExtension creates a new instance of Ed25519AuthExtension
+func NewEd25519AuthExtension(pubKey []byte) *Ed25519AuthExtension {
+	return &Ed25519AuthExtension{PubKey: pubKey}
+}
+
+// AccountID returns the account ID that is bound to the public key
+func (ext Ed25519AuthExtension) AccountID() (AccountID, error) {
+	if len(ext.PubKey) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key length: expected=%v actual=%v", ed25519.PubKeySize, len(ext.PubKey))
+	}
+	pk := ed25519.PubKey{Key: ext.PubKey}
+	return AccountID(pk.Address()), nil
+}
+
+// Verify implements AuthExtensionVerifier
+func (ext Ed25519AuthExtension) Verify(ctx sdk.Context, signer Account, signature signing.SignatureV2, tx sdk.Tx) error {
+	id, err := ext.AccountID()
+	if err != nil {
+		return err
+	} else if !bytes.Equal(id, signer.Id) {
+		return fmt.Errorf("the account ID doesn't match the public key: expected=%X actual=%X", id, signer.Id)
+	}
+	sig, err := getSingleSignature(signature)
+	if err != nil {
+		return err
+	}
+	signBytes, err := GetExtAuthSignBytes(ctx.ChainID(), tx)
+	if err != nil {
+		return err
+	}
+	pk := ed25519.PubKey{Key: ext.PubKey}
+	if !pk.VerifySignature(signBytes, sig) {
+		return fmt.Errorf("failed to verify the ed25519 signature: signer=%X", signer.Id)
+	}
+	return nil
+}
+
+// Verify implements AuthExtensionVerifier
+// The signature must be a 65-byte [R || S || V] signature created by personal_sign of the sign bytes.
+func (ext EthereumAuthExtension) Verify(ctx sdk.Context, signer Account, signature signing.SignatureV2, tx sdk.Tx) error {
+	if len(signer.Id) != EthereumAddressLength {
+		return fmt.Errorf("invalid ethereum address length: expected=%v actual=%v", EthereumAddressLength, len(signer.Id))
+	}
+	sig, err := getSingleSignature(signature)
+	if err != nil {
+		return err
+	}
+	signBytes, err := GetExtAuthSignBytes(ctx.ChainID(), tx)
+	if err != nil {
+		return err
+	}
+	addr, err := RecoverEthereumAddress(EthereumPersonalMessageHash(signBytes), sig)
+	if err != nil {
+		return err
+	} else if !bytes.Equal(addr, signer.Id) {
+		return fmt.Errorf("the recovered address doesn't match the signer: expected=%X actual=%X", signer.Id, addr)
+	}
+	return nil
+}
+
+// GetExtAuthSignBytes returns the bytes that the signers with the built-in auth extensions sign
+// The tx must have a timeout height, which is checked by the TxTimeoutHeightDecorator of the ante handler,
+// so that the signature cannot be submitted again after the timeout.
+func GetExtAuthSignBytes(chainID string, tx sdk.Tx) ([]byte, error) {
+	t, ok := tx.(sdk.TxWithTimeoutHeight)
+	if !ok || t.GetTimeoutHeight() == 0 {
+		return nil, fmt.Errorf("a tx signed with the built-in auth extensions must have a timeout height")
+	}
+	doc := ExtAuthSignDoc{ChainId: chainID, TimeoutHeight: t.GetTimeoutHeight()}
+	for _, msg := range tx.GetMsgs() {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		doc.Msgs = append(doc.Msgs, any)
+	}
+	if t, ok := tx.(sdk.TxWithMemo); ok {
+		doc.Memo = t.GetMemo()
+	}
+	return proto.Marshal(&doc)
+}
+
+// EthereumPersonalMessageHash returns the hash of a given message for personal_sign
+func EthereumPersonalMessageHash(msg []byte) []byte {
+	return keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(msg))), msg)
+}
+
+// RecoverEthereumAddress recovers the Ethereum address from a given hash and a 65-byte [R || S || V] signature
+func RecoverEthereumAddress(hash []byte, sig []byte) ([]byte, error) {
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("invalid signature length: expected=65 actual=%v", len(sig))
+	}
+	v := sig[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return nil, fmt.Errorf("invalid signature recovery id: %v", sig[64])
+	}
+	// reject the malleable signatures as well as Ethereum (EIP-2)
+	if new(big.Int).SetBytes(sig[32:64]).Cmp(secp256k1HalfN) > 0 {
+		return nil, fmt.Errorf("invalid signature: S must be in the lower half of the curve order")
+	}
+	// btcec expects a compact signature in the form of [V || R || S]
+	compact := append([]byte{v + 27}, sig[:64]...)
+	pub, _, err := btcec.RecoverCompact(btcec.S256(), compact, hash)
+	if err != nil {
+		return nil, err
+	}
+	return keccak256(pub.SerializeUncompressed()[1:])[12:], nil
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+func getSingleSignature(signature signing.SignatureV2) ([]byte, error) {
+	data, ok := signature.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return nil, fmt.Errorf("unexpected signature data type: %T", signature.Data)
+	}
+	return data.Signature, nil
+}
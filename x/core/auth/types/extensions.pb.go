@@ -0,0 +1,920 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cross/core/auth/extensions.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Secp256k1AuthExtension is an AuthExtensionVerifier that verifies a secp256k1 signature
+// The account ID must be the address of the public key.
+type Secp256K1AuthExtension struct {
+	// pub_key is a compressed secp256k1 public key
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *Secp256K1AuthExtension) Reset()         { *m = Secp256K1AuthExtension{} }
+func (m *Secp256K1AuthExtension) String() string { return proto.CompactTextString(m) }
+func (*Secp256K1AuthExtension) ProtoMessage()    {}
+func (*Secp256K1AuthExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7569fc6f9b02a9c1, []int{0}
+}
+func (m *Secp256K1AuthExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Secp256K1AuthExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Secp256K1AuthExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Secp256K1AuthExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Secp256K1AuthExtension.Merge(m, src)
+}
+func (m *Secp256K1AuthExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *Secp256K1AuthExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_Secp256K1AuthExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Secp256K1AuthExtension proto.InternalMessageInfo
+
+// Ed25519AuthExtension is an AuthExtensionVerifier that verifies an ed25519 signature
+// The account ID must be the address of the public key.
+type Ed25519AuthExtension struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *Ed25519AuthExtension) Reset()         { *m = Ed25519AuthExtension{} }
+func (m *Ed25519AuthExtension) String() string { return proto.CompactTextString(m) }
+func (*Ed25519AuthExtension) ProtoMessage()    {}
+func (*Ed25519AuthExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7569fc6f9b02a9c1, []int{1}
+}
+func (m *Ed25519AuthExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ed25519AuthExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ed25519AuthExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Ed25519AuthExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ed25519AuthExtension.Merge(m, src)
+}
+func (m *Ed25519AuthExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ed25519AuthExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ed25519AuthExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ed25519AuthExtension proto.InternalMessageInfo
+
+// EthereumAuthExtension is an AuthExtensionVerifier that verifies a signature created by Ethereum personal_sign
+// The account ID must be the Ethereum address of the signer.
+type EthereumAuthExtension struct {
+}
+
+func (m *EthereumAuthExtension) Reset()         { *m = EthereumAuthExtension{} }
+func (m *EthereumAuthExtension) String() string { return proto.CompactTextString(m) }
+func (*EthereumAuthExtension) ProtoMessage()    {}
+func (*EthereumAuthExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7569fc6f9b02a9c1, []int{2}
+}
+func (m *EthereumAuthExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumAuthExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumAuthExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthereumAuthExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumAuthExtension.Merge(m, src)
+}
+func (m *EthereumAuthExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumAuthExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumAuthExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumAuthExtension proto.InternalMessageInfo
+
+// ExtAuthSignDoc is the document that the signers with the built-in auth extensions sign
+// The msgs bind the signature to the cross-chain txs: MsgExtSignTx contains the txID, and the txID of MsgInitiateTx is derived from itself.
+// The timeout height bounds the period in which the signature can be submitted.
+type ExtAuthSignDoc struct {
+	ChainId string       `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Msgs    []*types.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Memo    string       `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// height of this chain after which the tx is rejected
+	TimeoutHeight uint64 `protobuf:"varint,4,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+}
+
+func (m *ExtAuthSignDoc) Reset()         { *m = ExtAuthSignDoc{} }
+func (m *ExtAuthSignDoc) String() string { return proto.CompactTextString(m) }
+func (*ExtAuthSignDoc) ProtoMessage()    {}
+func (*ExtAuthSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7569fc6f9b02a9c1, []int{3}
+}
+func (m *ExtAuthSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtAuthSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtAuthSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtAuthSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtAuthSignDoc.Merge(m, src)
+}
+func (m *ExtAuthSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtAuthSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtAuthSignDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtAuthSignDoc proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Secp256K1AuthExtension)(nil), "cross.core.auth.Secp256k1AuthExtension")
+	proto.RegisterType((*Ed25519AuthExtension)(nil), "cross.core.auth.Ed25519AuthExtension")
+	proto.RegisterType((*EthereumAuthExtension)(nil), "cross.core.auth.EthereumAuthExtension")
+	proto.RegisterType((*ExtAuthSignDoc)(nil), "cross.core.auth.ExtAuthSignDoc")
+}
+
+func init() { proto.RegisterFile("cross/core/auth/extensions.proto", fileDescriptor_7569fc6f9b02a9c1) }
+
+var fileDescriptor_7569fc6f9b02a9c1 = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4b, 0xfb, 0x40,
+	0x18, 0xc7, 0x93, 0x5f, 0x43, 0xfb, 0xf3, 0xd4, 0x0a, 0xa1, 0xda, 0xb4, 0x43, 0x0c, 0x05, 0x21,
+	0x53, 0x8e, 0x56, 0x2a, 0xe8, 0x56, 0x31, 0xa0, 0x88, 0x4b, 0xba, 0xb9, 0x94, 0xfc, 0x39, 0xef,
+	0x8e, 0x36, 0xb9, 0x90, 0xbb, 0x83, 0xe6, 0x5d, 0x38, 0x39, 0xfb, 0x72, 0x3a, 0x76, 0x74, 0xd4,
+	0x76, 0xf1, 0x65, 0x48, 0x2e, 0x16, 0x74, 0x73, 0x7b, 0x9e, 0xef, 0xf3, 0x79, 0xfe, 0xf1, 0x05,
+	0x4e, 0x5c, 0x30, 0xce, 0x61, 0xcc, 0x0a, 0x04, 0x43, 0x29, 0x08, 0x44, 0x4b, 0x81, 0x32, 0x4e,
+	0x59, 0xc6, 0xbd, 0xbc, 0x60, 0x82, 0x99, 0x47, 0x8a, 0xf0, 0x2a, 0xc2, 0xab, 0x88, 0x7e, 0x07,
+	0x33, 0xcc, 0x54, 0x0d, 0x56, 0x51, 0x8d, 0xf5, 0x7b, 0x98, 0x31, 0xbc, 0x40, 0x50, 0x65, 0x91,
+	0x7c, 0x82, 0x61, 0x56, 0xd6, 0xa5, 0xc1, 0x10, 0x9c, 0x4c, 0x51, 0x9c, 0x8f, 0xc6, 0x17, 0xf3,
+	0xe1, 0x44, 0x0a, 0xe2, 0xef, 0x56, 0x98, 0x5d, 0xd0, 0xca, 0x65, 0x34, 0x9b, 0xa3, 0xd2, 0xd2,
+	0x1d, 0xdd, 0x3d, 0x08, 0x9a, 0xb9, 0x8c, 0xee, 0x51, 0x39, 0x80, 0xa0, 0xe3, 0x27, 0xa3, 0xf1,
+	0x78, 0x78, 0xf9, 0xc7, 0x86, 0x2e, 0x38, 0xf6, 0x05, 0x41, 0x05, 0x92, 0xe9, 0xaf, 0x8e, 0xc1,
+	0x8b, 0x0e, 0xda, 0xfe, 0x52, 0x54, 0xe2, 0x94, 0xe2, 0xec, 0x86, 0xc5, 0x66, 0x0f, 0xfc, 0x8f,
+	0x49, 0x48, 0xb3, 0x19, 0x4d, 0xd4, 0x94, 0xbd, 0xa0, 0xa5, 0xf2, 0xbb, 0xc4, 0x74, 0x81, 0x91,
+	0x72, 0xcc, 0xad, 0x7f, 0x4e, 0xc3, 0xdd, 0x1f, 0x75, 0xbc, 0xfa, 0x29, 0x6f, 0xf7, 0x94, 0x37,
+	0xc9, 0xca, 0x40, 0x11, 0xa6, 0x09, 0x8c, 0x14, 0xa5, 0xcc, 0x6a, 0xa8, 0x01, 0x2a, 0x36, 0xcf,
+	0x40, 0x5b, 0xd0, 0x14, 0x31, 0x29, 0x66, 0x04, 0x51, 0x4c, 0x84, 0x65, 0x38, 0xba, 0x6b, 0x04,
+	0x87, 0xdf, 0xea, 0xad, 0x12, 0xaf, 0x8c, 0xcf, 0xd7, 0x53, 0xed, 0xfa, 0x61, 0xf5, 0x61, 0x6b,
+	0xab, 0x8d, 0xad, 0xaf, 0x37, 0xb6, 0xfe, 0xbe, 0xb1, 0xf5, 0xe7, 0xad, 0xad, 0xad, 0xb7, 0xb6,
+	0xf6, 0xb6, 0xb5, 0xb5, 0x47, 0x88, 0xa9, 0x20, 0x32, 0xf2, 0x62, 0x96, 0xc2, 0x24, 0x14, 0xa1,
+	0x3a, 0x70, 0x11, 0x46, 0xb0, 0xf6, 0x6b, 0xf9, 0xc3, 0x31, 0x51, 0xe6, 0x88, 0x47, 0x4d, 0x75,
+	0xe3, 0xf9, 0xd7, 0x00, 0xdd, 0xac, 0x3e, 0xf9, 0xd1, 0x01, 0x00, 0x00,
+}
+
+func (m *Secp256K1AuthExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Secp256K1AuthExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Secp256K1AuthExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintExtensions(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Ed25519AuthExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ed25519AuthExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ed25519AuthExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintExtensions(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumAuthExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumAuthExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumAuthExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtAuthSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtAuthSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtAuthSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintExtensions(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintExtensions(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExtensions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintExtensions(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExtensions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExtensions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Secp256K1AuthExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovExtensions(uint64(l))
+	}
+	return n
+}
+
+func (m *Ed25519AuthExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovExtensions(uint64(l))
+	}
+	return n
+}
+
+func (m *EthereumAuthExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExtAuthSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovExtensions(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovExtensions(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovExtensions(uint64(l))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovExtensions(uint64(m.TimeoutHeight))
+	}
+	return n
+}
+
+func sovExtensions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExtensions(x uint64) (n int) {
+	return sovExtensions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Secp256K1AuthExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Secp256k1AuthExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Secp256k1AuthExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ed25519AuthExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ed25519AuthExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ed25519AuthExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumAuthExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumAuthExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumAuthExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtAuthSignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtensions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtAuthSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtAuthSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtensions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtensions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExtensions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExtensions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExtensions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtensions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtensions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExtensions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExtensions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExtensions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExtensions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExtensions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExtensions = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltinAuthExtensions(t *testing.T) {
+	require := require.New(t)
+
+	const chainID = "testchain"
+	ctx := sdk.Context{}.WithChainID(chainID)
+
+	secpKey := secp256k1.GenPrivKey()
+	edKey := ed25519.GenPrivKey()
+	ethKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(err)
+	ethAddr := keccak256(ethKey.PubKey().SerializeUncompressed()[1:])[12:]
+
+	secpExt := NewSecp256K1AuthExtension(secpKey.PubKey().Bytes())
+	secpID, err := secpExt.AccountID()
+	require.NoError(err)
+	edExt := NewEd25519AuthExtension(edKey.PubKey().Bytes())
+	edID, err := edExt.AccountID()
+	require.NoError(err)
+	ethExt := &EthereumAuthExtension{}
+
+	secpAcc := NewAccount(secpID, NewAuthTypeExtension(secpExt))
+	edAcc := NewAccount(edID, NewAuthTypeExtension(edExt))
+	ethAcc := NewAccount(ethAddr, NewAuthTypeExtension(ethExt))
+
+	txCfg := authtx.NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), authtx.DefaultSignModes)
+	builder := txCfg.NewTxBuilder()
+	require.NoError(builder.SetMsgs(&MsgExtSignTx{TxID: []byte("tx0"), Signers: []Account{secpAcc, edAcc, ethAcc}}))
+	builder.SetMemo("memo")
+
+	// a tx without a timeout height cannot be signed
+	_, err = GetExtAuthSignBytes(chainID, builder.GetTx())
+	require.Error(err)
+
+	builder.SetTimeoutHeight(100)
+	tx := builder.GetTx()
+	signBytes, err := GetExtAuthSignBytes(chainID, tx)
+	require.NoError(err)
+
+	secpSig, err := secpKey.Sign(signBytes)
+	require.NoError(err)
+	edSig, err := edKey.Sign(signBytes)
+	require.NoError(err)
+	// btcec returns [V || R || S], but Ethereum uses [R || S || V]
+	compact, err := btcec.SignCompact(btcec.S256(), ethKey, EthereumPersonalMessageHash(signBytes), false)
+	require.NoError(err)
+	ethSig := append(compact[1:], compact[0])
+
+	require.NoError(secpExt.Verify(ctx, secpAcc, newSignatureV2(secpSig), tx))
+	require.NoError(edExt.Verify(ctx, edAcc, newSignatureV2(edSig), tx))
+	require.NoError(ethExt.Verify(ctx, ethAcc, newSignatureV2(ethSig), tx))
+
+	// a signature for other account is rejected
+	require.Error(secpExt.Verify(ctx, secpAcc, newSignatureV2(edSig), tx))
+	require.Error(edExt.Verify(ctx, edAcc, newSignatureV2(secpSig), tx))
+	require.Error(ethExt.Verify(ctx, secpAcc, newSignatureV2(ethSig), tx))
+
+	// a high-S signature is rejected even though it is valid on the curve
+	highS := new(big.Int).Sub(btcec.S256().N, new(big.Int).SetBytes(ethSig[32:64]))
+	malleated := make([]byte, 65)
+	copy(malleated, ethSig[:32])
+	highS.FillBytes(malleated[32:64])
+	// the recovery ID is flipped since -S corresponds to the negated R point
+	malleated[64] = 27 + 28 - ethSig[64]
+	pub, _, err := btcec.RecoverCompact(btcec.S256(), append([]byte{malleated[64]}, malleated[:64]...), EthereumPersonalMessageHash(signBytes))
+	require.NoError(err)
+	require.Equal(ethAddr, keccak256(pub.SerializeUncompressed()[1:])[12:])
+	require.Error(ethExt.Verify(ctx, ethAcc, newSignatureV2(malleated), tx))
+
+	// the account ID must be bound to the public key
+	require.Error(secpExt.Verify(ctx, edAcc, newSignatureV2(secpSig), tx))
+	require.Error(edExt.Verify(ctx, secpAcc, newSignatureV2(edSig), tx))
+
+	// a signature for other chain is rejected
+	otherCtx := ctx.WithChainID("otherchain")
+	require.Error(secpExt.Verify(otherCtx, secpAcc, newSignatureV2(secpSig), tx))
+	require.Error(edExt.Verify(otherCtx, edAcc, newSignatureV2(edSig), tx))
+	require.Error(ethExt.Verify(otherCtx, ethAcc, newSignatureV2(ethSig), tx))
+
+	// a signature for other timeout height is rejected
+	builder.SetTimeoutHeight(200)
+	otherTx := builder.GetTx()
+	require.Error(secpExt.Verify(ctx, secpAcc, newSignatureV2(secpSig), otherTx))
+	require.Error(edExt.Verify(ctx, edAcc, newSignatureV2(edSig), otherTx))
+	require.Error(ethExt.Verify(ctx, ethAcc, newSignatureV2(ethSig), otherTx))
+}
+
+func newSignatureV2(sig []byte) signing.SignatureV2 {
+	return signing.SignatureV2{
+		Data: &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+	}
+}
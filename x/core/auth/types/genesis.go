@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new auth GenesisState instance.
+func NewGenesisState(txAuthStates []IdentifiedTxAuthState, records []IBCSignTxRecord, grants []SignGrant) *GenesisState {
+	return &GenesisState{
+		TxAuthStates:     txAuthStates,
+		IbcSignTxRecords: records,
+		SignGrants:       grants,
+	}
+}
+
+// DefaultGenesis returns a GenesisState instance
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	states := make(map[string]bool)
+	for _, s := range gs.TxAuthStates {
+		if len(s.TxId) == 0 {
+			return fmt.Errorf("txID must not be empty")
+		} else if states[string(s.TxId)] {
+			return fmt.Errorf("duplicate tx auth state: txID=%x", s.TxId)
+		}
+		states[string(s.TxId)] = true
+		for _, g := range s.TxAuthState.SignerGroups {
+			if err := g.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+	}
+
+	records := make(map[string]bool)
+	for _, r := range gs.IbcSignTxRecords {
+		if len(r.TxID) == 0 || len(r.Signer) == 0 {
+			return fmt.Errorf("txID and signer of the record must not be empty")
+		} else if r.Status == IBC_SIGN_TX_RECORD_STATUS_UNKNOWN {
+			return fmt.Errorf("record has an unknown status: txID=%x signer=%x", r.TxID, r.Signer)
+		}
+		key := fmt.Sprintf("%x/%x", r.TxID, r.Signer)
+		if records[key] {
+			return fmt.Errorf("duplicate record: txID=%x signer=%x", r.TxID, r.Signer)
+		}
+		records[key] = true
+	}
+
+	grants := make(map[string]bool)
+	for _, g := range gs.SignGrants {
+		if err := g.ValidateBasic(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%x/%x", g.Granter, g.Grantee)
+		if grants[key] {
+			return fmt.Errorf("duplicate grant: granter=%x grantee=%x", g.Granter, g.Grantee)
+		}
+		grants[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+)
+
+// SignTarget is a contract transaction that requires the signature of an account
+type SignTarget struct {
+	CrossChainChannel xcctypes.XCC
+	CallInfo          []byte
+}
+
+var _ codectypes.UnpackInterfacesMessage = (*SignGrant)(nil)
+
+// NewSignGrant creates a new instance of SignGrant
+func NewSignGrant(granter, grantee AccountID, anyXCC *codectypes.Any, callInfoPrefix []byte, expirationTimestamp uint64) SignGrant {
+	return SignGrant{
+		Granter:             granter,
+		Grantee:             grantee,
+		CrossChainChannel:   anyXCC,
+		CallInfoPrefix:      callInfoPrefix,
+		ExpirationTimestamp: expirationTimestamp,
+	}
+}
+
+// ValidateBasic validates the grant
+func (g SignGrant) ValidateBasic() error {
+	if len(g.Granter) == 0 {
+		return fmt.Errorf("granter must not be empty")
+	} else if len(g.Grantee) == 0 {
+		return fmt.Errorf("grantee must not be empty")
+	} else if bytes.Equal(g.Granter, g.Grantee) {
+		return fmt.Errorf("granter and grantee must be different")
+	}
+	return nil
+}
+
+// IsExpired returns a boolean whether the grant is expired at a given time
+func (g SignGrant) IsExpired(now time.Time) bool {
+	return g.ExpirationTimestamp != 0 && uint64(now.Unix()) >= g.ExpirationTimestamp
+}
+
+// Accept returns an error if the grant doesn't allow the grantee to sign all given targets
+func (g SignGrant) Accept(now time.Time, targets []SignTarget) error {
+	if g.IsExpired(now) {
+		return fmt.Errorf("the grant is expired: granter=%X grantee=%X", g.Granter, g.Grantee)
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("the granter '%X' is not a signer of the tx", g.Granter)
+	}
+	var xcc xcctypes.XCC
+	if g.CrossChainChannel != nil {
+		var ok bool
+		xcc, ok = g.CrossChainChannel.GetCachedValue().(xcctypes.XCC)
+		if !ok {
+			return fmt.Errorf("unexpected cross chain channel type: %T", g.CrossChainChannel.GetCachedValue())
+		}
+	}
+	for _, target := range targets {
+		if xcc != nil && !xcc.Equal(target.CrossChainChannel) {
+			return fmt.Errorf("the grant doesn't allow the cross chain channel: expected=%v actual=%v", xcc, target.CrossChainChannel)
+		}
+		if !bytes.HasPrefix(target.CallInfo, g.CallInfoPrefix) {
+			return fmt.Errorf("the grant doesn't allow the call info: prefix=%X", g.CallInfoPrefix)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (g SignGrant) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if g.CrossChainChannel == nil {
+		return nil
+	}
+	return unpacker.UnpackAny(g.CrossChainChannel, new(xcctypes.XCC))
+}
@@ -1,13 +1,25 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+)
 
 const SubModuleName = "auth"
 
 const (
 	KeyTxAuthStatePrefix uint8 = iota
+	KeyIBCSignTxRecordPrefix
+	KeySignGrantPrefix
+	KeyIBCSignTxRecordUpdatedAtPrefix
 )
 
+// MaxPrunedIBCSignTxRecordsPerBlock is the maximum number of IBCSignTx records that are pruned in a block
+const MaxPrunedIBCSignTxRecordsPerBlock = 100
+
 // KeyPrefixBytes return the key prefix bytes from a URL string format
 func KeyPrefixBytes(prefix uint8) []byte {
 	return []byte(fmt.Sprintf("%d/", prefix))
@@ -16,3 +28,45 @@ func KeyPrefixBytes(prefix uint8) []byte {
 func KeyTxAuthState() []byte {
 	return KeyPrefixBytes(KeyTxAuthStatePrefix)
 }
+
+func KeyIBCSignTxRecordsByTxID(txID crosstypes.TxID) []byte {
+	return append(
+		KeyPrefixBytes(KeyIBCSignTxRecordPrefix),
+		address.MustLengthPrefix(txID)...,
+	)
+}
+
+func KeyIBCSignTxRecord(txID crosstypes.TxID, signer AccountID) []byte {
+	return append(
+		KeyIBCSignTxRecordsByTxID(txID),
+		signer...,
+	)
+}
+
+// KeyIBCSignTxRecordUpdatedAt returns a key of the index of IBCSignTx records by their update time
+func KeyIBCSignTxRecordUpdatedAt(updatedAt uint64, txID crosstypes.TxID, signer AccountID) []byte {
+	return append(
+		append(
+			append(
+				KeyPrefixBytes(KeyIBCSignTxRecordUpdatedAtPrefix),
+				sdk.Uint64ToBigEndian(updatedAt)...,
+			),
+			address.MustLengthPrefix(txID)...,
+		),
+		signer...,
+	)
+}
+
+func KeySignGrantsByGranter(granter AccountID) []byte {
+	return append(
+		KeyPrefixBytes(KeySignGrantPrefix),
+		address.MustLengthPrefix(granter)...,
+	)
+}
+
+func KeySignGrant(granter, grantee AccountID) []byte {
+	return append(
+		KeySignGrantsByGranter(granter),
+		grantee...,
+	)
+}
@@ -15,6 +15,12 @@ const (
 	TypeSignTx    = "SignTx"
 	TypeIBCSignTx = "IBCSignTx"
 	TypeExtSignTx = "ExtSignTx"
+
+	TypeRevokeSign    = "RevokeSign"
+	TypeIBCRevokeSign = "IBCRevokeSign"
+
+	TypeGrantSign       = "GrantSign"
+	TypeRevokeSignGrant = "RevokeSignGrant"
 )
 
 var _ sdk.Msg = (*MsgSignTx)(nil)
@@ -190,3 +196,226 @@ func (msg *MsgExtSignTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 	}
 	return nil
 }
+
+var _ sdk.Msg = (*MsgRevokeSign)(nil)
+
+// NewMsgRevokeSign creates a new instance of MsgRevokeSign
+func NewMsgRevokeSign(txID crosstypes.TxID, signers []AccountID) *MsgRevokeSign {
+	return &MsgRevokeSign{
+		TxID:    txID,
+		Signers: signers,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRevokeSign) Route() string {
+	return crosstypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRevokeSign) Type() string {
+	return TypeRevokeSign
+}
+
+// ValidateBasic performs a basic check of the MsgRevokeSign fields.
+func (msg MsgRevokeSign) ValidateBasic() error {
+	if len(msg.TxID) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "txID must not be empty")
+	}
+	if len(msg.Signers) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing signers")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgRevokeSign) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+// GetSigners returns the addresses that must sign the transaction.
+// Addresses are returned in a deterministic order.
+// Duplicate addresses will be omitted.
+func (msg MsgRevokeSign) GetSigners() []sdk.AccAddress {
+	seen := map[string]bool{}
+	signers := []sdk.AccAddress{}
+
+	for _, s := range msg.Signers {
+		addr := s.AccAddress().String()
+		if !seen[addr] {
+			signers = append(signers, s.AccAddress())
+			seen[addr] = true
+		}
+	}
+
+	return signers
+}
+
+var (
+	_ sdk.Msg                            = (*MsgIBCRevokeSign)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgIBCRevokeSign)(nil)
+)
+
+// NewMsgIBCRevokeSign creates a new instance of MsgIBCRevokeSign
+func NewMsgIBCRevokeSign(
+	anyXCC *codectypes.Any, txID crosstypes.TxID, signers []AccountID,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *MsgIBCRevokeSign {
+	return &MsgIBCRevokeSign{
+		CrossChainChannel: anyXCC,
+		TxID:              txID,
+		Signers:           signers,
+		TimeoutHeight:     timeoutHeight,
+		TimeoutTimestamp:  timeoutTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgIBCRevokeSign) Route() string {
+	return crosstypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgIBCRevokeSign) Type() string {
+	return TypeIBCRevokeSign
+}
+
+// ValidateBasic performs a basic check of the MsgIBCRevokeSign fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+func (msg MsgIBCRevokeSign) ValidateBasic() error {
+	if len(msg.TxID) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "txID must not be empty")
+	}
+	if len(msg.Signers) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing signers")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgIBCRevokeSign) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+// GetSigners returns the addresses that must sign the transaction.
+// Addresses are returned in a deterministic order.
+// Duplicate addresses will be omitted.
+func (msg MsgIBCRevokeSign) GetSigners() []sdk.AccAddress {
+	seen := map[string]bool{}
+	signers := []sdk.AccAddress{}
+
+	for _, s := range msg.Signers {
+		addr := s.AccAddress().String()
+		if !seen[addr] {
+			signers = append(signers, s.AccAddress())
+			seen[addr] = true
+		}
+	}
+
+	return signers
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (msg *MsgIBCRevokeSign) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(msg.CrossChainChannel, new(xcctypes.XCC))
+}
+
+var (
+	_ sdk.Msg                            = (*MsgGrantSign)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgGrantSign)(nil)
+)
+
+// NewMsgGrantSign creates a new instance of MsgGrantSign
+func NewMsgGrantSign(granter, grantee AccountID, anyXCC *codectypes.Any, callInfoPrefix []byte, expirationTimestamp uint64) *MsgGrantSign {
+	return &MsgGrantSign{
+		Granter:             granter,
+		Grantee:             grantee,
+		CrossChainChannel:   anyXCC,
+		CallInfoPrefix:      callInfoPrefix,
+		ExpirationTimestamp: expirationTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgGrantSign) Route() string {
+	return crosstypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgGrantSign) Type() string {
+	return TypeGrantSign
+}
+
+// ValidateBasic performs a basic check of the MsgGrantSign fields.
+func (msg MsgGrantSign) ValidateBasic() error {
+	if err := msg.Grant().ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgGrantSign) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGrantSign) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter.AccAddress()}
+}
+
+// Grant returns the grant that the msg creates
+func (msg MsgGrantSign) Grant() SignGrant {
+	return NewSignGrant(msg.Granter, msg.Grantee, msg.CrossChainChannel, msg.CallInfoPrefix, msg.ExpirationTimestamp)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantSign) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if msg.CrossChainChannel == nil {
+		return nil
+	}
+	return unpacker.UnpackAny(msg.CrossChainChannel, new(xcctypes.XCC))
+}
+
+var _ sdk.Msg = (*MsgRevokeSignGrant)(nil)
+
+// NewMsgRevokeSignGrant creates a new instance of MsgRevokeSignGrant
+func NewMsgRevokeSignGrant(granter, grantee AccountID) *MsgRevokeSignGrant {
+	return &MsgRevokeSignGrant{Granter: granter, Grantee: grantee}
+}
+
+// Route implements sdk.Msg
+func (MsgRevokeSignGrant) Route() string {
+	return crosstypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRevokeSignGrant) Type() string {
+	return TypeRevokeSignGrant
+}
+
+// ValidateBasic performs a basic check of the MsgRevokeSignGrant fields.
+func (msg MsgRevokeSignGrant) ValidateBasic() error {
+	if len(msg.Granter) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter")
+	} else if len(msg.Grantee) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgRevokeSignGrant) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRevokeSignGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter.AccAddress()}
+}
@@ -40,6 +40,8 @@ type MsgSignTx struct {
 	// Timeout timestamp (in nanoseconds) relative to the current block timestamp.
 	// The timeout is disabled when set to 0.
 	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+	// granters are the accounts on whose behalf the signers sign the tx via the sign grants
+	Granters []AccountID `protobuf:"bytes,5,rep,name=granters,proto3,casttype=AccountID" json:"granters,omitempty"`
 }
 
 func (m *MsgSignTx) Reset()         { *m = MsgSignTx{} }
@@ -270,6 +272,320 @@ func (m *MsgExtSignTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExtSignTxResponse proto.InternalMessageInfo
 
+// MsgRevokeSign defines a msg to revoke the signs of the local signers before the authentication is completed.
+type MsgRevokeSign struct {
+	TxID    github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=txID,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"txID,omitempty"`
+	Signers []AccountID                                     `protobuf:"bytes,2,rep,name=signers,proto3,casttype=AccountID" json:"signers,omitempty"`
+}
+
+func (m *MsgRevokeSign) Reset()         { *m = MsgRevokeSign{} }
+func (m *MsgRevokeSign) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSign) ProtoMessage()    {}
+func (*MsgRevokeSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bca20369ddda3126, []int{6}
+}
+func (m *MsgRevokeSign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSign.Merge(m, src)
+}
+func (m *MsgRevokeSign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSign proto.InternalMessageInfo
+
+// MsgRevokeSignResponse defines the Msg/RevokeSign response type.
+type MsgRevokeSignResponse struct {
+}
+
+func (m *MsgRevokeSignResponse) Reset()         { *m = MsgRevokeSignResponse{} }
+func (m *MsgRevokeSignResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSignResponse) ProtoMessage()    {}
+func (*MsgRevokeSignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bca20369ddda3126, []int{7}
+}
+func (m *MsgRevokeSignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSignResponse.Merge(m, src)
+}
+func (m *MsgRevokeSignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSignResponse proto.InternalMessageInfo
+
+// MsgIBCRevokeSign defines a msg to revoke the signs of the signers on the initiator chain via the channel.
+type MsgIBCRevokeSign struct {
+	CrossChainChannel *types1.Any                                     `protobuf:"bytes,1,opt,name=cross_chain_channel,json=crossChainChannel,proto3" json:"cross_chain_channel,omitempty"`
+	TxID              github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,2,opt,name=txID,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"txID,omitempty"`
+	Signers           []AccountID                                     `protobuf:"bytes,3,rep,name=signers,proto3,casttype=AccountID" json:"signers,omitempty"`
+	// Timeout height relative to the current block height.
+	// The timeout is disabled when set to 0.
+	TimeoutHeight types.Height `protobuf:"bytes,4,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// Timeout timestamp (in nanoseconds) relative to the current block timestamp.
+	// The timeout is disabled when set to 0.
+	TimeoutTimestamp uint64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *MsgIBCRevokeSign) Reset()         { *m = MsgIBCRevokeSign{} }
+func (m *MsgIBCRevokeSign) String() string { return proto.CompactTextString(m) }
+func (*MsgIBCRevokeSign) ProtoMessage()    {}
+func (*MsgIBCRevokeSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bca20369ddda3126, []int{8}
+}
+func (m *MsgIBCRevokeSign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIBCRevokeSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIBCRevokeSign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIBCRevokeSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIBCRevokeSign.Merge(m, src)
+}
+func (m *MsgIBCRevokeSign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIBCRevokeSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIBCRevokeSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIBCRevokeSign proto.InternalMessageInfo
+
+// MsgIBCRevokeSignResponse defines the Msg/IBCRevokeSign response type.
+type MsgIBCRevokeSignResponse struct {
+}
+
+func (m *MsgIBCRevokeSignResponse) Reset()         { *m = MsgIBCRevokeSignResponse{} }
+func (m *MsgIBCRevokeSignResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIBCRevokeSignResponse) ProtoMessage()    {}
+func (*MsgIBCRevokeSignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bca20369ddda3126, []int{9}
+}
+func (m *MsgIBCRevokeSignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIBCRevokeSignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIBCRevokeSignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIBCRevokeSignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIBCRevokeSignResponse.Merge(m, src)
+}
+func (m *MsgIBCRevokeSignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIBCRevokeSignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIBCRevokeSignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIBCRevokeSignResponse proto.InternalMessageInfo
+
+type MsgGrantSign struct {
+	Granter           AccountID   `protobuf:"bytes,1,opt,name=granter,proto3,casttype=AccountID" json:"granter,omitempty"`
+	Grantee           AccountID   `protobuf:"bytes,2,opt,name=grantee,proto3,casttype=AccountID" json:"grantee,omitempty"`
+	CrossChainChannel *types1.Any `protobuf:"bytes,3,opt,name=cross_chain_channel,json=crossChainChannel,proto3" json:"cross_chain_channel,omitempty"`
+	CallInfoPrefix    []byte      `protobuf:"bytes,4,opt,name=call_info_prefix,json=callInfoPrefix,proto3" json:"call_info_prefix,omitempty"`
+	// expiration_timestamp (unix time in seconds) is the time when the grant expires
+	// The expiration is disabled when set to 0.
+	ExpirationTimestamp uint64 `protobuf:"varint,5,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+}
+
+func (m *MsgGrantSign) Reset()         { *m = MsgGrantSign{} }
+func (m *MsgGrantSign) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantSign) ProtoMessage()    {}
+func (*MsgGrantSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bca20369ddda3126, []int{10}
+}
+func (m *MsgGrantSign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantSign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantSign.Merge(m, src)
+}
+func (m *MsgGrantSign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantSign proto.InternalMessageInfo
+
+// MsgGrantSignResponse defines the Msg/GrantSign response type.
+type MsgGrantSignResponse struct {
+}
+
+func (m *MsgGrantSignResponse) Reset()         { *m = MsgGrantSignResponse{} }
+func (m *MsgGrantSignResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantSignResponse) ProtoMessage()    {}
+func (*MsgGrantSignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bca20369ddda3126, []int{11}
+}
+func (m *MsgGrantSignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantSignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantSignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantSignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantSignResponse.Merge(m, src)
+}
+func (m *MsgGrantSignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantSignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantSignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantSignResponse proto.InternalMessageInfo
+
+type MsgRevokeSignGrant struct {
+	Granter AccountID `protobuf:"bytes,1,opt,name=granter,proto3,casttype=AccountID" json:"granter,omitempty"`
+	Grantee AccountID `protobuf:"bytes,2,opt,name=grantee,proto3,casttype=AccountID" json:"grantee,omitempty"`
+}
+
+func (m *MsgRevokeSignGrant) Reset()         { *m = MsgRevokeSignGrant{} }
+func (m *MsgRevokeSignGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSignGrant) ProtoMessage()    {}
+func (*MsgRevokeSignGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bca20369ddda3126, []int{12}
+}
+func (m *MsgRevokeSignGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSignGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSignGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSignGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSignGrant.Merge(m, src)
+}
+func (m *MsgRevokeSignGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSignGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSignGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSignGrant proto.InternalMessageInfo
+
+// MsgRevokeSignGrantResponse defines the Msg/RevokeSignGrant response type.
+type MsgRevokeSignGrantResponse struct {
+}
+
+func (m *MsgRevokeSignGrantResponse) Reset()         { *m = MsgRevokeSignGrantResponse{} }
+func (m *MsgRevokeSignGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSignGrantResponse) ProtoMessage()    {}
+func (*MsgRevokeSignGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bca20369ddda3126, []int{13}
+}
+func (m *MsgRevokeSignGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSignGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSignGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSignGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSignGrantResponse.Merge(m, src)
+}
+func (m *MsgRevokeSignGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSignGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSignGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSignGrantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSignTx)(nil), "cross.core.auth.MsgSignTx")
 	proto.RegisterType((*MsgSignTxResponse)(nil), "cross.core.auth.MsgSignTxResponse")
@@ -277,51 +593,73 @@ func init() {
 	proto.RegisterType((*MsgIBCSignTxResponse)(nil), "cross.core.auth.MsgIBCSignTxResponse")
 	proto.RegisterType((*MsgExtSignTx)(nil), "cross.core.auth.MsgExtSignTx")
 	proto.RegisterType((*MsgExtSignTxResponse)(nil), "cross.core.auth.MsgExtSignTxResponse")
+	proto.RegisterType((*MsgRevokeSign)(nil), "cross.core.auth.MsgRevokeSign")
+	proto.RegisterType((*MsgRevokeSignResponse)(nil), "cross.core.auth.MsgRevokeSignResponse")
+	proto.RegisterType((*MsgIBCRevokeSign)(nil), "cross.core.auth.MsgIBCRevokeSign")
+	proto.RegisterType((*MsgIBCRevokeSignResponse)(nil), "cross.core.auth.MsgIBCRevokeSignResponse")
+	proto.RegisterType((*MsgGrantSign)(nil), "cross.core.auth.MsgGrantSign")
+	proto.RegisterType((*MsgGrantSignResponse)(nil), "cross.core.auth.MsgGrantSignResponse")
+	proto.RegisterType((*MsgRevokeSignGrant)(nil), "cross.core.auth.MsgRevokeSignGrant")
+	proto.RegisterType((*MsgRevokeSignGrantResponse)(nil), "cross.core.auth.MsgRevokeSignGrantResponse")
 }
 
 func init() { proto.RegisterFile("cross/core/auth/msgs.proto", fileDescriptor_bca20369ddda3126) }
 
 var fileDescriptor_bca20369ddda3126 = []byte{
-	// 611 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0xe3, 0x50, 0x9a, 0x6b, 0x4b, 0x1b, 0x53, 0x24, 0x63, 0xa8, 0x6d, 0x59, 0x42, 0x44,
-	0x0c, 0xb6, 0x9a, 0x2e, 0xa8, 0x5b, 0x9d, 0x22, 0x1a, 0xa4, 0x0c, 0x98, 0x4e, 0x30, 0x04, 0xdb,
-	0x3d, 0xce, 0x96, 0x6c, 0x5f, 0x94, 0x3b, 0x57, 0xce, 0xcc, 0xc2, 0xc8, 0xce, 0xd2, 0x3f, 0x82,
-	0x3f, 0x22, 0x63, 0x47, 0x26, 0x0b, 0xda, 0x85, 0xb9, 0x12, 0x4b, 0x27, 0xe4, 0xf3, 0x8f, 0xa6,
-	0x4d, 0x41, 0x20, 0x2a, 0x96, 0xe4, 0xe9, 0x7d, 0xef, 0x7d, 0x77, 0xfe, 0xbe, 0xf7, 0x0e, 0xc8,
-	0xde, 0x18, 0x13, 0x62, 0x7a, 0x78, 0x0c, 0x4d, 0x27, 0xa1, 0xbe, 0x19, 0x11, 0x44, 0x8c, 0xd1,
-	0x18, 0x53, 0x2c, 0xae, 0x32, 0xcc, 0xc8, 0x31, 0x23, 0xc7, 0xe4, 0xfb, 0x08, 0x63, 0x14, 0x42,
-	0x93, 0xc1, 0x6e, 0xf2, 0xce, 0x74, 0xe2, 0x49, 0x51, 0x2b, 0xaf, 0x23, 0x8c, 0x30, 0x0b, 0xcd,
-	0x3c, 0x2a, 0xb3, 0x6a, 0xe0, 0x7a, 0x05, 0xb7, 0x17, 0x06, 0x30, 0xa6, 0xe6, 0xe1, 0x66, 0x19,
-	0x95, 0x05, 0x0f, 0xae, 0x1e, 0x4f, 0x27, 0x23, 0x58, 0x9e, 0xaf, 0x7f, 0x6e, 0x80, 0xd6, 0x80,
-	0xa0, 0x57, 0x01, 0x8a, 0xf7, 0x53, 0xf1, 0x39, 0x68, 0xd2, 0xb4, 0xbf, 0x2b, 0xf1, 0x1a, 0xdf,
-	0x59, 0xb6, 0xb6, 0xce, 0x33, 0xd5, 0x44, 0x01, 0xf5, 0x13, 0xd7, 0xf0, 0x70, 0x64, 0x1e, 0x38,
-	0xd4, 0xf1, 0x7c, 0x27, 0x88, 0x43, 0xc7, 0x35, 0x0b, 0xd2, 0xb4, 0xa0, 0x2d, 0x18, 0xf7, 0xd3,
-	0xfe, 0xae, 0xcd, 0x08, 0xc4, 0xc7, 0xe0, 0x36, 0x09, 0x50, 0x0c, 0xc7, 0x44, 0x6a, 0x68, 0x42,
-	0x67, 0xd9, 0x5a, 0x39, 0xcf, 0xd4, 0xd6, 0x8e, 0xe7, 0xe1, 0x24, 0xa6, 0xfd, 0x5d, 0xbb, 0x42,
-	0xc5, 0xb7, 0xe0, 0x0e, 0x0d, 0x22, 0x88, 0x13, 0x3a, 0xf4, 0x61, 0x80, 0x7c, 0x2a, 0x09, 0x1a,
-	0xdf, 0x59, 0xea, 0xca, 0x46, 0xe0, 0x7a, 0x85, 0x2c, 0xe5, 0xc7, 0x1c, 0x6e, 0x1a, 0x7b, 0xac,
-	0xc2, 0xda, 0x98, 0x66, 0x2a, 0x77, 0x96, 0xa9, 0xf7, 0x26, 0x4e, 0x14, 0x6e, 0xeb, 0x97, 0xfb,
-	0x75, 0x7b, 0xa5, 0x4c, 0x14, 0xd5, 0x62, 0x1f, 0xb4, 0xab, 0x8a, 0xfc, 0x9f, 0x50, 0x27, 0x1a,
-	0x49, 0x4d, 0x8d, 0xef, 0x34, 0xad, 0x87, 0x67, 0x99, 0x2a, 0x5d, 0x26, 0xa9, 0x4b, 0x74, 0x7b,
-	0xad, 0xcc, 0xed, 0x57, 0xa9, 0xed, 0xc5, 0x0f, 0x47, 0x2a, 0xf7, 0xfd, 0x48, 0xe5, 0xf4, 0x37,
-	0xa0, 0x5d, 0xab, 0x66, 0x43, 0x32, 0xc2, 0x31, 0x81, 0xe2, 0x13, 0xd0, 0xa6, 0xe9, 0x30, 0x97,
-	0x78, 0xe8, 0xe1, 0x68, 0x14, 0x42, 0x0a, 0x0f, 0x98, 0x94, 0x8b, 0xf6, 0x2a, 0x4d, 0x77, 0x12,
-	0xea, 0xf7, 0xaa, 0xb4, 0xb8, 0x06, 0x84, 0x10, 0x23, 0xa9, 0xa1, 0xf1, 0x9d, 0x96, 0x9d, 0x87,
-	0x33, 0xe4, 0xef, 0x05, 0xb0, 0x3c, 0x20, 0xa8, 0x6f, 0xf5, 0x4a, 0x5b, 0x5e, 0x80, 0xbb, 0x4c,
-	0xee, 0x21, 0x53, 0x3f, 0xff, 0x8d, 0x63, 0x18, 0x32, 0xea, 0xa5, 0xee, 0xba, 0x51, 0x4c, 0x8c,
-	0x51, 0x4d, 0x8c, 0xb1, 0x13, 0x4f, 0xac, 0xe6, 0x34, 0x53, 0x79, 0xbb, 0xcd, 0xda, 0x7a, 0x79,
-	0x57, 0xaf, 0x68, 0xaa, 0x2d, 0x6e, 0xdc, 0xa0, 0xc5, 0xc2, 0x5f, 0x5a, 0xdc, 0xfc, 0x1f, 0x16,
-	0xdf, 0xfa, 0x47, 0x8b, 0x35, 0xb0, 0x3e, 0x6b, 0x42, 0xe5, 0xf2, 0x4c, 0xc5, 0x27, 0x9e, 0xf9,
-	0xf4, 0x2c, 0xa5, 0x37, 0xbd, 0x3e, 0x4f, 0x2f, 0xaf, 0xcf, 0x52, 0x57, 0x32, 0xae, 0xbc, 0x13,
-	0x46, 0x29, 0x34, 0x33, 0x9a, 0xab, 0xc5, 0x9e, 0xbb, 0x7f, 0x7d, 0xb9, 0xf9, 0xfb, 0x77, 0x7f,
-	0xf0, 0x40, 0x18, 0x10, 0x24, 0xee, 0x81, 0x85, 0xf2, 0x03, 0xe4, 0xb9, 0x63, 0xea, 0x29, 0x97,
-	0xf5, 0x5f, 0x63, 0xf5, 0x06, 0xbc, 0x04, 0xad, 0x8b, 0xa9, 0xdd, 0xb8, 0xae, 0xa1, 0x86, 0xe5,
-	0x47, 0xbf, 0x85, 0x67, 0x29, 0x2f, 0x04, 0xbe, 0x96, 0xb2, 0x86, 0xaf, 0xa7, 0x9c, 0x53, 0xc0,
-	0x1a, 0x4c, 0xbf, 0x29, 0xdc, 0xf4, 0x44, 0xe1, 0x8f, 0x4f, 0x14, 0xfe, 0xeb, 0x89, 0xc2, 0x7f,
-	0x3c, 0x55, 0xb8, 0xe3, 0x53, 0x85, 0xfb, 0x72, 0xaa, 0x70, 0xaf, 0xff, 0xcc, 0xb2, 0x8b, 0x87,
-	0xd4, 0x5d, 0x60, 0x8b, 0xb7, 0xf5, 0x33, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x51, 0xbf, 0xad, 0xe7,
-	0x05, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xbf, 0x6f, 0xfa, 0x46,
+	0x14, 0xb7, 0x31, 0xf9, 0xc1, 0x05, 0x12, 0x70, 0x88, 0xe4, 0xba, 0x8d, 0x4d, 0x68, 0x93, 0x90,
+	0x56, 0xb2, 0x15, 0xb2, 0x54, 0xd9, 0x02, 0xa9, 0x12, 0x2a, 0x21, 0xb5, 0x2e, 0x53, 0xab, 0x8a,
+	0x1a, 0xe7, 0x30, 0x56, 0x8d, 0xcf, 0xc2, 0x47, 0x64, 0xe6, 0x0e, 0xad, 0xd4, 0xa5, 0x52, 0xc7,
+	0x2e, 0xf9, 0x73, 0x18, 0x33, 0x76, 0xa2, 0x2d, 0x59, 0x3a, 0x67, 0xcc, 0x54, 0xf9, 0x6c, 0x8c,
+	0x01, 0x43, 0xd3, 0x28, 0x4a, 0x97, 0xef, 0x92, 0x9c, 0xee, 0xf3, 0xb9, 0xcf, 0xbd, 0x7b, 0x9f,
+	0xf7, 0x9e, 0x01, 0xbc, 0xd6, 0x43, 0x8e, 0x23, 0x6b, 0xa8, 0x07, 0x65, 0xb5, 0x8f, 0x3b, 0x72,
+	0xd7, 0xd1, 0x1d, 0xc9, 0xee, 0x21, 0x8c, 0xd8, 0x1d, 0x82, 0x49, 0x1e, 0x26, 0x79, 0x18, 0xff,
+	0x9e, 0x8e, 0x90, 0x6e, 0x42, 0x99, 0xc0, 0xad, 0x7e, 0x5b, 0x56, 0xad, 0x81, 0xcf, 0xe5, 0xf3,
+	0x3a, 0xd2, 0x11, 0x59, 0xca, 0xde, 0x2a, 0xd8, 0x15, 0x8d, 0x96, 0xe6, 0x6b, 0x6b, 0xa6, 0x01,
+	0x2d, 0x2c, 0xdf, 0x9e, 0x06, 0xab, 0x80, 0xf0, 0xfe, 0xfc, 0xf5, 0x78, 0x60, 0xc3, 0xe0, 0xfe,
+	0xe2, 0x1f, 0x09, 0x90, 0xaa, 0x3b, 0xfa, 0x57, 0x86, 0x6e, 0x35, 0x5c, 0xf6, 0x0a, 0x24, 0xb1,
+	0x5b, 0xbb, 0xe4, 0xe8, 0x02, 0x5d, 0x4a, 0x57, 0xce, 0x9e, 0x46, 0xa2, 0xac, 0x1b, 0xb8, 0xd3,
+	0x6f, 0x49, 0x1a, 0xea, 0xca, 0x37, 0x2a, 0x56, 0xb5, 0x8e, 0x6a, 0x58, 0xa6, 0xda, 0x92, 0x7d,
+	0x51, 0xd7, 0x97, 0xf5, 0x15, 0x1b, 0x6e, 0xed, 0x52, 0x21, 0x02, 0xec, 0x31, 0xd8, 0x70, 0x0c,
+	0xdd, 0x82, 0x3d, 0x87, 0x4b, 0x14, 0x98, 0x52, 0xba, 0x92, 0x79, 0x1a, 0x89, 0xa9, 0x0b, 0x4d,
+	0x43, 0x7d, 0x0b, 0xd7, 0x2e, 0x95, 0x09, 0xca, 0x7e, 0x07, 0xb6, 0xb1, 0xd1, 0x85, 0xa8, 0x8f,
+	0x9b, 0x1d, 0x68, 0xe8, 0x1d, 0xcc, 0x31, 0x05, 0xba, 0xb4, 0x55, 0xe6, 0x25, 0xa3, 0xa5, 0xf9,
+	0x69, 0x09, 0x1e, 0x73, 0x7b, 0x2a, 0x5d, 0x13, 0x46, 0x65, 0x7f, 0x38, 0x12, 0xa9, 0xc7, 0x91,
+	0xb8, 0x37, 0x50, 0xbb, 0xe6, 0x79, 0x71, 0xf6, 0x7c, 0x51, 0xc9, 0x04, 0x1b, 0x3e, 0x9b, 0xad,
+	0x81, 0xdc, 0x84, 0xe1, 0xfd, 0x77, 0xb0, 0xda, 0xb5, 0xb9, 0x64, 0x81, 0x2e, 0x25, 0x2b, 0x1f,
+	0x3c, 0x8e, 0x44, 0x6e, 0x56, 0x24, 0xa4, 0x14, 0x95, 0x6c, 0xb0, 0xd7, 0x98, 0x6c, 0xb1, 0x27,
+	0x60, 0x53, 0xef, 0xa9, 0x16, 0xf6, 0x9e, 0xb5, 0x16, 0xf7, 0xac, 0x10, 0x3e, 0xdf, 0xfc, 0xe9,
+	0x4e, 0xa4, 0xfe, 0xbe, 0x13, 0xa9, 0xe2, 0x37, 0x20, 0x17, 0x26, 0x58, 0x81, 0x8e, 0x8d, 0x2c,
+	0x07, 0xb2, 0x1f, 0x83, 0x1c, 0x76, 0x9b, 0x9e, 0x1b, 0x4d, 0x0d, 0x75, 0x6d, 0x13, 0x62, 0x78,
+	0x43, 0xb2, 0xbe, 0xa9, 0xec, 0x60, 0xf7, 0xa2, 0x8f, 0x3b, 0xd5, 0xc9, 0x36, 0x9b, 0x05, 0x8c,
+	0x89, 0x74, 0x2e, 0x51, 0xa0, 0x4b, 0x29, 0xc5, 0x5b, 0x46, 0xc4, 0x7f, 0x60, 0x40, 0xba, 0xee,
+	0xe8, 0xb5, 0x4a, 0x35, 0x70, 0xf0, 0x73, 0xb0, 0x4b, 0x9c, 0x69, 0x12, 0xa3, 0xbc, 0xbf, 0x96,
+	0x05, 0x4d, 0x22, 0xbd, 0x55, 0xce, 0x4b, 0x7e, 0x71, 0x49, 0x93, 0xe2, 0x92, 0x2e, 0xac, 0x41,
+	0x25, 0x39, 0x1c, 0x89, 0xb4, 0x92, 0x23, 0xc7, 0xaa, 0xde, 0xa9, 0xaa, 0x7f, 0x28, 0xac, 0x86,
+	0xc4, 0x2b, 0x56, 0x03, 0xf3, 0x1f, 0xab, 0x21, 0xf9, 0x16, 0xd5, 0xb0, 0xf6, 0x92, 0x6a, 0x88,
+	0xb8, 0x50, 0x00, 0xf9, 0xa8, 0x09, 0x13, 0x97, 0x23, 0x8c, 0xdf, 0x68, 0xe2, 0xd3, 0x67, 0x2e,
+	0x7e, 0xed, 0x4e, 0xfb, 0x74, 0xb6, 0xd3, 0xb6, 0xca, 0x9c, 0x34, 0x37, 0x52, 0xa4, 0x20, 0xd1,
+	0xc4, 0x68, 0x2a, 0x4c, 0xf6, 0x42, 0xfc, 0x61, 0x70, 0x31, 0xf1, 0xff, 0x4c, 0x83, 0x4c, 0xdd,
+	0xd1, 0x15, 0x78, 0x8b, 0xbe, 0x87, 0x1e, 0xeb, 0xed, 0x47, 0x45, 0x24, 0x9a, 0x03, 0xb0, 0x37,
+	0x13, 0x4c, 0x4c, 0xc0, 0x3f, 0x32, 0x20, 0xeb, 0x7b, 0x12, 0x89, 0xf9, 0x5d, 0x73, 0xfc, 0x0f,
+	0xcd, 0xf1, 0x11, 0xe0, 0xe6, 0x8d, 0x88, 0xf1, 0xeb, 0xd7, 0x04, 0x69, 0x90, 0x2b, 0x6f, 0x7e,
+	0x12, 0xaf, 0x8e, 0xc1, 0x46, 0x30, 0x4c, 0x83, 0x12, 0x9b, 0x4f, 0x4b, 0x80, 0x4e, 0x89, 0x90,
+	0x4b, 0xac, 0x20, 0xc2, 0x65, 0xee, 0x33, 0x2f, 0x71, 0xbf, 0x04, 0xb2, 0x9a, 0x6a, 0x9a, 0x4d,
+	0xc3, 0x6a, 0xa3, 0xa6, 0xdd, 0x83, 0x6d, 0xc3, 0x25, 0x6e, 0xa4, 0x95, 0x6d, 0x6f, 0xbf, 0x66,
+	0xb5, 0xd1, 0x17, 0x64, 0x97, 0x3d, 0x05, 0x79, 0xe8, 0xda, 0x46, 0x4f, 0xc5, 0x06, 0xb2, 0xe6,
+	0xd3, 0xaa, 0xec, 0x4e, 0xb1, 0xe5, 0x83, 0x25, 0x4c, 0x4a, 0x4c, 0xde, 0x6c, 0xc0, 0xce, 0xb4,
+	0x02, 0xe1, 0xbe, 0x7e, 0xf2, 0x22, 0x37, 0x1e, 0x01, 0x7e, 0xf1, 0xc6, 0xc5, 0xc8, 0xca, 0xe3,
+	0x24, 0x60, 0xea, 0x8e, 0xce, 0x5e, 0x83, 0xf5, 0x60, 0xe6, 0xf1, 0x0b, 0x93, 0x29, 0xfc, 0x30,
+	0xf2, 0xc5, 0xe5, 0x58, 0xf8, 0xd1, 0xfc, 0x12, 0xa4, 0xa6, 0x1f, 0xba, 0xfd, 0xb8, 0x03, 0x21,
+	0xcc, 0x1f, 0xae, 0x84, 0xa3, 0x92, 0xd3, 0x99, 0x1c, 0x2b, 0x19, 0xc2, 0xfc, 0xe1, 0x4a, 0x38,
+	0x94, 0x6c, 0x00, 0x10, 0x19, 0x39, 0x42, 0xdc, 0xa1, 0x29, 0xce, 0x1f, 0xad, 0xc6, 0x43, 0xd5,
+	0x6f, 0x41, 0x66, 0x76, 0x96, 0x1d, 0x2c, 0x79, 0x60, 0x44, 0xfb, 0xe4, 0x5f, 0x29, 0xd1, 0x3c,
+	0x4c, 0x5b, 0x2f, 0x36, 0x0f, 0x21, 0xcc, 0x1f, 0xae, 0x84, 0x43, 0x49, 0x0d, 0xec, 0xcc, 0x97,
+	0xe5, 0x87, 0xab, 0x1f, 0x4b, 0x48, 0xfc, 0x27, 0xcf, 0x20, 0x4d, 0x2e, 0xa9, 0xd4, 0x87, 0x7f,
+	0x09, 0xd4, 0x70, 0x2c, 0xd0, 0xf7, 0x63, 0x81, 0xfe, 0x73, 0x2c, 0xd0, 0xbf, 0x3c, 0x08, 0xd4,
+	0xfd, 0x83, 0x40, 0xfd, 0xfe, 0x20, 0x50, 0x5f, 0x3f, 0x6f, 0x22, 0x4f, 0x7f, 0x13, 0xb7, 0xd6,
+	0x49, 0xf7, 0x9f, 0xfd, 0x33, 0x00, 0x55, 0x14, 0xc7, 0x58, 0xb2, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -342,6 +680,14 @@ type MsgClient interface {
 	IBCSignTx(ctx context.Context, in *MsgIBCSignTx, opts ...grpc.CallOption) (*MsgIBCSignTxResponse, error)
 	// ExtSignTx
 	ExtSignTx(ctx context.Context, in *MsgExtSignTx, opts ...grpc.CallOption) (*MsgExtSignTxResponse, error)
+	// RevokeSign defines a rpc handler method for MsgRevokeSign.
+	RevokeSign(ctx context.Context, in *MsgRevokeSign, opts ...grpc.CallOption) (*MsgRevokeSignResponse, error)
+	// IBCRevokeSign defines a rpc handler method for MsgIBCRevokeSign.
+	IBCRevokeSign(ctx context.Context, in *MsgIBCRevokeSign, opts ...grpc.CallOption) (*MsgIBCRevokeSignResponse, error)
+	// GrantSign defines a rpc handler method for MsgGrantSign.
+	GrantSign(ctx context.Context, in *MsgGrantSign, opts ...grpc.CallOption) (*MsgGrantSignResponse, error)
+	// RevokeSignGrant defines a rpc handler method for MsgRevokeSignGrant.
+	RevokeSignGrant(ctx context.Context, in *MsgRevokeSignGrant, opts ...grpc.CallOption) (*MsgRevokeSignGrantResponse, error)
 }
 
 type msgClient struct {
@@ -379,6 +725,42 @@ func (c *msgClient) ExtSignTx(ctx context.Context, in *MsgExtSignTx, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) RevokeSign(ctx context.Context, in *MsgRevokeSign, opts ...grpc.CallOption) (*MsgRevokeSignResponse, error) {
+	out := new(MsgRevokeSignResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.auth.Msg/RevokeSign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IBCRevokeSign(ctx context.Context, in *MsgIBCRevokeSign, opts ...grpc.CallOption) (*MsgIBCRevokeSignResponse, error) {
+	out := new(MsgIBCRevokeSignResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.auth.Msg/IBCRevokeSign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantSign(ctx context.Context, in *MsgGrantSign, opts ...grpc.CallOption) (*MsgGrantSignResponse, error) {
+	out := new(MsgGrantSignResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.auth.Msg/GrantSign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSignGrant(ctx context.Context, in *MsgRevokeSignGrant, opts ...grpc.CallOption) (*MsgRevokeSignGrantResponse, error) {
+	out := new(MsgRevokeSignGrantResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.auth.Msg/RevokeSignGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SignTx defines a rpc handler method for MsgSignTx.
@@ -387,6 +769,14 @@ type MsgServer interface {
 	IBCSignTx(context.Context, *MsgIBCSignTx) (*MsgIBCSignTxResponse, error)
 	// ExtSignTx
 	ExtSignTx(context.Context, *MsgExtSignTx) (*MsgExtSignTxResponse, error)
+	// RevokeSign defines a rpc handler method for MsgRevokeSign.
+	RevokeSign(context.Context, *MsgRevokeSign) (*MsgRevokeSignResponse, error)
+	// IBCRevokeSign defines a rpc handler method for MsgIBCRevokeSign.
+	IBCRevokeSign(context.Context, *MsgIBCRevokeSign) (*MsgIBCRevokeSignResponse, error)
+	// GrantSign defines a rpc handler method for MsgGrantSign.
+	GrantSign(context.Context, *MsgGrantSign) (*MsgGrantSignResponse, error)
+	// RevokeSignGrant defines a rpc handler method for MsgRevokeSignGrant.
+	RevokeSignGrant(context.Context, *MsgRevokeSignGrant) (*MsgRevokeSignGrantResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -402,6 +792,18 @@ func (*UnimplementedMsgServer) IBCSignTx(ctx context.Context, req *MsgIBCSignTx)
 func (*UnimplementedMsgServer) ExtSignTx(ctx context.Context, req *MsgExtSignTx) (*MsgExtSignTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExtSignTx not implemented")
 }
+func (*UnimplementedMsgServer) RevokeSign(ctx context.Context, req *MsgRevokeSign) (*MsgRevokeSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSign not implemented")
+}
+func (*UnimplementedMsgServer) IBCRevokeSign(ctx context.Context, req *MsgIBCRevokeSign) (*MsgIBCRevokeSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCRevokeSign not implemented")
+}
+func (*UnimplementedMsgServer) GrantSign(ctx context.Context, req *MsgGrantSign) (*MsgGrantSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantSign not implemented")
+}
+func (*UnimplementedMsgServer) RevokeSignGrant(ctx context.Context, req *MsgRevokeSignGrant) (*MsgRevokeSignGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSignGrant not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -461,47 +863,144 @@ func _Msg_ExtSignTx_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cross.core.auth.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SignTx",
-			Handler:    _Msg_SignTx_Handler,
-		},
-		{
-			MethodName: "IBCSignTx",
-			Handler:    _Msg_IBCSignTx_Handler,
-		},
-		{
-			MethodName: "ExtSignTx",
-			Handler:    _Msg_ExtSignTx_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cross/core/auth/msgs.proto",
+func _Msg_RevokeSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.auth.Msg/RevokeSign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSign(ctx, req.(*MsgRevokeSign))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSignTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_IBCRevokeSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIBCRevokeSign)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).IBCRevokeSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.auth.Msg/IBCRevokeSign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IBCRevokeSign(ctx, req.(*MsgIBCRevokeSign))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSignTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _Msg_GrantSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantSign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.auth.Msg/GrantSign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantSign(ctx, req.(*MsgGrantSign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSignGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSignGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSignGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.auth.Msg/RevokeSignGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSignGrant(ctx, req.(*MsgRevokeSignGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cross.core.auth.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignTx",
+			Handler:    _Msg_SignTx_Handler,
+		},
+		{
+			MethodName: "IBCSignTx",
+			Handler:    _Msg_IBCSignTx_Handler,
+		},
+		{
+			MethodName: "ExtSignTx",
+			Handler:    _Msg_ExtSignTx_Handler,
+		},
+		{
+			MethodName: "RevokeSign",
+			Handler:    _Msg_RevokeSign_Handler,
+		},
+		{
+			MethodName: "IBCRevokeSign",
+			Handler:    _Msg_IBCRevokeSign_Handler,
+		},
+		{
+			MethodName: "GrantSign",
+			Handler:    _Msg_GrantSign_Handler,
+		},
+		{
+			MethodName: "RevokeSignGrant",
+			Handler:    _Msg_RevokeSignGrant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cross/core/auth/msgs.proto",
+}
+
+func (m *MsgSignTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
 func (m *MsgSignTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Granters) > 0 {
+		for iNdEx := len(m.Granters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Granters[iNdEx])
+			copy(dAtA[i:], m.Granters[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granters[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.TimeoutTimestamp != 0 {
 		i = encodeVarintMsgs(dAtA, i, uint64(m.TimeoutTimestamp))
 		i--
@@ -732,102 +1231,403 @@ func (m *MsgExtSignTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRevokeSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSignTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRevokeSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
 	if len(m.Signers) > 0 {
-		for _, b := range m.Signers {
-			l = len(b)
-			n += 1 + l + sovMsgs(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = m.TimeoutHeight.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovMsgs(uint64(m.TimeoutTimestamp))
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSignTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeSignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TxAuthCompleted {
-		n += 2
-	}
-	l = len(m.Log)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgIBCSignTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIBCRevokeSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIBCRevokeSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIBCRevokeSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CrossChainChannel != nil {
-		l = m.CrossChainChannel.Size()
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Signers) > 0 {
-		for _, b := range m.Signers {
-			l = len(b)
-			n += 1 + l + sovMsgs(uint64(l))
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = m.TimeoutHeight.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovMsgs(uint64(m.TimeoutTimestamp))
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.CrossChainChannel != nil {
+		{
+			size, err := m.CrossChainChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgIBCSignTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIBCRevokeSignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIBCRevokeSignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIBCRevokeSignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExtSignTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxID)
-	if l > 0 {
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CallInfoPrefix) > 0 {
+		i -= len(m.CallInfoPrefix)
+		copy(dAtA[i:], m.CallInfoPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CallInfoPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CrossChainChannel != nil {
+		{
+			size, err := m.CrossChainChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantSignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantSignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantSignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSignGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSignGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSignGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSignGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSignGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSignGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSignTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, b := range m.Signers {
+			l = len(b)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovMsgs(uint64(m.TimeoutTimestamp))
+	}
+	if len(m.Granters) > 0 {
+		for _, b := range m.Granters {
+			l = len(b)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSignTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxAuthCompleted {
+		n += 2
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIBCSignTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CrossChainChannel != nil {
+		l = m.CrossChainChannel.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, b := range m.Signers {
+			l = len(b)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovMsgs(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MsgIBCSignTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtSignTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
 	if len(m.Signers) > 0 {
@@ -836,25 +1636,1067 @@ func (m *MsgExtSignTx) Size() (n int) {
 			n += 1 + l + sovMsgs(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgExtSignTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, b := range m.Signers {
+			l = len(b)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeSignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIBCRevokeSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CrossChainChannel != nil {
+		l = m.CrossChainChannel.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, b := range m.Signers {
+			l = len(b)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovMsgs(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MsgIBCRevokeSignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.CrossChainChannel != nil {
+		l = m.CrossChainChannel.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CallInfoPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovMsgs(uint64(m.ExpirationTimestamp))
+	}
+	return n
+}
+
+func (m *MsgGrantSignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSignGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeSignGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSignTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
+			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granters", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granters = append(m.Granters, make([]byte, postIndex-iNdEx))
+			copy(m.Granters[len(m.Granters)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthCompleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TxAuthCompleted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIBCSignTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIBCSignTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIBCSignTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CrossChainChannel == nil {
+				m.CrossChainChannel = &types1.Any{}
+			}
+			if err := m.CrossChainChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
+			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIBCSignTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIBCSignTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIBCSignTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtSignTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtSignTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtSignTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, Account{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtSignTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtSignTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtSignTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
+			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgExtSignTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRevokeSignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSignTx) Unmarshal(dAtA []byte) error {
+func (m *MsgIBCRevokeSign) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,13 +2719,49 @@ func (m *MsgSignTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIBCRevokeSign: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIBCRevokeSign: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CrossChainChannel == nil {
+				m.CrossChainChannel = &types1.Any{}
+			}
+			if err := m.CrossChainChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
@@ -917,7 +2795,7 @@ func (m *MsgSignTx) Unmarshal(dAtA []byte) error {
 				m.TxID = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -949,7 +2827,7 @@ func (m *MsgSignTx) Unmarshal(dAtA []byte) error {
 			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
 			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
 			}
@@ -982,7 +2860,7 @@ func (m *MsgSignTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
@@ -1025,7 +2903,7 @@ func (m *MsgSignTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIBCRevokeSignResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1048,64 +2926,12 @@ func (m *MsgSignTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIBCRevokeSignResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIBCRevokeSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthCompleted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TxAuthCompleted = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Log = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -1130,7 +2956,7 @@ func (m *MsgSignTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIBCSignTx) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantSign) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1153,17 +2979,17 @@ func (m *MsgIBCSignTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIBCSignTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantSign: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIBCSignTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantSign: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1173,31 +2999,29 @@ func (m *MsgIBCSignTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CrossChainChannel == nil {
-				m.CrossChainChannel = &types1.Any{}
-			}
-			if err := m.CrossChainChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1224,16 +3048,16 @@ func (m *MsgIBCSignTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxID == nil {
-				m.TxID = []byte{}
+			m.Grantee = append(m.Grantee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Grantee == nil {
+				m.Grantee = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainChannel", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1243,29 +3067,33 @@ func (m *MsgIBCSignTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
-			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
+			if m.CrossChainChannel == nil {
+				m.CrossChainChannel = &types1.Any{}
+			}
+			if err := m.CrossChainChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallInfoPrefix", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1275,30 +3103,31 @@ func (m *MsgIBCSignTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CallInfoPrefix = append(m.CallInfoPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallInfoPrefix == nil {
+				m.CallInfoPrefix = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
 			}
-			m.TimeoutTimestamp = 0
+			m.ExpirationTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1308,7 +3137,7 @@ func (m *MsgIBCSignTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				m.ExpirationTimestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1337,7 +3166,7 @@ func (m *MsgIBCSignTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgIBCSignTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantSignResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1360,10 +3189,10 @@ func (m *MsgIBCSignTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgIBCSignTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantSignResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgIBCSignTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1390,7 +3219,7 @@ func (m *MsgIBCSignTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtSignTx) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeSignGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1413,15 +3242,15 @@ func (m *MsgExtSignTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtSignTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeSignGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtSignTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeSignGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1448,16 +3277,16 @@ func (m *MsgExtSignTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxID == nil {
-				m.TxID = []byte{}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1467,24 +3296,24 @@ func (m *MsgExtSignTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, Account{})
-			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Grantee = append(m.Grantee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Grantee == nil {
+				m.Grantee = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1511,7 +3340,7 @@ func (m *MsgExtSignTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtSignTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeSignGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1534,10 +3363,10 @@ func (m *MsgExtSignTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtSignTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeSignGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtSignTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeSignGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
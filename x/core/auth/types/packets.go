@@ -52,3 +52,44 @@ func (p PacketAcknowledgementIBCSignTx) ValidateBasic() error {
 func (PacketAcknowledgementIBCSignTx) Type() string {
 	return PacketType
 }
+
+var _ packets.PacketDataPayload = (*PacketDataIBCRevokeSign)(nil)
+
+// NewPacketDataIBCRevokeSign creates a new instance of PacketDataIBCRevokeSign
+func NewPacketDataIBCRevokeSign(
+	txID crosstypes.TxID,
+	signers []AccountID,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) PacketDataIBCRevokeSign {
+	return PacketDataIBCRevokeSign{
+		TxID:             txID,
+		Signers:          signers,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (p PacketDataIBCRevokeSign) ValidateBasic() error {
+	if len(p.TxID) == 0 {
+		return errors.New("txID must not be empty")
+	}
+	if len(p.Signers) == 0 {
+		return errors.New("signers are required")
+	}
+	return nil
+}
+
+func (PacketDataIBCRevokeSign) Type() string {
+	return PacketType
+}
+
+var _ packets.PacketAcknowledgementPayload = (*PacketAcknowledgementIBCRevokeSign)(nil)
+
+func (p PacketAcknowledgementIBCRevokeSign) ValidateBasic() error {
+	return nil
+}
+
+func (PacketAcknowledgementIBCRevokeSign) Type() string {
+	return PacketType
+}
@@ -99,6 +99,8 @@ var xxx_messageInfo_PacketDataIBCSignTx proto.InternalMessageInfo
 
 type PacketAcknowledgementIBCSignTx struct {
 	Status IBCSignTxStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cross.core.auth.IBCSignTxStatus" json:"status,omitempty"`
+	// error_message is the reason why the counterparty chain failed to receive the signs
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 }
 
 func (m *PacketAcknowledgementIBCSignTx) Reset()         { *m = PacketAcknowledgementIBCSignTx{} }
@@ -134,47 +136,133 @@ func (m *PacketAcknowledgementIBCSignTx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketAcknowledgementIBCSignTx proto.InternalMessageInfo
 
+type PacketDataIBCRevokeSign struct {
+	TxID    github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=txID,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"txID,omitempty"`
+	Signers []AccountID                                     `protobuf:"bytes,2,rep,name=signers,proto3,casttype=AccountID" json:"signers,omitempty"`
+	// Timeout height relative to the current block height.
+	// The timeout is disabled when set to 0.
+	TimeoutHeight types.Height `protobuf:"bytes,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// Timeout timestamp (in nanoseconds) relative to the current block timestamp.
+	// The timeout is disabled when set to 0.
+	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *PacketDataIBCRevokeSign) Reset()         { *m = PacketDataIBCRevokeSign{} }
+func (m *PacketDataIBCRevokeSign) String() string { return proto.CompactTextString(m) }
+func (*PacketDataIBCRevokeSign) ProtoMessage()    {}
+func (*PacketDataIBCRevokeSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3907971b9c2156, []int{2}
+}
+func (m *PacketDataIBCRevokeSign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketDataIBCRevokeSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketDataIBCRevokeSign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketDataIBCRevokeSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketDataIBCRevokeSign.Merge(m, src)
+}
+func (m *PacketDataIBCRevokeSign) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketDataIBCRevokeSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketDataIBCRevokeSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketDataIBCRevokeSign proto.InternalMessageInfo
+
+type PacketAcknowledgementIBCRevokeSign struct {
+	Status IBCSignTxStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cross.core.auth.IBCSignTxStatus" json:"status,omitempty"`
+}
+
+func (m *PacketAcknowledgementIBCRevokeSign) Reset()         { *m = PacketAcknowledgementIBCRevokeSign{} }
+func (m *PacketAcknowledgementIBCRevokeSign) String() string { return proto.CompactTextString(m) }
+func (*PacketAcknowledgementIBCRevokeSign) ProtoMessage()    {}
+func (*PacketAcknowledgementIBCRevokeSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3907971b9c2156, []int{3}
+}
+func (m *PacketAcknowledgementIBCRevokeSign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketAcknowledgementIBCRevokeSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketAcknowledgementIBCRevokeSign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketAcknowledgementIBCRevokeSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketAcknowledgementIBCRevokeSign.Merge(m, src)
+}
+func (m *PacketAcknowledgementIBCRevokeSign) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketAcknowledgementIBCRevokeSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketAcknowledgementIBCRevokeSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketAcknowledgementIBCRevokeSign proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cross.core.auth.IBCSignTxStatus", IBCSignTxStatus_name, IBCSignTxStatus_value)
 	proto.RegisterType((*PacketDataIBCSignTx)(nil), "cross.core.auth.PacketDataIBCSignTx")
 	proto.RegisterType((*PacketAcknowledgementIBCSignTx)(nil), "cross.core.auth.PacketAcknowledgementIBCSignTx")
+	proto.RegisterType((*PacketDataIBCRevokeSign)(nil), "cross.core.auth.PacketDataIBCRevokeSign")
+	proto.RegisterType((*PacketAcknowledgementIBCRevokeSign)(nil), "cross.core.auth.PacketAcknowledgementIBCRevokeSign")
 }
 
 func init() { proto.RegisterFile("cross/core/auth/packets.proto", fileDescriptor_bf3907971b9c2156) }
 
 var fileDescriptor_bf3907971b9c2156 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0x94, 0x50,
-	0x14, 0xc6, 0x61, 0x4a, 0x6a, 0xbc, 0xf6, 0xcf, 0x88, 0x36, 0xa1, 0xc4, 0xb9, 0x10, 0x36, 0x4e,
-	0x5c, 0x40, 0xda, 0x6e, 0x4c, 0x77, 0x43, 0xd1, 0x4a, 0xaa, 0x53, 0x03, 0x34, 0x1a, 0x37, 0xf4,
-	0x72, 0x7b, 0x03, 0xa4, 0x03, 0x77, 0x1c, 0x2e, 0x75, 0xfa, 0x06, 0x2e, 0x7d, 0x04, 0x13, 0xdf,
-	0xc2, 0x27, 0x98, 0x65, 0x97, 0xae, 0x88, 0xce, 0x6c, 0x5c, 0x77, 0xd9, 0x95, 0x99, 0x0b, 0x53,
-	0xd3, 0xa6, 0x8b, 0xae, 0x38, 0x39, 0xdf, 0x8f, 0xef, 0x1c, 0x0e, 0x1f, 0xe8, 0xe0, 0x11, 0x2d,
-	0x0a, 0x0b, 0xd3, 0x11, 0xb1, 0x50, 0xc9, 0x12, 0x6b, 0x88, 0xf0, 0x29, 0x61, 0x85, 0x39, 0x1c,
-	0x51, 0x46, 0xe5, 0x75, 0x2e, 0x9b, 0x73, 0xd9, 0x9c, 0xcb, 0xea, 0xd3, 0x98, 0xc6, 0x94, 0x6b,
-	0xd6, 0xbc, 0xaa, 0x31, 0x55, 0x4b, 0x23, 0x5c, 0x7b, 0xe0, 0x41, 0x4a, 0x72, 0x66, 0x9d, 0x6d,
-	0x35, 0x55, 0x0d, 0x18, 0x3f, 0x5b, 0xe0, 0xc9, 0x7b, 0xee, 0xec, 0x20, 0x86, 0x5c, 0x7b, 0xcf,
-	0x4f, 0xe3, 0x3c, 0x18, 0xcb, 0xfb, 0x40, 0x62, 0x63, 0xd7, 0x51, 0x44, 0x5d, 0xec, 0xae, 0xd8,
-	0x3b, 0x57, 0x95, 0x66, 0xc5, 0x29, 0x4b, 0xca, 0xc8, 0xc4, 0x34, 0xb3, 0x4e, 0x10, 0x43, 0x38,
-	0x41, 0x69, 0x3e, 0x40, 0x91, 0x55, 0x2f, 0x3a, 0xae, 0xc7, 0xb0, 0xf3, 0x21, 0x29, 0xcc, 0x60,
-	0xec, 0x3a, 0x1e, 0x37, 0x90, 0x9f, 0x83, 0x07, 0x45, 0x1a, 0xe7, 0x64, 0x54, 0x28, 0x2d, 0x7d,
-	0xa9, 0xbb, 0x62, 0xaf, 0x5e, 0x55, 0xda, 0xc3, 0x1e, 0xc6, 0xb4, 0xcc, 0x99, 0xeb, 0x78, 0x0b,
-	0x55, 0x3e, 0x06, 0x6b, 0x2c, 0xcd, 0x08, 0x2d, 0x59, 0x98, 0x90, 0x34, 0x4e, 0x98, 0xb2, 0xa4,
-	0x8b, 0xdd, 0x47, 0xdb, 0xaa, 0x99, 0x46, 0xb8, 0xfe, 0xd0, 0x66, 0xf3, 0xb3, 0x2d, 0xf3, 0x0d,
-	0x27, 0xec, 0xce, 0xa4, 0xd2, 0x84, 0xcb, 0x4a, 0xdb, 0x38, 0x47, 0xd9, 0x60, 0xd7, 0xb8, 0xf9,
-	0xbe, 0xe1, 0xad, 0x36, 0x8d, 0x9a, 0x96, 0x5d, 0xf0, 0x78, 0x41, 0xcc, 0x9f, 0x05, 0x43, 0xd9,
-	0x50, 0x91, 0x74, 0xb1, 0x2b, 0xd9, 0xcf, 0x2e, 0x2b, 0x4d, 0xb9, 0x69, 0x72, 0x8d, 0x18, 0x5e,
-	0xbb, 0xe9, 0x05, 0x8b, 0xd6, 0xae, 0xf4, 0xf7, 0xbb, 0x26, 0x18, 0xc7, 0x00, 0xd6, 0xb7, 0xeb,
-	0xe1, 0xd3, 0x9c, 0x7e, 0x19, 0x90, 0x93, 0x98, 0x64, 0x24, 0x67, 0xff, 0xcf, 0xf8, 0x12, 0x2c,
-	0x17, 0x0c, 0xb1, 0xb2, 0xe0, 0x87, 0x5c, 0xdb, 0xd6, 0xcd, 0x5b, 0xff, 0xcd, 0xbc, 0x66, 0x7d,
-	0xce, 0x79, 0x0d, 0x5f, 0x4f, 0x78, 0xf1, 0x19, 0xac, 0xdf, 0x02, 0x64, 0x08, 0x54, 0xd7, 0xde,
-	0x0b, 0x7d, 0x77, 0xbf, 0x1f, 0x06, 0x1f, 0x43, 0x3f, 0xe8, 0x05, 0x47, 0x7e, 0x78, 0xd4, 0x3f,
-	0xe8, 0x1f, 0x7e, 0xe8, 0xb7, 0x05, 0x79, 0x13, 0x6c, 0xdc, 0xa1, 0x1f, 0x1e, 0xb4, 0x45, 0xb9,
-	0x03, 0x36, 0xef, 0x90, 0x5e, 0xf7, 0xdc, 0xb7, 0xaf, 0x9c, 0x76, 0x4b, 0x95, 0xbe, 0xfe, 0x80,
-	0x82, 0xfd, 0x6e, 0xf2, 0x07, 0x0a, 0x93, 0x29, 0x14, 0x2f, 0xa6, 0x50, 0xfc, 0x3d, 0x85, 0xe2,
-	0xb7, 0x19, 0x14, 0x2e, 0x66, 0x50, 0xf8, 0x35, 0x83, 0xc2, 0xa7, 0xfb, 0xa5, 0x80, 0x07, 0x96,
-	0x47, 0x21, 0x5a, 0xe6, 0x39, 0xdb, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0x42, 0x74, 0xba, 0x62,
-	0xd0, 0x02, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0x31, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0xed, 0x34, 0xea, 0x5f, 0xbd, 0x7f, 0xd2, 0x06, 0x43, 0x85, 0x1b, 0x11, 0xdb, 0x32,
+	0x03, 0x11, 0x83, 0xad, 0xb6, 0x0b, 0xea, 0x16, 0x37, 0x50, 0xac, 0xd2, 0x14, 0x39, 0xae, 0x40,
+	0x2c, 0xe6, 0x72, 0x39, 0x39, 0x56, 0x62, 0x5f, 0xf0, 0x5d, 0x42, 0xfa, 0x01, 0x90, 0x18, 0xf9,
+	0x08, 0x48, 0x7c, 0x0b, 0x06, 0xe6, 0x8c, 0x1d, 0x99, 0x22, 0x48, 0x16, 0xe6, 0x8e, 0x9d, 0x50,
+	0xee, 0x9c, 0x42, 0xaa, 0x22, 0x55, 0xcc, 0x4c, 0x3e, 0xbd, 0xcf, 0xcf, 0xcf, 0xbd, 0xf7, 0x3e,
+	0xa7, 0x03, 0x15, 0x94, 0x12, 0x4a, 0x6d, 0x44, 0x52, 0x6c, 0xc3, 0x01, 0xeb, 0xd8, 0x7d, 0x88,
+	0xba, 0x98, 0x51, 0xab, 0x9f, 0x12, 0x46, 0x94, 0x0d, 0x2e, 0x5b, 0x73, 0xd9, 0x9a, 0xcb, 0xe5,
+	0x3b, 0x21, 0x09, 0x09, 0xd7, 0xec, 0xf9, 0x4a, 0x60, 0x65, 0x3d, 0x6a, 0x21, 0xe1, 0x81, 0x7a,
+	0x11, 0x4e, 0x98, 0x3d, 0xdc, 0xce, 0x56, 0x02, 0x30, 0x3f, 0xe7, 0xc0, 0xed, 0xe7, 0xdc, 0xb9,
+	0x0e, 0x19, 0x74, 0x9d, 0xfd, 0x66, 0x14, 0x26, 0xfe, 0x48, 0x39, 0x00, 0x79, 0x36, 0x72, 0xeb,
+	0xaa, 0x6c, 0xc8, 0xd5, 0x82, 0xb3, 0x7b, 0x31, 0xd1, 0xed, 0x30, 0x62, 0x9d, 0x41, 0xcb, 0x42,
+	0x24, 0xb6, 0xdb, 0x90, 0x41, 0xd4, 0x81, 0x51, 0xd2, 0x83, 0x2d, 0x5b, 0x34, 0x3a, 0x12, 0xdb,
+	0xb0, 0xd3, 0x3e, 0xa6, 0x96, 0x3f, 0x72, 0xeb, 0x1e, 0x37, 0x50, 0x1e, 0x80, 0xff, 0x68, 0x14,
+	0x26, 0x38, 0xa5, 0x6a, 0xce, 0x58, 0xa9, 0x16, 0x9c, 0xe2, 0xc5, 0x44, 0x5f, 0xab, 0x21, 0x44,
+	0x06, 0x09, 0x73, 0xeb, 0xde, 0x42, 0x55, 0x5e, 0x83, 0x75, 0x16, 0xc5, 0x98, 0x0c, 0x58, 0xd0,
+	0xc1, 0x51, 0xd8, 0x61, 0xea, 0x8a, 0x21, 0x57, 0xff, 0xdf, 0x29, 0x5b, 0x51, 0x0b, 0x89, 0x83,
+	0x66, 0x9d, 0x0f, 0xb7, 0xad, 0xa7, 0x9c, 0x70, 0x2a, 0xe3, 0x89, 0x2e, 0x9d, 0x4f, 0xf4, 0xcd,
+	0x53, 0x18, 0xf7, 0xf6, 0xcc, 0xe5, 0xff, 0x4d, 0xaf, 0x98, 0x15, 0x04, 0xad, 0xb8, 0xe0, 0xd6,
+	0x82, 0x98, 0x7f, 0x29, 0x83, 0x71, 0x5f, 0xcd, 0x1b, 0x72, 0x35, 0xef, 0xdc, 0x3b, 0x9f, 0xe8,
+	0xea, 0xb2, 0xc9, 0x25, 0x62, 0x7a, 0xa5, 0xac, 0xe6, 0x2f, 0x4a, 0x7b, 0xf9, 0x1f, 0x1f, 0x75,
+	0xc9, 0x7c, 0x27, 0x03, 0x4d, 0x0c, 0xaf, 0x86, 0xba, 0x09, 0x79, 0xdb, 0xc3, 0xed, 0x10, 0xc7,
+	0x38, 0x61, 0xbf, 0xe6, 0xf8, 0x08, 0xac, 0x52, 0x06, 0xd9, 0x80, 0xf2, 0x49, 0xae, 0xef, 0x18,
+	0xd6, 0x95, 0xe0, 0xac, 0x4b, 0xb6, 0xc9, 0x39, 0x2f, 0xe3, 0x95, 0xfb, 0xa0, 0x88, 0xd3, 0x94,
+	0xa4, 0x41, 0x8c, 0x29, 0x85, 0x21, 0x56, 0x73, 0x86, 0x5c, 0x5d, 0xf3, 0x0a, 0xbc, 0x78, 0x24,
+	0x6a, 0x59, 0x1f, 0x5f, 0x72, 0xe0, 0xee, 0x52, 0x88, 0x1e, 0x1e, 0x92, 0x2e, 0x9e, 0xdb, 0xfe,
+	0x0b, 0xf2, 0x26, 0x41, 0xb6, 0x81, 0xf9, 0xa7, 0x1c, 0x7f, 0x1b, 0xe5, 0x5f, 0x67, 0x29, 0x76,
+	0x79, 0xf8, 0x06, 0x6c, 0x5c, 0x01, 0x14, 0x0d, 0x94, 0x5d, 0x67, 0x3f, 0x68, 0xba, 0x07, 0x8d,
+	0xc0, 0x7f, 0x19, 0x34, 0xfd, 0x9a, 0x7f, 0xd2, 0x0c, 0x4e, 0x1a, 0x87, 0x8d, 0xe3, 0x17, 0x8d,
+	0x92, 0xa4, 0x6c, 0x81, 0xcd, 0x6b, 0xf4, 0xe3, 0xc3, 0x92, 0xac, 0x54, 0xc0, 0xd6, 0x35, 0xd2,
+	0x93, 0x9a, 0xfb, 0xec, 0x71, 0xbd, 0x94, 0x2b, 0xe7, 0xdf, 0x7f, 0xd2, 0x24, 0xe7, 0x68, 0xfc,
+	0x5d, 0x93, 0xc6, 0x53, 0x4d, 0x3e, 0x9b, 0x6a, 0xf2, 0xb7, 0xa9, 0x26, 0x7f, 0x98, 0x69, 0xd2,
+	0xd9, 0x4c, 0x93, 0xbe, 0xce, 0x34, 0xe9, 0xd5, 0xcd, 0x6e, 0x02, 0x7f, 0x7d, 0xf8, 0x75, 0x68,
+	0xad, 0xf2, 0x47, 0x63, 0xf7, 0xe7, 0x00, 0x04, 0xa3, 0x8b, 0xe4, 0x9d, 0x04, 0x00, 0x00,
 }
 
 func (m *PacketDataIBCSignTx) Marshal() (dAtA []byte, err error) {
@@ -247,6 +335,95 @@ func (m *PacketAcknowledgementIBCSignTx) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *PacketAcknowledgementIBCSignTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintPackets(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintPackets(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketDataIBCRevokeSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketDataIBCRevokeSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketDataIBCRevokeSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintPackets(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPackets(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintPackets(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintPackets(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketAcknowledgementIBCRevokeSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketAcknowledgementIBCRevokeSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketAcknowledgementIBCRevokeSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -295,6 +472,46 @@ func (m *PacketDataIBCSignTx) Size() (n int) {
 }
 
 func (m *PacketAcknowledgementIBCSignTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovPackets(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovPackets(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketDataIBCRevokeSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovPackets(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, b := range m.Signers {
+			l = len(b)
+			n += 1 + l + sovPackets(uint64(l))
+		}
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovPackets(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovPackets(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *PacketAcknowledgementIBCRevokeSign) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -512,6 +729,281 @@ func (m *PacketAcknowledgementIBCSignTx) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: PacketAcknowledgementIBCSignTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPackets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= IBCSignTxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPackets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPackets
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPackets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPackets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPackets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPackets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketDataIBCRevokeSign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPackets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketDataIBCRevokeSign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketDataIBCRevokeSign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPackets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPackets
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPackets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPackets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPackets
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPackets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, make([]byte, postIndex-iNdEx))
+			copy(m.Signers[len(m.Signers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPackets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPackets
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPackets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPackets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPackets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPackets
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPackets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketAcknowledgementIBCRevokeSign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPackets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketAcknowledgementIBCRevokeSign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketAcknowledgementIBCRevokeSign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
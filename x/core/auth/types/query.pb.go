@@ -104,36 +104,280 @@ func (m *QueryTxAuthStateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTxAuthStateResponse proto.InternalMessageInfo
 
+type QueryIBCSignTxRecordRequest struct {
+	TxID   github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=txID,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"txID,omitempty"`
+	Signer AccountID                                       `protobuf:"bytes,2,opt,name=signer,proto3,casttype=AccountID" json:"signer,omitempty"`
+}
+
+func (m *QueryIBCSignTxRecordRequest) Reset()         { *m = QueryIBCSignTxRecordRequest{} }
+func (m *QueryIBCSignTxRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCSignTxRecordRequest) ProtoMessage()    {}
+func (*QueryIBCSignTxRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3158548dc8277916, []int{2}
+}
+func (m *QueryIBCSignTxRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCSignTxRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCSignTxRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCSignTxRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCSignTxRecordRequest.Merge(m, src)
+}
+func (m *QueryIBCSignTxRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCSignTxRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCSignTxRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCSignTxRecordRequest proto.InternalMessageInfo
+
+type QueryIBCSignTxRecordResponse struct {
+	Record *IBCSignTxRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (m *QueryIBCSignTxRecordResponse) Reset()         { *m = QueryIBCSignTxRecordResponse{} }
+func (m *QueryIBCSignTxRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCSignTxRecordResponse) ProtoMessage()    {}
+func (*QueryIBCSignTxRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3158548dc8277916, []int{3}
+}
+func (m *QueryIBCSignTxRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCSignTxRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCSignTxRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCSignTxRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCSignTxRecordResponse.Merge(m, src)
+}
+func (m *QueryIBCSignTxRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCSignTxRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCSignTxRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCSignTxRecordResponse proto.InternalMessageInfo
+
+type QueryIBCSignTxRecordsRequest struct {
+	TxID github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=txID,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"txID,omitempty"`
+}
+
+func (m *QueryIBCSignTxRecordsRequest) Reset()         { *m = QueryIBCSignTxRecordsRequest{} }
+func (m *QueryIBCSignTxRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCSignTxRecordsRequest) ProtoMessage()    {}
+func (*QueryIBCSignTxRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3158548dc8277916, []int{4}
+}
+func (m *QueryIBCSignTxRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCSignTxRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCSignTxRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCSignTxRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCSignTxRecordsRequest.Merge(m, src)
+}
+func (m *QueryIBCSignTxRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCSignTxRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCSignTxRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCSignTxRecordsRequest proto.InternalMessageInfo
+
+type QueryIBCSignTxRecordsResponse struct {
+	Records []IBCSignTxRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryIBCSignTxRecordsResponse) Reset()         { *m = QueryIBCSignTxRecordsResponse{} }
+func (m *QueryIBCSignTxRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCSignTxRecordsResponse) ProtoMessage()    {}
+func (*QueryIBCSignTxRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3158548dc8277916, []int{5}
+}
+func (m *QueryIBCSignTxRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCSignTxRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCSignTxRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCSignTxRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCSignTxRecordsResponse.Merge(m, src)
+}
+func (m *QueryIBCSignTxRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCSignTxRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCSignTxRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCSignTxRecordsResponse proto.InternalMessageInfo
+
+type QuerySignGrantsRequest struct {
+	Granter AccountID `protobuf:"bytes,1,opt,name=granter,proto3,casttype=AccountID" json:"granter,omitempty"`
+}
+
+func (m *QuerySignGrantsRequest) Reset()         { *m = QuerySignGrantsRequest{} }
+func (m *QuerySignGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignGrantsRequest) ProtoMessage()    {}
+func (*QuerySignGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3158548dc8277916, []int{6}
+}
+func (m *QuerySignGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignGrantsRequest.Merge(m, src)
+}
+func (m *QuerySignGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignGrantsRequest proto.InternalMessageInfo
+
+type QuerySignGrantsResponse struct {
+	Grants []SignGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+}
+
+func (m *QuerySignGrantsResponse) Reset()         { *m = QuerySignGrantsResponse{} }
+func (m *QuerySignGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignGrantsResponse) ProtoMessage()    {}
+func (*QuerySignGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3158548dc8277916, []int{7}
+}
+func (m *QuerySignGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignGrantsResponse.Merge(m, src)
+}
+func (m *QuerySignGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignGrantsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryTxAuthStateRequest)(nil), "cross.core.auth.QueryTxAuthStateRequest")
 	proto.RegisterType((*QueryTxAuthStateResponse)(nil), "cross.core.auth.QueryTxAuthStateResponse")
+	proto.RegisterType((*QueryIBCSignTxRecordRequest)(nil), "cross.core.auth.QueryIBCSignTxRecordRequest")
+	proto.RegisterType((*QueryIBCSignTxRecordResponse)(nil), "cross.core.auth.QueryIBCSignTxRecordResponse")
+	proto.RegisterType((*QueryIBCSignTxRecordsRequest)(nil), "cross.core.auth.QueryIBCSignTxRecordsRequest")
+	proto.RegisterType((*QueryIBCSignTxRecordsResponse)(nil), "cross.core.auth.QueryIBCSignTxRecordsResponse")
+	proto.RegisterType((*QuerySignGrantsRequest)(nil), "cross.core.auth.QuerySignGrantsRequest")
+	proto.RegisterType((*QuerySignGrantsResponse)(nil), "cross.core.auth.QuerySignGrantsResponse")
 }
 
 func init() { proto.RegisterFile("cross/core/auth/query.proto", fileDescriptor_3158548dc8277916) }
 
 var fileDescriptor_3158548dc8277916 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x2e, 0xca, 0x2f,
-	0x2e, 0xd6, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x2c, 0x4d, 0x2d,
-	0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x07, 0x4b, 0xea, 0x81, 0x24, 0xf5, 0x40,
-	0x92, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89, 0x79,
-	0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x10, 0xe5, 0x52, 0x22, 0xe9, 0xf9, 0xe9,
-	0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0xc5, 0xb0, 0xa1, 0xa4, 0xb2, 0x20, 0x15, 0xaa, 0x45,
-	0x29, 0x89, 0x4b, 0x3c, 0x10, 0x64, 0x61, 0x48, 0x85, 0x63, 0x69, 0x49, 0x46, 0x70, 0x49, 0x62,
-	0x49, 0x6a, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x90, 0x3b, 0x17, 0x4b, 0x49, 0x85, 0xa7,
-	0x8b, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x8f, 0x93, 0xf1, 0xaf, 0x7b, 0xf2, 0xfa, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x29, 0x89, 0x25, 0x89, 0xc9, 0x19, 0x89, 0x99,
-	0x79, 0x39, 0x89, 0x49, 0xfa, 0x10, 0x1b, 0x2a, 0x20, 0x76, 0x40, 0x8c, 0x0f, 0xa9, 0xf0, 0x74,
-	0x09, 0x02, 0x1b, 0xa0, 0x14, 0xc3, 0x25, 0x81, 0x69, 0x47, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
-	0x90, 0x03, 0x17, 0x6f, 0x49, 0x45, 0x3c, 0xc8, 0x59, 0xf1, 0xc5, 0x20, 0x09, 0xb0, 0x6d, 0xdc,
-	0x46, 0x32, 0x7a, 0x68, 0x3e, 0xd7, 0x43, 0xd6, 0xcc, 0x5d, 0x82, 0xe0, 0x18, 0x4d, 0x62, 0xe4,
-	0x62, 0x05, 0x1b, 0x2f, 0xd4, 0xc1, 0xc8, 0xc5, 0x8d, 0xa4, 0x4c, 0x48, 0x03, 0xc3, 0x10, 0x1c,
-	0x5e, 0x95, 0xd2, 0x24, 0x42, 0x25, 0xc4, 0xc1, 0x4a, 0x2a, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x92,
-	0x13, 0x92, 0xd1, 0xc7, 0x08, 0xd6, 0x0a, 0x10, 0x05, 0xf6, 0x85, 0x93, 0xef, 0x89, 0x87, 0x72,
-	0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x45, 0x5c, 0x38, 0x22, 0xe2,
-	0x2a, 0x89, 0x0d, 0x1c, 0x59, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0xb2, 0x70, 0xaf,
-	0x2d, 0x02, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xd4, 0x98, 0xe2, 0xc4, 0x52, 0x19, 0xc4, 0x86, 0x6d, 0xdc, 0x84, 0xb5, 0xb5, 0x11,
+	0x9b, 0x1d, 0x68, 0x2f, 0x1e, 0x4d, 0x0c, 0x94, 0x1c, 0x3c, 0x98, 0xe4, 0x20, 0x22, 0x94, 0xd9,
+	0xed, 0xb0, 0x59, 0xa8, 0x3b, 0xe9, 0xce, 0x2c, 0x6c, 0xaf, 0x82, 0xe0, 0x45, 0x10, 0x3c, 0x7a,
+	0xf0, 0xef, 0xe4, 0x58, 0xf0, 0x22, 0x1e, 0x82, 0x26, 0xfe, 0x8a, 0x9e, 0x64, 0x66, 0x27, 0x49,
+	0xc9, 0x6e, 0xe9, 0x1e, 0x7a, 0xca, 0x66, 0xde, 0xf7, 0xbe, 0xef, 0x7b, 0xf3, 0xde, 0x1b, 0xb8,
+	0xed, 0x86, 0x8c, 0x73, 0xec, 0xb2, 0x90, 0x62, 0x12, 0x89, 0x21, 0x3e, 0x8b, 0x68, 0x78, 0x6e,
+	0x8f, 0x42, 0x26, 0x18, 0xda, 0x54, 0x41, 0x5b, 0x06, 0x6d, 0x19, 0x34, 0xaa, 0x1e, 0x63, 0xde,
+	0x29, 0xc5, 0x64, 0xe4, 0x63, 0x12, 0x04, 0x4c, 0x10, 0xe1, 0xb3, 0x80, 0x27, 0x70, 0xe3, 0xa1,
+	0xc7, 0x3c, 0xa6, 0x3e, 0xb1, 0xfc, 0xd2, 0xa7, 0x29, 0x05, 0x71, 0x3e, 0xa2, 0x3a, 0xc5, 0x72,
+	0xe0, 0xd6, 0x1b, 0x29, 0x38, 0x88, 0x5b, 0x91, 0x18, 0xf6, 0x05, 0x11, 0xb4, 0x47, 0xcf, 0x22,
+	0xca, 0x05, 0x3a, 0x82, 0x45, 0x11, 0x77, 0x3b, 0x15, 0x50, 0x07, 0x8d, 0xfb, 0xed, 0xc3, 0xcb,
+	0x49, 0x0d, 0x7b, 0xbe, 0x18, 0x46, 0x8e, 0xed, 0xb2, 0x0f, 0xf8, 0x84, 0x08, 0xe2, 0x0e, 0x89,
+	0x1f, 0x9c, 0x12, 0x07, 0x27, 0x0a, 0x71, 0xa2, 0x91, 0xd0, 0x0f, 0xe2, 0x6e, 0xa7, 0xa7, 0x08,
+	0xac, 0xf7, 0xb0, 0x92, 0xd6, 0xe0, 0x23, 0x16, 0x70, 0x8a, 0x5e, 0xc2, 0x0d, 0x11, 0x1f, 0x4b,
+	0x5b, 0xc7, 0x5c, 0x06, 0x94, 0x5a, 0xf9, 0xa0, 0x6a, 0xaf, 0x54, 0x6e, 0x5f, 0x4d, 0x2e, 0x8b,
+	0xe5, 0x1f, 0xeb, 0x0b, 0x80, 0xdb, 0x8a, 0xbe, 0xdb, 0x7e, 0xd5, 0xf7, 0xbd, 0x60, 0x10, 0xf7,
+	0xa8, 0xcb, 0xc2, 0x93, 0xdb, 0x2e, 0x03, 0xed, 0xc2, 0x12, 0xf7, 0xbd, 0x80, 0x86, 0x95, 0x35,
+	0x45, 0xb5, 0x71, 0x39, 0xa9, 0xdd, 0x6b, 0xb9, 0x2e, 0x8b, 0x02, 0xd1, 0xed, 0xf4, 0x74, 0xd0,
+	0x7a, 0x0b, 0xab, 0xd9, 0x76, 0x74, 0xc5, 0x2f, 0x60, 0x29, 0x54, 0x27, 0xba, 0xd4, 0x7a, 0xaa,
+	0xd4, 0xd5, 0x4c, 0x8d, 0xb7, 0xbc, 0x6c, 0x66, 0x7e, 0xeb, 0x0d, 0x23, 0xf0, 0xf1, 0x35, 0x42,
+	0x8b, 0xae, 0xad, 0x27, 0x9e, 0x78, 0x05, 0xd4, 0xef, 0xe4, 0x29, 0xa2, 0x5d, 0x1c, 0x4f, 0x6a,
+	0x85, 0xde, 0x3c, 0xcd, 0x6a, 0xc1, 0x47, 0x4a, 0x42, 0x62, 0x8e, 0x42, 0x12, 0x88, 0x45, 0x15,
+	0x7b, 0x70, 0xdd, 0x93, 0x07, 0x34, 0xac, 0x80, 0xac, 0x7b, 0x9e, 0x47, 0xad, 0x3e, 0xdc, 0x4a,
+	0x51, 0x2c, 0xef, 0x58, 0xa1, 0xe6, 0xf6, 0x8c, 0x94, 0xbd, 0x45, 0x92, 0x36, 0xa6, 0xf1, 0x07,
+	0xbf, 0x8b, 0xf0, 0xae, 0x62, 0x45, 0x9f, 0x01, 0x2c, 0x5f, 0x19, 0x3a, 0xd4, 0x48, 0x71, 0x5c,
+	0xb3, 0x38, 0xc6, 0xb3, 0x1c, 0xc8, 0xc4, 0xa8, 0xb5, 0xf3, 0xf1, 0xe7, 0xbf, 0x6f, 0x6b, 0x26,
+	0xaa, 0xe2, 0xd4, 0x92, 0xc6, 0xf2, 0x47, 0xed, 0x04, 0xfa, 0x0e, 0xe0, 0xe6, 0xca, 0x7d, 0xa2,
+	0xfd, 0x6c, 0x91, 0xec, 0x25, 0x30, 0x9a, 0x39, 0xd1, 0xda, 0xd6, 0x73, 0x65, 0x6b, 0x17, 0x3d,
+	0x49, 0xd9, 0xf2, 0x1d, 0xb7, 0x29, 0x07, 0xbd, 0x29, 0xe2, 0x66, 0xd2, 0x4b, 0xf4, 0x03, 0xc0,
+	0x07, 0xab, 0x93, 0x82, 0xf2, 0x09, 0xce, 0x9b, 0x6e, 0xd8, 0x79, 0xe1, 0xda, 0xe0, 0xbe, 0x32,
+	0xf8, 0x14, 0xed, 0xe4, 0x30, 0xc8, 0xd1, 0x27, 0x00, 0xe1, 0x72, 0x4a, 0xd0, 0x5e, 0xb6, 0x58,
+	0x6a, 0x14, 0x8d, 0xc6, 0xcd, 0xc0, 0x1b, 0xfb, 0xa8, 0xbc, 0x24, 0xc3, 0xd5, 0x7e, 0x3d, 0xfe,
+	0x6b, 0x16, 0xc6, 0x53, 0x13, 0x5c, 0x4c, 0x4d, 0xf0, 0x67, 0x6a, 0x82, 0xaf, 0x33, 0xb3, 0x70,
+	0x31, 0x33, 0x0b, 0xbf, 0x66, 0x66, 0xe1, 0x5d, 0xbe, 0x65, 0x5d, 0xbe, 0xe0, 0x4e, 0x49, 0x3d,
+	0xe1, 0x87, 0xff, 0x07, 0x00, 0xbd, 0xb8, 0xae, 0x0a, 0x43, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -149,6 +393,12 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	TxAuthState(ctx context.Context, in *QueryTxAuthStateRequest, opts ...grpc.CallOption) (*QueryTxAuthStateResponse, error)
+	// IBCSignTxRecord returns the record of a sign sent to other chain
+	IBCSignTxRecord(ctx context.Context, in *QueryIBCSignTxRecordRequest, opts ...grpc.CallOption) (*QueryIBCSignTxRecordResponse, error)
+	// IBCSignTxRecords returns the records of the signs sent to other chain for a given tx
+	IBCSignTxRecords(ctx context.Context, in *QueryIBCSignTxRecordsRequest, opts ...grpc.CallOption) (*QueryIBCSignTxRecordsResponse, error)
+	// SignGrants returns the sign grants of a given granter
+	SignGrants(ctx context.Context, in *QuerySignGrantsRequest, opts ...grpc.CallOption) (*QuerySignGrantsResponse, error)
 }
 
 type queryClient struct {
@@ -168,9 +418,42 @@ func (c *queryClient) TxAuthState(ctx context.Context, in *QueryTxAuthStateReque
 	return out, nil
 }
 
+func (c *queryClient) IBCSignTxRecord(ctx context.Context, in *QueryIBCSignTxRecordRequest, opts ...grpc.CallOption) (*QueryIBCSignTxRecordResponse, error) {
+	out := new(QueryIBCSignTxRecordResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.auth.Query/IBCSignTxRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCSignTxRecords(ctx context.Context, in *QueryIBCSignTxRecordsRequest, opts ...grpc.CallOption) (*QueryIBCSignTxRecordsResponse, error) {
+	out := new(QueryIBCSignTxRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.auth.Query/IBCSignTxRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignGrants(ctx context.Context, in *QuerySignGrantsRequest, opts ...grpc.CallOption) (*QuerySignGrantsResponse, error) {
+	out := new(QuerySignGrantsResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.auth.Query/SignGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	TxAuthState(context.Context, *QueryTxAuthStateRequest) (*QueryTxAuthStateResponse, error)
+	// IBCSignTxRecord returns the record of a sign sent to other chain
+	IBCSignTxRecord(context.Context, *QueryIBCSignTxRecordRequest) (*QueryIBCSignTxRecordResponse, error)
+	// IBCSignTxRecords returns the records of the signs sent to other chain for a given tx
+	IBCSignTxRecords(context.Context, *QueryIBCSignTxRecordsRequest) (*QueryIBCSignTxRecordsResponse, error)
+	// SignGrants returns the sign grants of a given granter
+	SignGrants(context.Context, *QuerySignGrantsRequest) (*QuerySignGrantsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -180,6 +463,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) TxAuthState(ctx context.Context, req *QueryTxAuthStateRequest) (*QueryTxAuthStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxAuthState not implemented")
 }
+func (*UnimplementedQueryServer) IBCSignTxRecord(ctx context.Context, req *QueryIBCSignTxRecordRequest) (*QueryIBCSignTxRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCSignTxRecord not implemented")
+}
+func (*UnimplementedQueryServer) IBCSignTxRecords(ctx context.Context, req *QueryIBCSignTxRecordsRequest) (*QueryIBCSignTxRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCSignTxRecords not implemented")
+}
+func (*UnimplementedQueryServer) SignGrants(ctx context.Context, req *QuerySignGrantsRequest) (*QuerySignGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignGrants not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -203,6 +495,60 @@ func _Query_TxAuthState_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IBCSignTxRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCSignTxRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCSignTxRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.auth.Query/IBCSignTxRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCSignTxRecord(ctx, req.(*QueryIBCSignTxRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCSignTxRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCSignTxRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCSignTxRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.auth.Query/IBCSignTxRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCSignTxRecords(ctx, req.(*QueryIBCSignTxRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.auth.Query/SignGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignGrants(ctx, req.(*QuerySignGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cross.core.auth.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -211,6 +557,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TxAuthState",
 			Handler:    _Query_TxAuthState_Handler,
 		},
+		{
+			MethodName: "IBCSignTxRecord",
+			Handler:    _Query_IBCSignTxRecord_Handler,
+		},
+		{
+			MethodName: "IBCSignTxRecords",
+			Handler:    _Query_IBCSignTxRecords_Handler,
+		},
+		{
+			MethodName: "SignGrants",
+			Handler:    _Query_SignGrants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cross/core/auth/query.proto",
@@ -281,50 +639,342 @@ func (m *QueryTxAuthStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryIBCSignTxRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTxAuthStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TxID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryIBCSignTxRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxAuthStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIBCSignTxRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TxAuthState != nil {
-		l = m.TxAuthState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryIBCSignTxRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryIBCSignTxRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryTxAuthStateRequest) Unmarshal(dAtA []byte) error {
+
+func (m *QueryIBCSignTxRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCSignTxRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCSignTxRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCSignTxRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCSignTxRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCSignTxRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCSignTxRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTxAuthStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxAuthStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxAuthState != nil {
+		l = m.TxAuthState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCSignTxRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCSignTxRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCSignTxRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCSignTxRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySignGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTxAuthStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -500,6 +1150,564 @@ func (m *QueryTxAuthStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryIBCSignTxRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCSignTxRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCSignTxRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCSignTxRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCSignTxRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCSignTxRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &IBCSignTxRecord{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCSignTxRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCSignTxRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCSignTxRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCSignTxRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCSignTxRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCSignTxRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, IBCSignTxRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, SignGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	crosstypes "github.com/datachainlab/cross/x/core/types"
@@ -11,10 +14,18 @@ import (
 type TxAuthenticator interface {
 	// InitAuthState initializes the state of the tx corresponding to a given txID
 	InitAuthState(ctx sdk.Context, txID crosstypes.TxID, signers []Account) error
+	// InitAuthStateWithSignerGroups initializes the state of the tx that requires the signatures of the signer groups in addition to the signers
+	InitAuthStateWithSignerGroups(ctx sdk.Context, txID crosstypes.TxID, signers []Account, groups []SignerGroup) error
 	// IsCompletedAuth returns a boolean whether the tx corresponding a given txID is completed
 	IsCompletedAuth(ctx sdk.Context, txID crosstypes.TxID) (bool, error)
 	// Sign executes
 	Sign(ctx sdk.Context, txID crosstypes.TxID, signers []Account) (bool, error)
+	// DeleteAuthState deletes the state of the tx corresponding to a given txID
+	DeleteAuthState(ctx sdk.Context, txID crosstypes.TxID) error
+	// GetRemainingSigners returns the signers who haven't signed the tx corresponding to a given txID yet
+	GetRemainingSigners(ctx sdk.Context, txID crosstypes.TxID) ([]Account, error)
+	// GetSignedSigners returns the signers who have signed the tx corresponding to a given txID
+	GetSignedSigners(ctx sdk.Context, txID crosstypes.TxID) ([]Account, error)
 }
 
 // TxManager defines the expected interface of transaction manager
@@ -23,18 +34,145 @@ type TxManager interface {
 	IsActive(ctx sdk.Context, txID crosstypes.TxID) (bool, error)
 	// OnPostAuth represents a callback function is called at post authentication
 	OnPostAuth(ctx sdk.Context, txID crosstypes.TxID) error
+	// GetSignTargets returns the contract transactions of the tx that require the signature of a given signer
+	GetSignTargets(ctx sdk.Context, txID crosstypes.TxID, signer Account) ([]SignTarget, error)
+}
+
+// NewTxAuthState creates a new instance of TxAuthState
+func NewTxAuthState(signers []Account, groups []SignerGroup) TxAuthState {
+	return TxAuthState{
+		RemainingSigners: signers,
+		SignerGroups:     groups,
+		RequiredSigners:  append([]Account{}, signers...),
+	}
 }
 
 // IsCompleted returns a boolean whether the required authentication is completed
 func (s TxAuthState) IsCompleted() bool {
-	return len(s.RemainingSigners) == 0
+	if len(s.RemainingSigners) > 0 {
+		return false
+	}
+	for _, g := range s.SignerGroups {
+		if !g.IsSatisfied(s.SignedSigners) {
+			return false
+		}
+	}
+	return true
 }
 
 // ConsumeSigners removes the signers from required signers
 func (s *TxAuthState) ConsumeSigners(signers []Account) (isConsumed bool) {
 	before := len(s.RemainingSigners)
+	for _, signer := range signers {
+		if containsAccount(s.SignedSigners, signer) {
+			continue
+		}
+		if containsAccount(s.RemainingSigners, signer) || s.isGroupMember(signer) {
+			s.SignedSigners = append(s.SignedSigners, signer)
+			isConsumed = true
+		}
+	}
 	s.RemainingSigners = getRemainingAccounts(signers, s.RemainingSigners)
-	return before-len(s.RemainingSigners) > 0
+	return isConsumed || before-len(s.RemainingSigners) > 0
+}
+
+// RevokeSigners cancels the signs of given signers
+// The revoked signers that are required individually are put back into the remaining signers.
+func (s *TxAuthState) RevokeSigners(signers []Account) (isRevoked bool) {
+	var signed []Account
+	for _, acc := range s.SignedSigners {
+		if !containsAccount(signers, acc) {
+			signed = append(signed, acc)
+			continue
+		}
+		isRevoked = true
+		if containsAccount(s.RequiredSigners, acc) && !containsAccount(s.RemainingSigners, acc) {
+			s.RemainingSigners = append(s.RemainingSigners, acc)
+		}
+	}
+	s.SignedSigners = signed
+	return isRevoked
+}
+
+// RemainingAccounts returns the accounts whose signatures are still required to complete the authentication
+// It includes the members of the groups that are not satisfied yet.
+func (s TxAuthState) RemainingAccounts() []Account {
+	accs := append([]Account{}, s.RemainingSigners...)
+	for _, g := range s.SignerGroups {
+		if g.IsSatisfied(s.SignedSigners) {
+			continue
+		}
+		for _, m := range g.Members {
+			if !containsAccount(s.SignedSigners, m) && !containsAccount(accs, m) {
+				accs = append(accs, m)
+			}
+		}
+	}
+	return accs
+}
+
+func (s TxAuthState) isGroupMember(acc Account) bool {
+	for _, g := range s.SignerGroups {
+		if containsAccount(g.Members, acc) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewSignerGroup creates a new instance of SignerGroup
+func NewSignerGroup(members []Account, threshold uint32) SignerGroup {
+	return SignerGroup{Members: members, Threshold: threshold}
+}
+
+// ValidateBasic validates the group
+func (g SignerGroup) ValidateBasic() error {
+	if g.Threshold == 0 {
+		return fmt.Errorf("threshold must be greater than 0")
+	} else if int(g.Threshold) > len(g.Members) {
+		return fmt.Errorf("threshold must be less than or equal to the number of members: threshold=%v members=%v", g.Threshold, len(g.Members))
+	}
+	for i, m := range g.Members {
+		if containsAccount(g.Members[:i], m) {
+			return fmt.Errorf("duplicate member: %x", m.Id)
+		}
+	}
+	return nil
+}
+
+// IsSatisfied returns a boolean whether the threshold of the group is satisfied by given signed accounts
+func (g SignerGroup) IsSatisfied(signed []Account) bool {
+	return uint32(len(g.SignedMembers(signed))) >= g.Threshold
+}
+
+// SignedMembers returns the members of the group that are included in given signed accounts
+func (g SignerGroup) SignedMembers(signed []Account) []Account {
+	var members []Account
+	for _, m := range g.Members {
+		if containsAccount(signed, m) {
+			members = append(members, m)
+		}
+	}
+	return members
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (g *SignerGroup) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i := range g.Members {
+		if err := g.Members[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsAccount(accs []Account, acc Account) bool {
+	for _, a := range accs {
+		if a.Equal(acc) {
+			return true
+		}
+	}
+	return false
 }
 
 func getRemainingAccounts(signers, required []Account) []Account {
@@ -55,6 +193,11 @@ func getRemainingAccounts(signers, required []Account) []Account {
 	return remaining
 }
 
+// NewIBCSignTxRecord creates a new instance of IBCSignTxRecord
+func NewIBCSignTxRecord(txID crosstypes.TxID, signer AccountID, status IBCSignTxRecordStatus) IBCSignTxRecord {
+	return IBCSignTxRecord{TxID: txID, Signer: signer, Status: status}
+}
+
 // AuthExtensionVerifier defines an interface that verifies a tx with an auth extension signature
 type AuthExtensionVerifier interface {
 	proto.Message
@@ -7,6 +7,7 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_datachainlab_cross_x_core_types "github.com/datachainlab/cross/x/core/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -56,6 +57,49 @@ func (AuthMode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2514c47ca339c50e, []int{0}
 }
 
+// IBCSignTxRecordStatus defines the delivery status of a sign sent to other chain
+type IBCSignTxRecordStatus int32
+
+const (
+	IBC_SIGN_TX_RECORD_STATUS_UNKNOWN IBCSignTxRecordStatus = 0
+	// the packet is sent, but the acknowledgement is not received yet
+	IBC_SIGN_TX_RECORD_STATUS_PENDING IBCSignTxRecordStatus = 1
+	// the sign is accepted by the counterparty chain
+	IBC_SIGN_TX_RECORD_STATUS_OK IBCSignTxRecordStatus = 2
+	// the sign is rejected by the counterparty chain
+	IBC_SIGN_TX_RECORD_STATUS_FAILED IBCSignTxRecordStatus = 3
+	// the packet is timed out
+	IBC_SIGN_TX_RECORD_STATUS_TIMEOUT IBCSignTxRecordStatus = 4
+	// the sign is revoked on the counterparty chain
+	IBC_SIGN_TX_RECORD_STATUS_REVOKED IBCSignTxRecordStatus = 5
+)
+
+var IBCSignTxRecordStatus_name = map[int32]string{
+	0: "IBC_SIGN_TX_RECORD_STATUS_UNKNOWN",
+	1: "IBC_SIGN_TX_RECORD_STATUS_PENDING",
+	2: "IBC_SIGN_TX_RECORD_STATUS_OK",
+	3: "IBC_SIGN_TX_RECORD_STATUS_FAILED",
+	4: "IBC_SIGN_TX_RECORD_STATUS_TIMEOUT",
+	5: "IBC_SIGN_TX_RECORD_STATUS_REVOKED",
+}
+
+var IBCSignTxRecordStatus_value = map[string]int32{
+	"IBC_SIGN_TX_RECORD_STATUS_UNKNOWN": 0,
+	"IBC_SIGN_TX_RECORD_STATUS_PENDING": 1,
+	"IBC_SIGN_TX_RECORD_STATUS_OK":      2,
+	"IBC_SIGN_TX_RECORD_STATUS_FAILED":  3,
+	"IBC_SIGN_TX_RECORD_STATUS_TIMEOUT": 4,
+	"IBC_SIGN_TX_RECORD_STATUS_REVOKED": 5,
+}
+
+func (x IBCSignTxRecordStatus) String() string {
+	return proto.EnumName(IBCSignTxRecordStatus_name, int32(x))
+}
+
+func (IBCSignTxRecordStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2514c47ca339c50e, []int{1}
+}
+
 type Account struct {
 	Id       AccountID `protobuf:"bytes,1,opt,name=id,proto3,casttype=AccountID" json:"id,omitempty"`
 	AuthType AuthType  `protobuf:"bytes,2,opt,name=auth_type,json=authType,proto3" json:"auth_type"`
@@ -132,15 +176,60 @@ func (m *AuthType) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuthType proto.InternalMessageInfo
 
+// SignerGroup defines a group of accounts that requires signatures from any `threshold` members
+type SignerGroup struct {
+	Members   []Account `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+	Threshold uint32    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *SignerGroup) Reset()         { *m = SignerGroup{} }
+func (m *SignerGroup) String() string { return proto.CompactTextString(m) }
+func (*SignerGroup) ProtoMessage()    {}
+func (*SignerGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2514c47ca339c50e, []int{2}
+}
+func (m *SignerGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerGroup.Merge(m, src)
+}
+func (m *SignerGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerGroup proto.InternalMessageInfo
+
 type TxAuthState struct {
 	RemainingSigners []Account `protobuf:"bytes,1,rep,name=remaining_signers,json=remainingSigners,proto3" json:"remaining_signers"`
+	// signer_groups are the groups that require M-of-N signatures
+	SignerGroups []SignerGroup `protobuf:"bytes,2,rep,name=signer_groups,json=signerGroups,proto3" json:"signer_groups"`
+	// signed_signers are the accounts that have already signed the tx
+	SignedSigners []Account `protobuf:"bytes,3,rep,name=signed_signers,json=signedSigners,proto3" json:"signed_signers"`
+	// required_signers are the accounts that are required to sign the tx individually
+	RequiredSigners []Account `protobuf:"bytes,4,rep,name=required_signers,json=requiredSigners,proto3" json:"required_signers"`
 }
 
 func (m *TxAuthState) Reset()         { *m = TxAuthState{} }
 func (m *TxAuthState) String() string { return proto.CompactTextString(m) }
 func (*TxAuthState) ProtoMessage()    {}
 func (*TxAuthState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2514c47ca339c50e, []int{2}
+	return fileDescriptor_2514c47ca339c50e, []int{3}
 }
 func (m *TxAuthState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -169,45 +258,257 @@ func (m *TxAuthState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TxAuthState proto.InternalMessageInfo
 
+// IBCSignTxRecord is a record of a sign sent to other chain via MsgIBCSignTx
+type IBCSignTxRecord struct {
+	TxID   github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=txID,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"txID,omitempty"`
+	Signer AccountID                                       `protobuf:"bytes,2,opt,name=signer,proto3,casttype=AccountID" json:"signer,omitempty"`
+	Status IBCSignTxRecordStatus                           `protobuf:"varint,3,opt,name=status,proto3,enum=cross.core.auth.IBCSignTxRecordStatus" json:"status,omitempty"`
+	// error_message is the reason why the counterparty chain rejected the sign
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// updated_at is the block time (in seconds) when the record was last updated.
+	// The record is pruned when the IBCSignTxRecordRetention param has passed since then.
+	UpdatedAt uint64 `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *IBCSignTxRecord) Reset()         { *m = IBCSignTxRecord{} }
+func (m *IBCSignTxRecord) String() string { return proto.CompactTextString(m) }
+func (*IBCSignTxRecord) ProtoMessage()    {}
+func (*IBCSignTxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2514c47ca339c50e, []int{4}
+}
+func (m *IBCSignTxRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCSignTxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCSignTxRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCSignTxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCSignTxRecord.Merge(m, src)
+}
+func (m *IBCSignTxRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCSignTxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCSignTxRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCSignTxRecord proto.InternalMessageInfo
+
+// SignGrant authorizes the grantee to sign cross-chain transactions on behalf of the granter
+type SignGrant struct {
+	Granter AccountID `protobuf:"bytes,1,opt,name=granter,proto3,casttype=AccountID" json:"granter,omitempty"`
+	Grantee AccountID `protobuf:"bytes,2,opt,name=grantee,proto3,casttype=AccountID" json:"grantee,omitempty"`
+	// cross_chain_channel restricts the contract transactions that the grantee can sign to the ones with the channel
+	CrossChainChannel *types.Any `protobuf:"bytes,3,opt,name=cross_chain_channel,json=crossChainChannel,proto3" json:"cross_chain_channel,omitempty"`
+	// call_info_prefix restricts the contract transactions that the grantee can sign to the ones whose call info has the prefix
+	CallInfoPrefix []byte `protobuf:"bytes,4,opt,name=call_info_prefix,json=callInfoPrefix,proto3" json:"call_info_prefix,omitempty"`
+	// expiration_timestamp (unix time in seconds) is the time when the grant expires.
+	// It is compared with the block time like the timeout_timestamp of a tx.
+	// The expiration is disabled when set to 0.
+	ExpirationTimestamp uint64 `protobuf:"varint,5,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+}
+
+func (m *SignGrant) Reset()         { *m = SignGrant{} }
+func (m *SignGrant) String() string { return proto.CompactTextString(m) }
+func (*SignGrant) ProtoMessage()    {}
+func (*SignGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2514c47ca339c50e, []int{5}
+}
+func (m *SignGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignGrant.Merge(m, src)
+}
+func (m *SignGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignGrant proto.InternalMessageInfo
+
+// IdentifiedTxAuthState defines a TxAuthState with its txID
+type IdentifiedTxAuthState struct {
+	TxId        github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	TxAuthState TxAuthState                                     `protobuf:"bytes,2,opt,name=tx_auth_state,json=txAuthState,proto3" json:"tx_auth_state"`
+}
+
+func (m *IdentifiedTxAuthState) Reset()         { *m = IdentifiedTxAuthState{} }
+func (m *IdentifiedTxAuthState) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedTxAuthState) ProtoMessage()    {}
+func (*IdentifiedTxAuthState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2514c47ca339c50e, []int{6}
+}
+func (m *IdentifiedTxAuthState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedTxAuthState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedTxAuthState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedTxAuthState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedTxAuthState.Merge(m, src)
+}
+func (m *IdentifiedTxAuthState) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedTxAuthState) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedTxAuthState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedTxAuthState proto.InternalMessageInfo
+
+// GenesisState defines the auth module's genesis state
+type GenesisState struct {
+	TxAuthStates     []IdentifiedTxAuthState `protobuf:"bytes,1,rep,name=tx_auth_states,json=txAuthStates,proto3" json:"tx_auth_states"`
+	IbcSignTxRecords []IBCSignTxRecord       `protobuf:"bytes,2,rep,name=ibc_sign_tx_records,json=ibcSignTxRecords,proto3" json:"ibc_sign_tx_records"`
+	SignGrants       []SignGrant             `protobuf:"bytes,3,rep,name=sign_grants,json=signGrants,proto3" json:"sign_grants"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2514c47ca339c50e, []int{7}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cross.core.auth.AuthMode", AuthMode_name, AuthMode_value)
+	proto.RegisterEnum("cross.core.auth.IBCSignTxRecordStatus", IBCSignTxRecordStatus_name, IBCSignTxRecordStatus_value)
 	proto.RegisterType((*Account)(nil), "cross.core.auth.Account")
 	proto.RegisterType((*AuthType)(nil), "cross.core.auth.AuthType")
+	proto.RegisterType((*SignerGroup)(nil), "cross.core.auth.SignerGroup")
 	proto.RegisterType((*TxAuthState)(nil), "cross.core.auth.TxAuthState")
+	proto.RegisterType((*IBCSignTxRecord)(nil), "cross.core.auth.IBCSignTxRecord")
+	proto.RegisterType((*SignGrant)(nil), "cross.core.auth.SignGrant")
+	proto.RegisterType((*IdentifiedTxAuthState)(nil), "cross.core.auth.IdentifiedTxAuthState")
+	proto.RegisterType((*GenesisState)(nil), "cross.core.auth.GenesisState")
 }
 
 func init() { proto.RegisterFile("cross/core/auth/types.proto", fileDescriptor_2514c47ca339c50e) }
 
 var fileDescriptor_2514c47ca339c50e = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0xae, 0x1a, 0x9b, 0x0b, 0x2c, 0xf3, 0x36, 0xd1, 0x0e, 0x91, 0x56, 0x3b, 0x55,
-	0x48, 0xd8, 0x52, 0xb9, 0x21, 0x2e, 0x69, 0x1b, 0xb4, 0x8a, 0x36, 0x45, 0x6d, 0x27, 0x21, 0x2e,
-	0xc5, 0x4d, 0x4c, 0x62, 0xd4, 0xda, 0x51, 0xe2, 0x48, 0xcb, 0x5b, 0xf0, 0x08, 0x3c, 0x4e, 0x8f,
-	0x3b, 0x72, 0x9a, 0xa0, 0xbd, 0xf0, 0x0c, 0x9c, 0x50, 0x9c, 0x8c, 0x4a, 0x43, 0xbb, 0xd9, 0xfe,
-	0xff, 0xfd, 0xfd, 0xbe, 0xff, 0xa7, 0x0f, 0x3e, 0xf7, 0x62, 0x99, 0x24, 0xc4, 0x93, 0x31, 0x23,
-	0x34, 0x55, 0x21, 0x51, 0x59, 0xc4, 0x12, 0x1c, 0xc5, 0x52, 0x49, 0x74, 0xa4, 0x45, 0x9c, 0x8b,
-	0x38, 0x17, 0xcf, 0x4f, 0x03, 0x19, 0x48, 0xad, 0x91, 0xfc, 0x54, 0xd8, 0xce, 0x1b, 0x81, 0x94,
-	0xc1, 0x92, 0x11, 0x7d, 0x5b, 0xa4, 0x5f, 0x08, 0x15, 0x59, 0x21, 0x5d, 0x2c, 0xe1, 0x23, 0xdb,
-	0xf3, 0x64, 0x2a, 0x14, 0x7a, 0x01, 0x2b, 0xdc, 0xaf, 0x83, 0x16, 0x68, 0x3f, 0xee, 0x3e, 0xf9,
-	0x73, 0xdb, 0x3c, 0x2c, 0x85, 0x41, 0x7f, 0x52, 0xe1, 0x3e, 0x7a, 0x0b, 0x0f, 0x73, 0xc4, 0x3c,
-	0xe7, 0xd7, 0x2b, 0x2d, 0xd0, 0xae, 0x75, 0x1a, 0xf8, 0x1e, 0x1f, 0xdb, 0xa9, 0x0a, 0x67, 0x59,
-	0xc4, 0xba, 0xd5, 0xf5, 0x6d, 0xd3, 0x98, 0x1c, 0xd0, 0xf2, 0xfe, 0xa6, 0xfa, 0xfb, 0x7b, 0x13,
-	0x5c, 0x24, 0xf0, 0xe0, 0xce, 0x81, 0x5e, 0xc1, 0xea, 0x4a, 0xfa, 0x4c, 0x03, 0x9f, 0x3e, 0x50,
-	0x6a, 0x24, 0x7d, 0x36, 0xd1, 0x36, 0xd4, 0x81, 0xfb, 0x32, 0x52, 0x5c, 0x8a, 0x92, 0x7d, 0x8a,
-	0x8b, 0x50, 0xf8, 0x2e, 0x14, 0xb6, 0x45, 0xa6, 0xb1, 0x60, 0x52, 0x3a, 0x4b, 0xe8, 0x67, 0x58,
-	0x9b, 0x5d, 0xe7, 0xd5, 0xa6, 0x8a, 0x2a, 0x86, 0xde, 0xc3, 0xe3, 0x98, 0xad, 0x28, 0x17, 0x5c,
-	0x04, 0xf3, 0x84, 0x07, 0x82, 0xc5, 0x49, 0x1d, 0xb4, 0xf6, 0xda, 0xb5, 0x4e, 0xfd, 0xff, 0x26,
-	0x8a, 0x11, 0x94, 0x71, 0xcc, 0x7f, 0x1f, 0xa7, 0xc5, 0x3f, 0x4d, 0x30, 0x5e, 0x7e, 0x2d, 0x62,
-	0xe5, 0xdd, 0xa2, 0x06, 0x3c, 0xb3, 0xaf, 0x66, 0x97, 0xf3, 0xd1, 0xb8, 0xef, 0xcc, 0xaf, 0xdc,
-	0xe9, 0x07, 0xa7, 0x37, 0x78, 0x37, 0x70, 0xfa, 0xa6, 0x81, 0x4e, 0xe0, 0xd1, 0x4e, 0x1a, 0x8e,
-	0x7b, 0xf6, 0xd0, 0x04, 0xe8, 0x0c, 0x1e, 0xef, 0x1e, 0x7b, 0x97, 0xb6, 0xeb, 0x3a, 0x43, 0xb3,
-	0x82, 0x9e, 0xc1, 0x93, 0xdd, 0xb3, 0xf3, 0x71, 0xe6, 0xb8, 0xd3, 0xc1, 0xd8, 0x35, 0xf7, 0xba,
-	0xa3, 0xf5, 0x2f, 0xcb, 0x58, 0x6f, 0x2c, 0x70, 0xb3, 0xb1, 0xc0, 0xcf, 0x8d, 0x05, 0xbe, 0x6d,
-	0x2d, 0xe3, 0x66, 0x6b, 0x19, 0x3f, 0xb6, 0x96, 0xf1, 0x89, 0x04, 0x5c, 0x85, 0xe9, 0x02, 0x7b,
-	0x72, 0x45, 0x7c, 0xaa, 0xa8, 0x17, 0x52, 0x2e, 0x96, 0x74, 0x41, 0x8a, 0x2d, 0xba, 0xbe, 0xbf,
-	0x47, 0x8b, 0x7d, 0x3d, 0xbe, 0xd7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xf9, 0x86, 0xbe,
-	0x67, 0x02, 0x00, 0x00,
+	// 999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6e, 0xe3, 0xd4,
+	0x17, 0x8e, 0xdd, 0xf4, 0x4f, 0x4e, 0xfa, 0xc7, 0xbd, 0x6d, 0xf5, 0x4b, 0xfb, 0xeb, 0xa4, 0xa1,
+	0x30, 0x10, 0x8d, 0x44, 0x22, 0x3a, 0x1b, 0x84, 0x10, 0x92, 0x9b, 0xb8, 0xa9, 0x69, 0xeb, 0x54,
+	0x8e, 0x03, 0x23, 0x36, 0x57, 0x8e, 0x7d, 0xeb, 0x18, 0x12, 0xdf, 0xe0, 0x7b, 0x23, 0xb9, 0x4b,
+	0x76, 0x48, 0xb0, 0xe0, 0x11, 0x90, 0x58, 0xf1, 0x0c, 0xbc, 0x40, 0x97, 0xb3, 0x64, 0x55, 0x41,
+	0xbb, 0xe1, 0x19, 0x46, 0x2c, 0x90, 0xaf, 0xed, 0x49, 0x98, 0xa6, 0x9d, 0x4a, 0x6c, 0x2a, 0xdf,
+	0xef, 0x9c, 0xfb, 0x7d, 0xe7, 0xdc, 0x9e, 0xef, 0x28, 0xf0, 0x7f, 0x27, 0xa4, 0x8c, 0xd5, 0x1d,
+	0x1a, 0x92, 0xba, 0x3d, 0xe6, 0xfd, 0x3a, 0xbf, 0x1c, 0x11, 0x56, 0x1b, 0x85, 0x94, 0x53, 0xb4,
+	0x26, 0x82, 0xb5, 0x38, 0x58, 0x8b, 0x83, 0x3b, 0x9b, 0x1e, 0xf5, 0xa8, 0x88, 0xd5, 0xe3, 0xaf,
+	0x24, 0x6d, 0x67, 0xdb, 0xa3, 0xd4, 0x1b, 0x90, 0xba, 0x38, 0xf5, 0xc6, 0x17, 0x75, 0x3b, 0xb8,
+	0x4c, 0x42, 0xfb, 0x03, 0x58, 0x54, 0x1d, 0x87, 0x8e, 0x03, 0x8e, 0x9e, 0x80, 0xec, 0xbb, 0x25,
+	0xa9, 0x22, 0x55, 0x97, 0x0f, 0x57, 0x5e, 0x5d, 0xef, 0x15, 0xd2, 0x80, 0xde, 0x34, 0x65, 0xdf,
+	0x45, 0x9f, 0x42, 0x21, 0x96, 0xc0, 0xb1, 0x7e, 0x49, 0xae, 0x48, 0xd5, 0xe2, 0xc1, 0x76, 0xed,
+	0x0d, 0xfd, 0x9a, 0x3a, 0xe6, 0x7d, 0xeb, 0x72, 0x44, 0x0e, 0xf3, 0x57, 0xd7, 0x7b, 0x39, 0x73,
+	0xc9, 0x4e, 0xcf, 0x9f, 0xe4, 0xff, 0xfa, 0x79, 0x4f, 0xda, 0x67, 0xb0, 0x94, 0x65, 0xa0, 0x0f,
+	0x21, 0x3f, 0xa4, 0x2e, 0x11, 0x82, 0xab, 0xf7, 0x50, 0x9d, 0x51, 0x97, 0x98, 0x22, 0x0d, 0x1d,
+	0xc0, 0x02, 0x1d, 0x71, 0x9f, 0x06, 0xa9, 0xf6, 0x66, 0x2d, 0x69, 0xaa, 0x96, 0x35, 0x55, 0x53,
+	0x83, 0x4b, 0x21, 0x2b, 0x99, 0x69, 0x66, 0x2a, 0xfa, 0x0d, 0x14, 0x3b, 0xbe, 0x17, 0x90, 0xb0,
+	0x15, 0xd2, 0xf1, 0x08, 0x7d, 0x0c, 0x8b, 0x43, 0x32, 0xec, 0x91, 0x90, 0x95, 0xa4, 0xca, 0x5c,
+	0xb5, 0x78, 0x50, 0xba, 0x2b, 0x9d, 0x34, 0x9e, 0x36, 0x91, 0xa5, 0xa3, 0x5d, 0x28, 0xf0, 0x7e,
+	0x48, 0x58, 0x9f, 0x0e, 0x5c, 0x51, 0xc5, 0x8a, 0x39, 0x01, 0x52, 0xb1, 0xdf, 0x64, 0x28, 0x5a,
+	0x51, 0x5c, 0x7b, 0x87, 0xdb, 0x9c, 0xa0, 0x13, 0x58, 0x0f, 0xc9, 0xd0, 0xf6, 0x03, 0x3f, 0xf0,
+	0x30, 0x13, 0x65, 0x3c, 0x56, 0x57, 0x79, 0x7d, 0x31, 0x29, 0x9f, 0xa1, 0x16, 0xac, 0x24, 0x14,
+	0xd8, 0x8b, 0x5b, 0x61, 0x25, 0x59, 0x10, 0xed, 0xde, 0x21, 0x9a, 0xea, 0x37, 0x25, 0x5b, 0x66,
+	0x13, 0x88, 0x21, 0x0d, 0x56, 0xc5, 0xd9, 0x7d, 0x5d, 0xd2, 0xdc, 0xa3, 0x4a, 0x4a, 0xe4, 0xdd,
+	0xac, 0x1e, 0x1d, 0x94, 0x90, 0x7c, 0x3b, 0xf6, 0xc3, 0x29, 0xa2, 0xfc, 0xa3, 0x88, 0xd6, 0xb2,
+	0x7b, 0x29, 0x95, 0x78, 0xbd, 0xdc, 0xfe, 0x0f, 0x32, 0xac, 0xe9, 0x87, 0x8d, 0x18, 0xb4, 0x22,
+	0x93, 0x38, 0x34, 0x74, 0x51, 0x0b, 0xf2, 0x3c, 0xd2, 0x9b, 0xe9, 0x60, 0x3e, 0x7f, 0x75, 0xbd,
+	0x57, 0xf7, 0x7c, 0xde, 0x1f, 0xf7, 0x6a, 0x0e, 0x1d, 0xd6, 0x5d, 0x9b, 0xdb, 0x4e, 0xdf, 0xf6,
+	0x83, 0x81, 0xdd, 0xab, 0x27, 0x56, 0x89, 0x12, 0xb3, 0x24, 0x3e, 0xb1, 0x22, 0xbd, 0x69, 0x0a,
+	0x02, 0xf4, 0x14, 0x16, 0x92, 0x22, 0x4b, 0xf2, 0xac, 0x19, 0x4f, 0x83, 0xe8, 0x33, 0x58, 0x60,
+	0xdc, 0xe6, 0xe3, 0xf8, 0x4d, 0xe2, 0xc9, 0x7c, 0xff, 0x4e, 0x2b, 0x6f, 0x54, 0xd8, 0x11, 0xd9,
+	0x66, 0x7a, 0x0b, 0xbd, 0x0b, 0x2b, 0x24, 0x0c, 0x69, 0x88, 0x87, 0x84, 0x31, 0xdb, 0x23, 0xa5,
+	0x7c, 0x45, 0xaa, 0x16, 0xcc, 0x65, 0x01, 0x9e, 0x25, 0x18, 0x7a, 0x02, 0x30, 0x1e, 0xb9, 0x36,
+	0x27, 0x2e, 0xb6, 0x79, 0x69, 0xbe, 0x22, 0x55, 0xf3, 0x66, 0x21, 0x45, 0x54, 0x9e, 0xbe, 0xc6,
+	0x8f, 0x32, 0x14, 0x62, 0xa1, 0x56, 0x68, 0x07, 0x1c, 0x7d, 0x00, 0x8b, 0x5e, 0xfc, 0x41, 0xc2,
+	0xd9, 0x1e, 0xcd, 0xa2, 0x93, 0x44, 0x32, 0xbb, 0xd1, 0x2c, 0x8a, 0x3e, 0x87, 0x0d, 0xd1, 0x1a,
+	0x16, 0x0f, 0x18, 0xff, 0x0d, 0x02, 0x32, 0x28, 0xcd, 0xbd, 0xd5, 0x5f, 0xeb, 0xe2, 0x5a, 0x23,
+	0xbe, 0xd5, 0x48, 0x2e, 0xa1, 0x2a, 0x28, 0x8e, 0x3d, 0x18, 0x60, 0x3f, 0xb8, 0xa0, 0x78, 0x14,
+	0x92, 0x0b, 0x3f, 0x12, 0x8d, 0x2f, 0x9b, 0xab, 0x31, 0xae, 0x07, 0x17, 0xf4, 0x5c, 0xa0, 0xe8,
+	0x23, 0xd8, 0x24, 0xd1, 0xc8, 0x0f, 0xed, 0xd8, 0xa2, 0x98, 0xfb, 0x43, 0xc2, 0xb8, 0x3d, 0x1c,
+	0xa5, 0x8f, 0xb0, 0x31, 0x89, 0x59, 0x59, 0x28, 0x7d, 0x8e, 0x5f, 0x25, 0xd8, 0xd2, 0x5d, 0x12,
+	0x70, 0xff, 0xc2, 0x27, 0xee, 0xb4, 0xc9, 0x8e, 0x61, 0x9e, 0x47, 0xd8, 0x77, 0xff, 0xeb, 0x8c,
+	0xb8, 0xe8, 0x08, 0x56, 0x78, 0x84, 0xc5, 0x9e, 0x8b, 0xff, 0x9d, 0xd9, 0xa2, 0xbb, 0xeb, 0xb0,
+	0x29, 0xf9, 0x74, 0xa4, 0x8b, 0x7c, 0x02, 0xed, 0xff, 0x2d, 0xc1, 0x72, 0x8b, 0x04, 0x84, 0xf9,
+	0x2c, 0x29, 0xd1, 0x84, 0xd5, 0x7f, 0x11, 0x67, 0x4b, 0x60, 0xc6, 0x74, 0xcd, 0x6a, 0x31, 0x73,
+	0xf1, 0x94, 0x06, 0x43, 0x5d, 0xd8, 0xf0, 0x7b, 0x8e, 0x70, 0x1e, 0xe6, 0x11, 0x0e, 0xc5, 0x34,
+	0x66, 0x4b, 0xa1, 0xf2, 0xb6, 0xb1, 0xcd, 0xb6, 0x8c, 0xdf, 0x73, 0xa6, 0x61, 0x86, 0x54, 0x28,
+	0x0a, 0x4a, 0x31, 0x26, 0xd9, 0x66, 0xd8, 0x99, 0xb9, 0x63, 0xc4, 0x64, 0xa6, 0x44, 0xc0, 0x32,
+	0x80, 0x3d, 0xfb, 0x1a, 0x96, 0xb2, 0xf5, 0x8d, 0xb6, 0x61, 0x4b, 0xed, 0x5a, 0xc7, 0xf8, 0xac,
+	0xdd, 0xd4, 0x70, 0xd7, 0xe8, 0x9c, 0x6b, 0x0d, 0xfd, 0x48, 0xd7, 0x9a, 0x4a, 0x0e, 0x6d, 0xc0,
+	0xda, 0x24, 0x74, 0xda, 0x6e, 0xa8, 0xa7, 0x8a, 0x84, 0xb6, 0x60, 0x7d, 0x02, 0x36, 0x8e, 0x55,
+	0xc3, 0xd0, 0x4e, 0x15, 0x19, 0xfd, 0x0f, 0x36, 0x26, 0xb0, 0xf6, 0xc2, 0xd2, 0x8c, 0x8e, 0xde,
+	0x36, 0x94, 0xb9, 0x67, 0xdf, 0xc9, 0xb0, 0x35, 0xd3, 0x91, 0xe8, 0x29, 0xbc, 0xa3, 0x1f, 0x36,
+	0x70, 0x47, 0x6f, 0x19, 0xd8, 0x7a, 0x81, 0x4d, 0xad, 0xd1, 0x36, 0x9b, 0xb8, 0x63, 0xa9, 0x56,
+	0xb7, 0x83, 0xbb, 0xc6, 0x89, 0xd1, 0xfe, 0xd2, 0x50, 0x72, 0x0f, 0xa7, 0x9d, 0x6b, 0x46, 0x53,
+	0x37, 0x5a, 0x8a, 0x84, 0x2a, 0xb0, 0x7b, 0x7f, 0x5a, 0xfb, 0x44, 0x91, 0xd1, 0x7b, 0x50, 0xb9,
+	0x3f, 0xe3, 0x48, 0xd5, 0x4f, 0xb5, 0xa6, 0x32, 0xf7, 0xb0, 0x9c, 0xa5, 0x9f, 0x69, 0xed, 0xae,
+	0xa5, 0xe4, 0x1f, 0x4e, 0x33, 0xb5, 0x2f, 0xda, 0x27, 0x5a, 0x53, 0x99, 0xdf, 0xc9, 0x7f, 0xff,
+	0x4b, 0x39, 0x77, 0x78, 0x76, 0xf5, 0x67, 0x39, 0x77, 0x75, 0x53, 0x96, 0x5e, 0xde, 0x94, 0xa5,
+	0x3f, 0x6e, 0xca, 0xd2, 0x4f, 0xb7, 0xe5, 0xdc, 0xcb, 0xdb, 0x72, 0xee, 0xf7, 0xdb, 0x72, 0xee,
+	0xab, 0xc7, 0x79, 0x61, 0xf2, 0xe3, 0xa2, 0xb7, 0x20, 0x3c, 0xff, 0xfc, 0x9f, 0x01, 0x00, 0x06,
+	0x83, 0xb6, 0x11, 0x7c, 0x08, 0x00, 0x00,
 }
 
 func (this *Account) Equal(that interface{}) bool {
@@ -264,6 +565,38 @@ func (this *AuthType) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SignerGroup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SignerGroup)
+	if !ok {
+		that2, ok := that.(SignerGroup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Members) != len(that1.Members) {
+		return false
+	}
+	for i := range this.Members {
+		if !this.Members[i].Equal(&that1.Members[i]) {
+			return false
+		}
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	return true
+}
 func (m *Account) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -344,7 +677,7 @@ func (m *AuthType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TxAuthState) Marshal() (dAtA []byte, err error) {
+func (m *SignerGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -354,20 +687,25 @@ func (m *TxAuthState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxAuthState) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignerGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxAuthState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RemainingSigners) > 0 {
-		for iNdEx := len(m.RemainingSigners) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Threshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RemainingSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -381,70 +719,1216 @@ func (m *TxAuthState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TxAuthState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Account) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.AuthType.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+
+func (m *TxAuthState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AuthType) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TxAuthState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Mode != 0 {
-		n += 1 + sovTypes(uint64(m.Mode))
+	if len(m.RequiredSigners) > 0 {
+		for iNdEx := len(m.RequiredSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Option != nil {
-		l = m.Option.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.SignedSigners) > 0 {
+		for iNdEx := len(m.SignedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignedSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
-}
-
-func (m *TxAuthState) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SignerGroups) > 0 {
+		for iNdEx := len(m.SignerGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
 	if len(m.RemainingSigners) > 0 {
-		for _, e := range m.RemainingSigners {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+		for iNdEx := len(m.RemainingSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Account) Unmarshal(dAtA []byte) error {
+func (m *IBCSignTxRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCSignTxRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCSignTxRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CallInfoPrefix) > 0 {
+		i -= len(m.CallInfoPrefix)
+		copy(dAtA[i:], m.CallInfoPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CallInfoPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CrossChainChannel != nil {
+		{
+			size, err := m.CrossChainChannel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedTxAuthState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedTxAuthState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedTxAuthState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TxAuthState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignGrants) > 0 {
+		for iNdEx := len(m.SignGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.IbcSignTxRecords) > 0 {
+		for iNdEx := len(m.IbcSignTxRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcSignTxRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxAuthStates) > 0 {
+		for iNdEx := len(m.TxAuthStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxAuthStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.AuthType.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *AuthType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovTypes(uint64(m.Mode))
+	}
+	if m.Option != nil {
+		l = m.Option.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTypes(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *TxAuthState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemainingSigners) > 0 {
+		for _, e := range m.RemainingSigners {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.SignerGroups) > 0 {
+		for _, e := range m.SignerGroups {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.SignedSigners) > 0 {
+		for _, e := range m.SignedSigners {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RequiredSigners) > 0 {
+		for _, e := range m.RequiredSigners {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IBCSignTxRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTypes(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *SignGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CrossChainChannel != nil {
+		l = m.CrossChainChannel.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CallInfoPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovTypes(uint64(m.ExpirationTimestamp))
+	}
+	return n
+}
+
+func (m *IdentifiedTxAuthState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.TxAuthState.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxAuthStates) > 0 {
+		for _, e := range m.TxAuthStates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.IbcSignTxRecords) > 0 {
+		for _, e := range m.IbcSignTxRecords {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.SignGrants) > 0 {
+		for _, e := range m.SignGrants {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= AuthMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Option == nil {
+				m.Option = &types.Any{}
+			}
+			if err := m.Option.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, Account{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxAuthState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxAuthState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxAuthState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingSigners = append(m.RemainingSigners, Account{})
+			if err := m.RemainingSigners[len(m.RemainingSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerGroups = append(m.SignerGroups, SignerGroup{})
+			if err := m.SignerGroups[len(m.SignerGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedSigners = append(m.SignedSigners, Account{})
+			if err := m.SignedSigners[len(m.SignedSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredSigners = append(m.RequiredSigners, Account{})
+			if err := m.RequiredSigners[len(m.RequiredSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCSignTxRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCSignTxRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCSignTxRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= IBCSignTxRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -467,15 +1951,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -502,14 +1986,48 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
-			if m.Id == nil {
-				m.Id = []byte{}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = append(m.Grantee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Grantee == nil {
+				m.Grantee = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -536,10 +2054,66 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CrossChainChannel == nil {
+				m.CrossChainChannel = &types.Any{}
+			}
+			if err := m.CrossChainChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallInfoPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallInfoPrefix = append(m.CallInfoPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallInfoPrefix == nil {
+				m.CallInfoPrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -564,7 +2138,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthType) Unmarshal(dAtA []byte) error {
+func (m *IdentifiedTxAuthState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -587,17 +2161,17 @@ func (m *AuthType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthType: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedTxAuthState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedTxAuthState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			m.Mode = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -607,14 +2181,29 @@ func (m *AuthType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= AuthMode(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -641,10 +2230,7 @@ func (m *AuthType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Option == nil {
-				m.Option = &types.Any{}
-			}
-			if err := m.Option.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxAuthState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -672,7 +2258,7 @@ func (m *AuthType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxAuthState) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -695,15 +2281,15 @@ func (m *TxAuthState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxAuthState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxAuthState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSigners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthStates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -730,8 +2316,76 @@ func (m *TxAuthState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemainingSigners = append(m.RemainingSigners, Account{})
-			if err := m.RemainingSigners[len(m.RemainingSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TxAuthStates = append(m.TxAuthStates, IdentifiedTxAuthState{})
+			if err := m.TxAuthStates[len(m.TxAuthStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSignTxRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSignTxRecords = append(m.IbcSignTxRecords, IBCSignTxRecord{})
+			if err := m.IbcSignTxRecords[len(m.IbcSignTxRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignGrants = append(m.SignGrants, SignGrant{})
+			if err := m.SignGrants[len(m.SignGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
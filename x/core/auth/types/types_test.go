@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxAuthStateWithSignerGroups(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		acc0 = NewLocalAccount(AccountID("acc0"))
+		acc1 = NewLocalAccount(AccountID("acc1"))
+		acc2 = NewLocalAccount(AccountID("acc2"))
+		acc3 = NewLocalAccount(AccountID("acc3"))
+		acc4 = NewLocalAccount(AccountID("acc4"))
+	)
+
+	require.Error(NewSignerGroup([]Account{acc1, acc2}, 0).ValidateBasic())
+	require.Error(NewSignerGroup([]Account{acc1, acc2}, 3).ValidateBasic())
+	require.Error(NewSignerGroup([]Account{acc1, acc1}, 1).ValidateBasic())
+	require.NoError(NewSignerGroup([]Account{acc1, acc2, acc3}, 2).ValidateBasic())
+
+	// acc0 and 2-of-3 of {acc1, acc2, acc3} are required
+	state := NewTxAuthState([]Account{acc0}, []SignerGroup{NewSignerGroup([]Account{acc1, acc2, acc3}, 2)})
+	require.False(state.IsCompleted())
+	require.Equal([]Account{acc0, acc1, acc2, acc3}, state.RemainingAccounts())
+
+	// an account that is not required is never consumed
+	require.False(state.ConsumeSigners([]Account{acc4}))
+	require.Len(state.SignedSigners, 0)
+
+	require.True(state.ConsumeSigners([]Account{acc1}))
+	require.False(state.IsCompleted())
+	// a duplicate sign is ignored
+	require.False(state.ConsumeSigners([]Account{acc1}))
+	require.Equal([]Account{acc1}, state.SignedSigners)
+
+	require.True(state.ConsumeSigners([]Account{acc0, acc3}))
+	require.True(state.IsCompleted())
+	require.Len(state.RemainingAccounts(), 0)
+	require.Equal([]Account{acc1, acc0, acc3}, state.SignedSigners)
+	require.Equal([]Account{acc1, acc3}, state.SignerGroups[0].SignedMembers(state.SignedSigners))
+}
+
+func TestTxAuthStateRevokeSigners(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		acc0 = NewLocalAccount(AccountID("acc0"))
+		acc1 = NewLocalAccount(AccountID("acc1"))
+		acc2 = NewLocalAccount(AccountID("acc2"))
+	)
+
+	// acc0 and 1-of-2 of {acc1, acc2} are required
+	state := NewTxAuthState([]Account{acc0}, []SignerGroup{NewSignerGroup([]Account{acc1, acc2}, 1)})
+	// nothing is revoked before signing
+	require.False(state.RevokeSigners([]Account{acc0}))
+
+	require.True(state.ConsumeSigners([]Account{acc0, acc1}))
+	require.True(state.IsCompleted())
+
+	// a revoked group member no longer counts toward the threshold
+	require.True(state.RevokeSigners([]Account{acc1}))
+	require.False(state.IsCompleted())
+	require.Equal([]Account{acc0}, state.SignedSigners)
+	require.Len(state.RemainingSigners, 0)
+
+	// a revoked individual signer is put back into the remaining signers
+	require.True(state.RevokeSigners([]Account{acc0}))
+	require.Equal([]Account{acc0}, state.RemainingSigners)
+	require.Len(state.SignedSigners, 0)
+
+	require.True(state.ConsumeSigners([]Account{acc0, acc2}))
+	require.True(state.IsCompleted())
+}
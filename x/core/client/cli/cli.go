@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/datachainlab/cross/x/core/auth"
 	"github.com/datachainlab/cross/x/core/initiator"
+	txcli "github.com/datachainlab/cross/x/core/tx/client/cli"
 	"github.com/datachainlab/cross/x/core/types"
 )
 
@@ -41,6 +42,7 @@ func GetQueryCmd() *cobra.Command {
 	ibcQueryCmd.AddCommand(
 		initiator.GetQueryCmd(),
 		auth.GetQueryCmd(),
+		txcli.GetParamsCmd(),
 	)
 
 	return ibcQueryCmd
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,14 +15,14 @@ type contractManager struct {
 	cdc      codec.Codec
 	storeKey sdk.StoreKey
 
-	mod                     types.ContractModule
-	commitStore             types.CommitStoreI
+	contractResolver        types.ContractResolver
 	resolverProvider        txtypes.CallResolverProvider
 	contractHandleDecorator types.ContractHandleDecorator
 }
 
 var _ txtypes.ContractManager = (*contractManager)(nil)
 
+// NewContractManager returns a ContractManager that handles all contract calls with a given contract module
 func NewContractManager(
 	cdc codec.Codec,
 	storeKey sdk.StoreKey,
@@ -29,12 +30,26 @@ func NewContractManager(
 	commitStore types.CommitStoreI,
 	contractHandleDecorator types.ContractHandleDecorator,
 ) txtypes.ContractManager {
+	return NewContractManagerWithResolver(
+		cdc,
+		storeKey,
+		types.NewSingleContractResolver(mod, commitStore),
+		contractHandleDecorator,
+	)
+}
 
+// NewContractManagerWithResolver returns a ContractManager that handles contract calls with the contract modules resolved by a given resolver.
+// A ContractRouter can be used to host multiple contract modules.
+func NewContractManagerWithResolver(
+	cdc codec.Codec,
+	storeKey sdk.StoreKey,
+	contractResolver types.ContractResolver,
+	contractHandleDecorator types.ContractHandleDecorator,
+) txtypes.ContractManager {
 	return contractManager{
 		cdc:                     cdc,
 		storeKey:                storeKey,
-		mod:                     mod,
-		commitStore:             commitStore,
+		contractResolver:        contractResolver,
 		resolverProvider:        txtypes.DefaultCallResolverProvider(),
 		contractHandleDecorator: contractHandleDecorator,
 	}
@@ -46,24 +61,34 @@ func (k contractManager) PrepareCommit(
 	txIndex crosstypes.TxIndex,
 	tx txtypes.ResolvedContractTransaction,
 ) (*txtypes.ContractCallResult, error) {
-	ctx, err := k.setupContext(ctx, tx, types.AtomicMode)
+	contractID, callInfo, err := k.contractResolver.ResolveContract(tx.CallInfo)
+	if err != nil {
+		return nil, err
+	}
+	mod, store, err := k.contractResolver.GetContract(contractID)
 	if err != nil {
 		return nil, err
 	}
-	res, err := k.processTransaction(ctx, tx)
+	ctx, err = k.setupContext(ctx, tx, callInfo, types.AtomicMode)
 	if err != nil {
 		return nil, err
 	}
-	if err := k.commitStore.Precommit(ctx, makeContractTransactionID(txID, txIndex)); err != nil {
+	res, err := k.processTransaction(ctx, mod, tx, callInfo)
+	if err != nil {
+		return nil, err
+	}
+	if err := store.Precommit(ctx, makeContractTransactionID(txID, txIndex)); err != nil {
 		return nil, err
 	}
 	k.setContractCallResult(ctx, txID, txIndex, *res)
+	k.setContractID(ctx, txID, txIndex, contractID)
 	return res, nil
 }
 
 func (k contractManager) setupContext(
 	ctx sdk.Context,
 	tx txtypes.ResolvedContractTransaction,
+	callInfo txtypes.ContractCallInfo,
 	commitMode types.CommitMode,
 ) (sdk.Context, error) {
 	rs, err := k.resolverProvider(k.cdc, tx.UnpackCallResults(k.cdc))
@@ -79,7 +104,7 @@ func (k contractManager) setupContext(
 			ExternalCallResolver: rs,
 		},
 	)
-	goCtx, err := k.contractHandleDecorator.Handle(ctx.Context(), tx.CallInfo)
+	goCtx, err := k.contractHandleDecorator.Handle(ctx.Context(), callInfo)
 	if err != nil {
 		return ctx, err
 	}
@@ -88,7 +113,9 @@ func (k contractManager) setupContext(
 
 func (k contractManager) processTransaction(
 	ctx sdk.Context,
+	mod types.ContractModule,
 	tx txtypes.ResolvedContractTransaction,
+	callInfo txtypes.ContractCallInfo,
 ) (res *txtypes.ContractCallResult, err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -100,10 +127,10 @@ func (k contractManager) processTransaction(
 		}
 	}()
 
-	res, err = k.mod.OnContractCall(
+	res, err = mod.OnContractCall(
 		sdk.WrapSDKContext(ctx),
 		tx.Signers,
-		tx.CallInfo,
+		callInfo,
 	)
 	if err != nil {
 		return nil, err
@@ -122,15 +149,23 @@ func (k contractManager) CommitImmediately(
 	txIndex crosstypes.TxIndex,
 	tx txtypes.ResolvedContractTransaction,
 ) (*txtypes.ContractCallResult, error) {
-	ctx, err := k.setupContext(ctx, tx, types.BasicMode)
+	contractID, callInfo, err := k.contractResolver.ResolveContract(tx.CallInfo)
+	if err != nil {
+		return nil, err
+	}
+	mod, store, err := k.contractResolver.GetContract(contractID)
 	if err != nil {
 		return nil, err
 	}
-	res, err := k.processTransaction(ctx, tx)
+	ctx, err = k.setupContext(ctx, tx, callInfo, types.BasicMode)
 	if err != nil {
 		return nil, err
 	}
-	k.commitStore.CommitImmediately(ctx)
+	res, err := k.processTransaction(ctx, mod, tx, callInfo)
+	if err != nil {
+		return nil, err
+	}
+	store.CommitImmediately(ctx)
 	return res, nil
 }
 
@@ -140,12 +175,21 @@ func (k contractManager) Commit(
 	txID crosstypes.TxID,
 	txIndex crosstypes.TxIndex,
 ) (*txtypes.ContractCallResult, error) {
-	if err := k.commitStore.Commit(ctx, makeContractTransactionID(txID, txIndex)); err != nil {
+	mod, store, err := k.contractResolver.GetContract(k.getContractID(ctx, txID, txIndex))
+	if err != nil {
+		return nil, err
+	}
+	if err := store.Commit(ctx, makeContractTransactionID(txID, txIndex)); err != nil {
 		return nil, err
 	}
 	res := k.getContractCallResult(ctx, txID, txIndex)
-	// TODO calls OnCommit handler
+	if hooks, ok := mod.(types.ContractModuleWithHooks); ok {
+		if err := hooks.OnCommit(sdk.WrapSDKContext(ctx), txID, txIndex, res); err != nil {
+			return nil, err
+		}
+	}
 	k.removeContractCallResult(ctx, txID, txIndex)
+	k.removeContractID(ctx, txID, txIndex)
 	return res, nil
 }
 
@@ -155,10 +199,23 @@ func (k contractManager) Abort(
 	txID crosstypes.TxID,
 	txIndex crosstypes.TxIndex,
 ) error {
-	if err := k.commitStore.Abort(ctx, makeContractTransactionID(txID, txIndex)); err != nil {
+	contractID := k.getContractID(ctx, txID, txIndex)
+	mod, store, err := k.contractResolver.GetContract(contractID)
+	if contractID == "" && errors.Is(err, types.ErrContractNotFound) {
+		// NOTE: the contract ID isn't stored if the transaction failed to prepare, so there is nothing to abort
+		return nil
+	} else if err != nil {
 		return err
 	}
-	// TODO calls OnAbort handler
+	if err := store.Abort(ctx, makeContractTransactionID(txID, txIndex)); err != nil {
+		return err
+	}
+	if hooks, ok := mod.(types.ContractModuleWithHooks); ok {
+		if err := hooks.OnAbort(sdk.WrapSDKContext(ctx), txID, txIndex); err != nil {
+			return err
+		}
+	}
 	k.removeContractCallResult(ctx, txID, txIndex)
+	k.removeContractID(ctx, txID, txIndex)
 	return nil
 }
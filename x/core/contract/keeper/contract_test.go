@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/datachainlab/cross/simapp/samplemod"
+	samplemodkeeper "github.com/datachainlab/cross/simapp/samplemod/keeper"
+	samplemodtypes "github.com/datachainlab/cross/simapp/samplemod/types"
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
+	contractkeeper "github.com/datachainlab/cross/x/core/contract/keeper"
+	contracttypes "github.com/datachainlab/cross/x/core/contract/types"
+	storekeeper "github.com/datachainlab/cross/x/core/store/keeper"
+	storetypes "github.com/datachainlab/cross/x/core/store/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	ibctesting "github.com/datachainlab/cross/x/ibc/testing"
+)
+
+type ContractManagerTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+}
+
+func (suite *ContractManagerTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chain = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+}
+
+func (suite *ContractManagerTestSuite) TestContractRouter() {
+	app := suite.chain.App
+	storeKey := app.GetKey(crosstypes.StoreKey)
+
+	// setup two contract modules, each with its own store
+	newContract := func(prefix string) (contracttypes.ContractModule, contracttypes.CommitStoreI) {
+		xstore := storekeeper.NewStore(app.AppCodec(), crosstypes.NewPrefixStoreKey(storeKey, []byte(prefix)))
+		k := samplemodkeeper.NewKeeper(app.AppCodec(), app.GetKey(samplemodtypes.StoreKey), xstore)
+		return samplemod.NewAppModule(k), xstore
+	}
+	modA, storeA := newContract("test/a")
+	modB, storeB := newContract("test/b")
+	router := contracttypes.NewContractRouter().
+		AddRoute("a", modA, storeA).
+		AddRoute("b", modB, storeB)
+	cm := contractkeeper.NewContractManagerWithResolver(
+		app.AppCodec(),
+		crosstypes.NewPrefixStoreKey(storeKey, []byte("test/cmanager")),
+		router,
+		storetypes.DefaultContractHandleDecorators(),
+	)
+
+	makeTx := func(contractID string) txtypes.ResolvedContractTransaction {
+		return txtypes.ResolvedContractTransaction{
+			Signers: []authtypes.Account{
+				authtypes.NewLocalAccount(authtypes.AccountID(suite.chain.SenderAccount.GetAddress())),
+			},
+			CallInfo: contracttypes.NewContractCallInfoEnvelope(
+				contractID,
+				samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(app.AppCodec()),
+			),
+		}
+	}
+
+	ctx := suite.chain.GetContext()
+
+	// a prepared transaction is committed to the store of the contract "a"
+	txID := []byte("txid-0")
+	_, err := cm.PrepareCommit(ctx, txID, 0, makeTx("a"))
+	suite.Require().NoError(err)
+	res, err := cm.Commit(ctx, txID, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(1), res.GetData())
+
+	res, err = cm.CommitImmediately(ctx, []byte("txid-1"), 0, makeTx("a"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(2), res.GetData())
+
+	// the contract "b" doesn't share the state with the contract "a"
+	res, err = cm.CommitImmediately(ctx, []byte("txid-2"), 0, makeTx("b"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(1), res.GetData())
+
+	// a prepared transaction of the contract "b" is aborted
+	txID = []byte("txid-3")
+	_, err = cm.PrepareCommit(ctx, txID, 0, makeTx("b"))
+	suite.Require().NoError(err)
+	suite.Require().NoError(cm.Abort(ctx, txID, 0))
+	res, err = cm.CommitImmediately(ctx, []byte("txid-4"), 0, makeTx("b"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Uint64ToBigEndian(2), res.GetData())
+
+	// an unknown contract
+	_, err = cm.PrepareCommit(ctx, []byte("txid-5"), 0, makeTx("c"))
+	suite.Require().ErrorIs(err, contracttypes.ErrContractNotFound)
+	_, err = cm.CommitImmediately(ctx, []byte("txid-6"), 0, makeTx("c"))
+	suite.Require().ErrorIs(err, contracttypes.ErrContractNotFound)
+
+	// a call info that isn't an envelope
+	tx := makeTx("a")
+	tx.CallInfo = samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(app.AppCodec())
+	_, err = cm.PrepareCommit(ctx, []byte("txid-7"), 0, tx)
+	suite.Require().Error(err)
+
+	// aborting a transaction that isn't prepared is a no-op
+	suite.Require().NoError(cm.Abort(ctx, []byte("txid-5"), 0))
+}
+
+func TestContractManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(ContractManagerTestSuite))
+}
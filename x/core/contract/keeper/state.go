@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/datachainlab/cross/x/core/contract/types"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
 	crosstypes "github.com/datachainlab/cross/x/core/types"
+	"github.com/datachainlab/cross/x/utils"
 )
 
 // TODO use channelInfo to create a key
@@ -32,6 +35,57 @@ func (k contractManager) removeContractCallResult(ctx sdk.Context, txID crosstyp
 	k.store(ctx).Delete(types.KeyContractCallResult(txID, txIndex))
 }
 
+// setContractID sets the store to the contract ID that handles the transaction
+func (k contractManager) setContractID(ctx sdk.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex, contractID string) {
+	// NOTE: an empty contract ID indicates the default contract, so it doesn't need to be stored
+	if contractID == "" {
+		return
+	}
+	k.store(ctx).Set(types.KeyContractID(txID, txIndex), []byte(contractID))
+}
+
+// getContractID returns the contract ID that handles the transaction
+func (k contractManager) getContractID(ctx sdk.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex) string {
+	return string(k.store(ctx).Get(types.KeyContractID(txID, txIndex)))
+}
+
+// removeContractID removes the contract ID from store
+func (k contractManager) removeContractID(ctx sdk.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex) {
+	k.store(ctx).Delete(types.KeyContractID(txID, txIndex))
+}
+
+// GetContractCallResults implements ContractManager.GetContractCallResults
+func (k contractManager) GetContractCallResults(ctx sdk.Context) ([]txtypes.IdentifiedContractCallResult, error) {
+	store := prefix.NewStore(k.store(ctx), types.KeyPrefixBytes(types.KeyContractCallResultPrefix))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var results []txtypes.IdentifiedContractCallResult
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) < 4 {
+			return nil, fmt.Errorf("invalid key length: %v", len(key))
+		}
+		var result txtypes.ContractCallResult
+		k.cdc.MustUnmarshal(iter.Value(), &result)
+		txID := append(crosstypes.TxID{}, key[:len(key)-4]...)
+		txIndex := utils.BigEndianToUint32(key[len(key)-4:])
+		results = append(results, txtypes.IdentifiedContractCallResult{
+			TxId:       txID,
+			TxIndex:    txIndex,
+			ContractId: k.getContractID(ctx, txID, txIndex),
+			Result:     result,
+		})
+	}
+	return results, nil
+}
+
+// SetContractCallResult implements ContractManager.SetContractCallResult
+func (k contractManager) SetContractCallResult(ctx sdk.Context, result txtypes.IdentifiedContractCallResult) {
+	k.setContractCallResult(ctx, result.TxId, result.TxIndex, result.Result)
+	k.setContractID(ctx, result.TxId, result.TxIndex, result.ContractId)
+}
+
 func (k contractManager) store(ctx sdk.Context) sdk.KVStore {
 	switch storeKey := k.storeKey.(type) {
 	case *crosstypes.PrefixStoreKey:
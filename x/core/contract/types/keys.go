@@ -13,6 +13,7 @@ const (
 
 const (
 	KeyContractCallResultPrefix uint8 = iota
+	KeyContractIDPrefix
 )
 
 // KeyPrefixBytes return the key prefix bytes from a URL string format
@@ -29,3 +30,13 @@ func KeyContractCallResult(txID crosstypes.TxID, txIndex crosstypes.TxIndex) []b
 		utils.Uint32ToBigEndian(txIndex)...,
 	)
 }
+
+func KeyContractID(txID crosstypes.TxID, txIndex crosstypes.TxIndex) []byte {
+	return append(
+		append(
+			KeyPrefixBytes(KeyContractIDPrefix),
+			txID[:]...,
+		),
+		utils.Uint32ToBigEndian(txIndex)...,
+	)
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// ErrContractNotFound is returned when the contract that a call info indicates is not registered
+var ErrContractNotFound = errors.New("contract not found")
+
+// ContractResolver resolves the contract module that handles a given ContractCallInfo
+type ContractResolver interface {
+	// ResolveContract returns the contract ID and the call info that is passed to the contract module
+	ResolveContract(callInfo txtypes.ContractCallInfo) (contractID string, contractCallInfo txtypes.ContractCallInfo, err error)
+	// GetContract returns the contract module and its commit store for a given contract ID
+	GetContract(contractID string) (ContractModule, CommitStoreI, error)
+}
+
+type singleContractResolver struct {
+	mod   ContractModule
+	store CommitStoreI
+}
+
+var _ ContractResolver = (*singleContractResolver)(nil)
+
+// NewSingleContractResolver returns a ContractResolver that routes all calls to a given contract module
+func NewSingleContractResolver(mod ContractModule, store CommitStoreI) ContractResolver {
+	return singleContractResolver{mod: mod, store: store}
+}
+
+func (r singleContractResolver) ResolveContract(callInfo txtypes.ContractCallInfo) (string, txtypes.ContractCallInfo, error) {
+	return "", callInfo, nil
+}
+
+func (r singleContractResolver) GetContract(contractID string) (ContractModule, CommitStoreI, error) {
+	if contractID != "" {
+		return nil, nil, fmt.Errorf("%w: contract_id='%v'", ErrContractNotFound, contractID)
+	}
+	return r.mod, r.store, nil
+}
+
+// ContractRouter is a ContractResolver that routes a ContractCallInfoEnvelope to the contract module registered with its contract ID.
+// Each contract module should have its own CommitStore.
+type ContractRouter interface {
+	ContractResolver
+	AddRoute(contractID string, mod ContractModule, store CommitStoreI) ContractRouter
+}
+
+type contractRoute struct {
+	mod   ContractModule
+	store CommitStoreI
+}
+
+type contractRouter struct {
+	routes map[string]contractRoute
+}
+
+var _ ContractRouter = (*contractRouter)(nil)
+
+// NewContractRouter creates a new ContractRouter
+func NewContractRouter() ContractRouter {
+	return &contractRouter{routes: make(map[string]contractRoute)}
+}
+
+func (r *contractRouter) AddRoute(contractID string, mod ContractModule, store CommitStoreI) ContractRouter {
+	if contractID == "" {
+		panic("contract ID cannot be empty")
+	} else if mod == nil || store == nil {
+		panic(fmt.Sprintf("contract module and store cannot be nil: contract_id='%v'", contractID))
+	} else if _, ok := r.routes[contractID]; ok {
+		panic(fmt.Sprintf("contract '%v' already exists", contractID))
+	}
+	r.routes[contractID] = contractRoute{mod: mod, store: store}
+	return r
+}
+
+func (r contractRouter) ResolveContract(callInfo txtypes.ContractCallInfo) (string, txtypes.ContractCallInfo, error) {
+	var env ContractCallInfoEnvelope
+	if err := proto.Unmarshal(callInfo, &env); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal ContractCallInfoEnvelope: %v", err)
+	}
+	if _, _, err := r.GetContract(env.ContractId); err != nil {
+		return "", nil, err
+	}
+	return env.ContractId, env.CallInfo, nil
+}
+
+func (r contractRouter) GetContract(contractID string) (ContractModule, CommitStoreI, error) {
+	route, ok := r.routes[contractID]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: contract_id='%v'", ErrContractNotFound, contractID)
+	}
+	return route.mod, route.store, nil
+}
+
+// NewContractCallInfoEnvelope creates a new ContractCallInfo that is routed to a given contract ID
+func NewContractCallInfoEnvelope(contractID string, callInfo txtypes.ContractCallInfo) txtypes.ContractCallInfo {
+	bz, err := proto.Marshal(&ContractCallInfoEnvelope{ContractId: contractID, CallInfo: callInfo})
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
@@ -7,6 +7,7 @@ import (
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 )
 
@@ -14,6 +15,16 @@ type ContractModule interface {
 	OnContractCall(ctx context.Context, signers []authtypes.Account, callInfo txtypes.ContractCallInfo) (*txtypes.ContractCallResult, error)
 }
 
+// ContractModuleWithHooks is a ContractModule that is notified when its prepared transaction is finalized.
+// The hooks can emit events and write to the non-cross state, but they must not access the cross store.
+type ContractModuleWithHooks interface {
+	ContractModule
+	// OnCommit is called after the transaction is committed
+	OnCommit(ctx context.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex, result *txtypes.ContractCallResult) error
+	// OnAbort is called after the transaction is aborted
+	OnAbort(ctx context.Context, txID crosstypes.TxID, txIndex crosstypes.TxIndex) error
+}
+
 type ContractHandler func(ctx context.Context, signers []authtypes.Account, callInfo txtypes.ContractCallInfo) (*txtypes.ContractCallResult, error)
 
 type ContractHandleDecorator interface {
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
+	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+)
+
+// NewGenesisState creates a new cross module GenesisState instance.
+func NewGenesisState(initiator initiatortypes.GenesisState, auth authtypes.GenesisState, xcc xcctypes.GenesisState, params txtypes.Params) *GenesisState {
+	return &GenesisState{
+		Initiator: initiator,
+		Auth:      auth,
+		Xcc:       xcc,
+		Params:    params,
+	}
+}
+
+// DefaultGenesis returns a GenesisState instance
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(
+		*initiatortypes.DefaultGenesis(),
+		*authtypes.DefaultGenesis(),
+		*xcctypes.DefaultGenesis(),
+		txtypes.DefaultParams(),
+	)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+// Every auth state must belong to a tx that is initiated on this chain.
+func (gs GenesisState) Validate() error {
+	if err := gs.Initiator.Validate(); err != nil {
+		return err
+	} else if err := gs.Auth.Validate(); err != nil {
+		return err
+	} else if err := gs.Xcc.Validate(); err != nil {
+		return err
+	} else if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	txIDs := make(map[string]bool)
+	for _, s := range gs.Initiator.TxStates {
+		txIDs[string(s.TxId)] = true
+	}
+	for _, s := range gs.Auth.TxAuthStates {
+		if !txIDs[string(s.TxId)] {
+			return fmt.Errorf("tx auth state doesn't belong to any initiated txs: txID=%x", s.TxId)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package core_test
+
+import (
+	"math"
+
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
+	genesistypes "github.com/datachainlab/cross/x/core/genesis/types"
+	storekeeper "github.com/datachainlab/cross/x/core/store/keeper"
+	storetypes "github.com/datachainlab/cross/x/core/store/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	ibctesting "github.com/datachainlab/cross/x/ibc/testing"
+)
+
+// testGenesis exports the cross states of a given chain and imports them into a new chain.
+// It checks if the states exported from the new chain equal to the original ones.
+func (suite *CrossTestSuite) testGenesis(src *ibctesting.TestChain) {
+	cdc := src.App.AppCodec()
+	newStore := func(chain *ibctesting.TestChain) storekeeper.CommitKVStore {
+		return storekeeper.NewStore(
+			chain.App.AppCodec(),
+			crosstypes.NewPrefixStoreKey(chain.App.GetKey(crosstypes.StoreKey), crosstypes.ContractStoreKeyPrefix),
+		)
+	}
+
+	ctx := src.GetContext()
+	crossGS, err := src.App.CrossKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(crossGS.Validate())
+	atomicGS, err := src.App.AtomicKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(atomicGS.Validate())
+	storeGS, err := newStore(src).ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(storeGS.Validate())
+
+	// the states must be preserved through the JSON encoding
+	var (
+		crossGS2  genesistypes.GenesisState
+		atomicGS2 atomictypes.GenesisState
+		storeGS2  storetypes.GenesisState
+	)
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(crossGS), &crossGS2)
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(atomicGS), &atomicGS2)
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(storeGS), &storeGS2)
+
+	dst := ibctesting.NewCoordinator(suite.T(), 1).GetChain(ibctesting.GetChainID(0))
+	dstCtx := dst.GetContext()
+	suite.Require().NotPanics(func() {
+		dst.App.CrossKeeper.InitGenesis(dstCtx, crossGS2)
+	})
+	suite.Require().NoError(dst.App.AtomicKeeper.InitGenesis(dstCtx, atomicGS2))
+	suite.Require().NoError(newStore(dst).InitGenesis(dstCtx, storeGS2))
+
+	crossGS3, err := dst.App.CrossKeeper.ExportGenesis(dstCtx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(cdc.MustMarshalJSON(crossGS), cdc.MustMarshalJSON(crossGS3))
+	atomicGS3, err := dst.App.AtomicKeeper.ExportGenesis(dstCtx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(cdc.MustMarshalJSON(atomicGS), cdc.MustMarshalJSON(atomicGS3))
+	storeGS3, err := newStore(dst).ExportGenesis(dstCtx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(storeGS, storeGS3)
+
+	// the termination index of the three-phase commit must be rebuilt
+	suite.Require().Equal(
+		src.App.AtomicKeeper.ThreePCKeeper().GetTxTerminations(ctx, math.MaxInt64, math.MaxInt32),
+		dst.App.AtomicKeeper.ThreePCKeeper().GetTxTerminations(dstCtx, math.MaxInt64, math.MaxInt32),
+	)
+
+	// an auth state that doesn't belong to any initiated txs is invalid
+	if len(crossGS.Initiator.TxStates) > 0 {
+		invalid := *crossGS
+		invalid.Initiator.TxStates = nil
+		suite.Require().Error(invalid.Validate())
+	}
+}
@@ -17,6 +17,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *initiatortypes.MsgInitiateTx:
 			res, err := k.InitiateTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *initiatortypes.MsgCancelTx:
+			res, err := k.CancelTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *authtypes.MsgSignTx:
 			res, err := k.SignTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -26,6 +29,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *authtypes.MsgExtSignTx:
 			res, err := k.ExtSignTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *authtypes.MsgRevokeSign:
+			res, err := k.RevokeSign(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *authtypes.MsgIBCRevokeSign:
+			res, err := k.IBCRevokeSign(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *authtypes.MsgGrantSign:
+			res, err := k.GrantSign(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *authtypes.MsgRevokeSignGrant:
+			res, err := k.RevokeSignGrant(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized IBC message type: %T", msg)
 		}
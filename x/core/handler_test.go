@@ -1,7 +1,9 @@
 package core_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -15,6 +17,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	samplemodtypes "github.com/datachainlab/cross/simapp/samplemod/types"
+	atomictypes "github.com/datachainlab/cross/x/core/atomic/types"
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
@@ -272,6 +275,7 @@ func (suite *CrossTestSuite) TestInitiateTxTPC() {
 	}
 
 	// Send a MsgIBCSignTx to chainC
+	var preparePackets []channeltypes.Packet
 	{
 		msg := authtypes.MsgIBCSignTx{
 			CrossChainChannel: xccCA,
@@ -298,6 +302,398 @@ func (suite *CrossTestSuite) TestInitiateTxTPC() {
 		ps, err = ibctesting.GetPacketsFromEvents(res1.GetEvents().ToABCIEvents())
 		suite.Require().NoError(err)
 		suite.Require().Equal(2, len(ps))
+		preparePackets = ps
+	}
+
+	// Relay the PacketDataPrepares to chainB and chainC
+	var commitPackets []channeltypes.Packet
+	{
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainB, suite.chainA, clientBA, exported.Tendermint))
+		_, err := relayPacket(suite.coordinator, suite.chainA, suite.chainB, clientAB, clientBA, preparePackets[0])
+		suite.Require().NoError(err)
+
+		// chainA has a pending coordinator state and chainB has a prepared transaction
+		suite.testGenesis(suite.chainA)
+		suite.testGenesis(suite.chainB)
+
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainA, clientCA, exported.Tendermint))
+		res, err := relayPacket(suite.coordinator, suite.chainA, suite.chainC, clientAC, clientCA, preparePackets[1])
+		suite.Require().NoError(err)
+
+		commitPackets, err = ibctesting.GetPacketsFromEvents(res.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Equal(2, len(commitPackets))
+	}
+
+	// Relay the PacketDataCommits to chainB and chainC
+	{
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainB, suite.chainA, clientBA, exported.Tendermint))
+		_, err := relayPacket(suite.coordinator, suite.chainA, suite.chainB, clientAB, clientBA, commitPackets[0])
+		suite.Require().NoError(err)
+
+		cs, found := suite.chainA.App.AtomicKeeper.TPCKeeper().GetCoordinatorState(suite.chainA.GetContext(), txID)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMMIT, cs.Phase)
+
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainA, clientCA, exported.Tendermint))
+		_, err = relayPacket(suite.coordinator, suite.chainA, suite.chainC, clientAC, clientCA, commitPackets[1])
+		suite.Require().NoError(err)
+
+		cs, found = suite.chainA.App.AtomicKeeper.TPCKeeper().GetCoordinatorState(suite.chainA.GetContext(), txID)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+		suite.Require().Equal(atomictypes.COORDINATOR_DECISION_COMMIT, cs.Decision)
+	}
+}
+
+func (suite *CrossTestSuite) TestInitiateTx3PC() {
+	// setup
+	// chainA is the coordinator, and it is under maintenance after sending the pre-commits.
+	// chainB and chainC commit the tx by themselves without hearing from chainA.
+
+	clientAB, clientBA, connAB, connBA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelAB, channelBA := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connAB, connBA, types.PortID, types.PortID, channeltypes.UNORDERED)
+	chAB := xcctypes.ChannelInfo{Port: channelAB.PortID, Channel: channelAB.ID}
+	xccAB, err := xcctypes.PackCrossChainChannel(&chAB)
+	suite.Require().NoError(err)
+	chBA := xcctypes.ChannelInfo{Port: channelBA.PortID, Channel: channelBA.ID}
+	xccBA, err := xcctypes.PackCrossChainChannel(&chBA)
+	suite.Require().NoError(err)
+
+	clientAC, clientCA, connAC, connCA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainC, exported.Tendermint, ibctesting.CrossVersion)
+	channelAC, channelCA := suite.coordinator.CreateChannel(suite.chainA, suite.chainC, connAC, connCA, types.PortID, types.PortID, channeltypes.UNORDERED)
+	chAC := xcctypes.ChannelInfo{Port: channelAC.PortID, Channel: channelAC.ID}
+	xccAC, err := xcctypes.PackCrossChainChannel(&chAC)
+	suite.Require().NoError(err)
+	chCA := xcctypes.ChannelInfo{Port: channelCA.PortID, Channel: channelCA.ID}
+	xccCA, err := xcctypes.PackCrossChainChannel(&chCA)
+	suite.Require().NoError(err)
+
+	// Signing process:
+	// 1. MsgInitiateTx consumes nothing from chainA
+	// 2. MsgIBCSignTx consumes Tx#0 from chainB
+	// 3. MsgIBCSignTx consumes Tx#1 from chainC
+
+	var txID crosstypes.TxID
+
+	// Send a MsgInitiateTx to chainA
+	{
+		msg0 := initiatortypes.NewMsgInitiateTx(
+			[]authtypes.Account{authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress()))},
+			suite.chainA.ChainID,
+			0,
+			txtypes.COMMIT_PROTOCOL_3PC,
+			[]initiatortypes.ContractTransaction{
+				{
+					CrossChainChannel: xccAB,
+					Signers: []authtypes.Account{
+						authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccAB)),
+					},
+					CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainB.App.AppCodec()),
+				},
+				{
+					CrossChainChannel: xccAC,
+					Signers: []authtypes.Account{
+						authtypes.NewAccount(authtypes.AccountID(suite.chainC.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccAC)),
+					},
+					CallInfo: samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainC.App.AppCodec()),
+				},
+			},
+			clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+			0,
+		)
+		res0, err := suite.chainA.SendMsgs(msg0)
+		suite.Require().NoError(err)
+		suite.chainA.NextBlock()
+
+		var txMsgData sdk.TxMsgData
+		var initiateTxRes initiatortypes.MsgInitiateTxResponse
+		suite.Require().NoError(proto.Unmarshal(res0.Data, &txMsgData))
+		suite.Require().NoError(proto.Unmarshal(txMsgData.Data[0].Data, &initiateTxRes))
+		suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, initiateTxRes.Status)
+		txID = initiateTxRes.TxID
+	}
+
+	// Send a MsgIBCSignTx to chainB
+	{
+		msg := authtypes.MsgIBCSignTx{
+			CrossChainChannel: xccBA,
+			TxID:              txID,
+			Signers:           []authtypes.AccountID{suite.chainB.SenderAccount.GetAddress().Bytes()},
+			TimeoutHeight:     clienttypes.NewHeight(0, uint64(suite.chainB.CurrentHeader.Height)+100),
+			TimeoutTimestamp:  0,
+		}
+		res0, err := sendMsgs(suite.coordinator, suite.chainB, suite.chainA, clientAB, &msg)
+		suite.Require().NoError(err)
+		suite.chainB.NextBlock()
+
+		ps, err := ibctesting.GetPacketsFromEvents(res0.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		p := ps[0]
+
+		res1, err := recvPacket(
+			suite.coordinator, suite.chainB, suite.chainA, clientBA, p,
+		)
+		suite.Require().NoError(err)
+		suite.chainA.NextBlock()
+		suite.chainB.NextBlock()
+		ps, err = ibctesting.GetPacketsFromEvents(res1.GetEvents().ToABCIEvents())
+		suite.Require().Equal(0, len(ps))
+	}
+
+	// Send a MsgIBCSignTx to chainC
+	var preparePackets []channeltypes.Packet
+	{
+		msg := authtypes.MsgIBCSignTx{
+			CrossChainChannel: xccCA,
+			TxID:              txID,
+			Signers:           []authtypes.AccountID{suite.chainC.SenderAccount.GetAddress().Bytes()},
+			TimeoutHeight:     clienttypes.NewHeight(0, uint64(suite.chainC.CurrentHeader.Height)+100),
+			TimeoutTimestamp:  0,
+		}
+		res0, err := sendMsgs(suite.coordinator, suite.chainC, suite.chainA, clientAC, &msg)
+		suite.Require().NoError(err)
+		suite.chainC.NextBlock()
+
+		ps, err := ibctesting.GetPacketsFromEvents(res0.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		p := ps[0]
+
+		res1, err := recvPacket(
+			suite.coordinator, suite.chainC, suite.chainA, clientCA, p,
+		)
+		suite.Require().NoError(err)
+		suite.chainA.NextBlock()
+		suite.chainC.NextBlock()
+
+		ps, err = ibctesting.GetPacketsFromEvents(res1.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Equal(2, len(ps))
+		preparePackets = ps
+	}
+
+	// Relay the PacketDataPrepares to chainB and chainC
+	var preCommitPackets []channeltypes.Packet
+	{
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainB, suite.chainA, clientBA, exported.Tendermint))
+		_, err := relayPacket(suite.coordinator, suite.chainA, suite.chainB, clientAB, clientBA, preparePackets[0])
+		suite.Require().NoError(err)
+
+		// chainB has a prepared transaction, and its termination index is rebuilt from the genesis
+		suite.testGenesis(suite.chainB)
+
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainA, clientCA, exported.Tendermint))
+		res, err := relayPacket(suite.coordinator, suite.chainA, suite.chainC, clientAC, clientCA, preparePackets[1])
+		suite.Require().NoError(err)
+
+		preCommitPackets, err = ibctesting.GetPacketsFromEvents(res.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Equal(2, len(preCommitPackets))
+
+		cs, found := suite.chainA.App.AtomicKeeper.ThreePCKeeper().GetCoordinatorState(suite.chainA.GetContext(), txID)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.COORDINATOR_PHASE_PRE_COMMIT, cs.Phase)
+	}
+
+	// Deliver the PacketDataPreCommits to chainB and chainC, but chainA doesn't receive their acknowledgements
+	var preCommitAcks [][]byte
+	for i, c := range []struct {
+		chain        *ibctesting.TestChain
+		client       string
+		sourceClient string
+	}{{suite.chainB, clientBA, clientAB}, {suite.chainC, clientCA, clientAC}} {
+		suite.Require().NoError(suite.coordinator.UpdateClient(c.chain, suite.chainA, c.client, exported.Tendermint))
+		res, err := recvPacket(suite.coordinator, suite.chainA, c.chain, c.sourceClient, preCommitPackets[i])
+		suite.Require().NoError(err)
+		c.chain.NextBlock()
+		acks, err := ibctesting.GetPacketAcknowledgementsFromEvents(res.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Equal(1, len(acks))
+		preCommitAcks = append(preCommitAcks, acks[0].Data())
+	}
+
+	// chainB and chainC commit the tx by themselves at the end of the block after the deadline
+	suite.coordinator.IncrementTimeBy(2 * time.Duration(txtypes.DefaultThreePhaseCommitTimeout) * time.Second)
+	for i, c := range []struct {
+		chain  *ibctesting.TestChain
+		client string
+	}{{suite.chainB, clientBA}, {suite.chainC, clientCA}} {
+		suite.Require().NoError(suite.coordinator.UpdateClient(c.chain, suite.chainA, c.client, exported.Tendermint))
+		txState, found := c.chain.App.AtomicKeeper.ThreePCKeeper().GetContractTransactionState(c.chain.GetContext(), txID, crosstypes.TxIndex(i))
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, txState.Status)
+	}
+
+	// chainA recovers and receives the acknowledgements of the PacketDataPreCommits
+	var commitPackets []channeltypes.Packet
+	{
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, clientAB, exported.Tendermint))
+		_, err := acknowledgePacket(suite.coordinator, suite.chainA, suite.chainB, clientBA, preCommitPackets[0], preCommitAcks[0])
+		suite.Require().NoError(err)
+		suite.chainA.NextBlock()
+
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainC, clientAC, exported.Tendermint))
+		res, err := acknowledgePacket(suite.coordinator, suite.chainA, suite.chainC, clientCA, preCommitPackets[1], preCommitAcks[1])
+		suite.Require().NoError(err)
+		suite.chainA.NextBlock()
+
+		commitPackets, err = ibctesting.GetPacketsFromEvents(res.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Equal(2, len(commitPackets))
+	}
+
+	// Relay the PacketDataCommits to chainB and chainC, which have already committed the tx
+	{
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainB, suite.chainA, clientBA, exported.Tendermint))
+		_, err := relayPacket(suite.coordinator, suite.chainA, suite.chainB, clientAB, clientBA, commitPackets[0])
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainC, suite.chainA, clientCA, exported.Tendermint))
+		_, err = relayPacket(suite.coordinator, suite.chainA, suite.chainC, clientAC, clientCA, commitPackets[1])
+		suite.Require().NoError(err)
+
+		cs, found := suite.chainA.App.AtomicKeeper.ThreePCKeeper().GetCoordinatorState(suite.chainA.GetContext(), txID)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+		suite.Require().Equal(atomictypes.COORDINATOR_DECISION_COMMIT, cs.Decision)
+		suite.Require().Empty(cs.CommitFailures)
+	}
+}
+
+func (suite *CrossTestSuite) TestInitiateTxSaga() {
+	// setup
+
+	clientA, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelA, channelB := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, types.PortID, types.PortID, channeltypes.UNORDERED)
+
+	chAB := xcctypes.ChannelInfo{Port: channelA.PortID, Channel: channelA.ID}
+	xccB, err := xcctypes.PackCrossChainChannel(&chAB)
+	suite.Require().NoError(err)
+	chBA := xcctypes.ChannelInfo{Port: channelB.PortID, Channel: channelB.ID}
+	xccA, err := xcctypes.PackCrossChainChannel(&chBA)
+	suite.Require().NoError(err)
+
+	xccSelf, err := xcctypes.PackCrossChainChannel(suite.chainA.App.XCCResolver.GetSelfCrossChainChannel(suite.chainA.GetContext()))
+	suite.Require().NoError(err)
+
+	// Signing process:
+	// 1. MsgInitiateTx consumes Tx#0 from chainA
+	// 2. MsgIBCSignTx consumes Tx#1 from chainB
+
+	var txID crosstypes.TxID
+
+	// Send a MsgInitiateTx to chainA
+	{
+		msg0 := initiatortypes.NewMsgInitiateTx(
+			[]authtypes.Account{authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress()))},
+			suite.chainA.ChainID,
+			0,
+			txtypes.COMMIT_PROTOCOL_SAGA,
+			[]initiatortypes.ContractTransaction{
+				{
+					CrossChainChannel: xccSelf,
+					Signers: []authtypes.Account{
+						authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+					},
+					CallInfo:             samplemodtypes.NewContractCallRequest("counter").ContractCallInfo(suite.chainA.App.AppCodec()),
+					CompensationCallInfo: samplemodtypes.NewContractCallRequest("decrement-counter").ContractCallInfo(suite.chainA.App.AppCodec()),
+				},
+				{
+					CrossChainChannel: xccB,
+					Signers: []authtypes.Account{
+						authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
+					},
+					CallInfo: samplemodtypes.NewContractCallRequest("fail").ContractCallInfo(suite.chainB.App.AppCodec()),
+				},
+			},
+			clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+			0,
+		)
+		res0, err := sendMsgs(suite.coordinator, suite.chainA, suite.chainB, clientB, msg0)
+		suite.Require().NoError(err)
+		suite.chainA.NextBlock()
+
+		var txMsgData sdk.TxMsgData
+		var initiateTxRes initiatortypes.MsgInitiateTxResponse
+		suite.Require().NoError(proto.Unmarshal(res0.Data, &txMsgData))
+		suite.Require().NoError(proto.Unmarshal(txMsgData.Data[0].Data, &initiateTxRes))
+		suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, initiateTxRes.Status)
+		txID = initiateTxRes.TxID
+	}
+
+	// Send a MsgIBCSignTx to chainB & receive the MsgIBCSignTx to run the transaction on chainA
+	var packetStep channeltypes.Packet
+	{
+		msg1 := authtypes.MsgIBCSignTx{
+			CrossChainChannel: xccA,
+			TxID:              txID,
+			Signers:           []authtypes.AccountID{suite.chainB.SenderAccount.GetAddress().Bytes()},
+			TimeoutHeight:     clienttypes.NewHeight(0, uint64(suite.chainB.CurrentHeader.Height)+100),
+			TimeoutTimestamp:  0,
+		}
+		res1, err := sendMsgs(suite.coordinator, suite.chainB, suite.chainA, clientA, &msg1)
+		suite.Require().NoError(err)
+		suite.chainB.NextBlock()
+
+		ps, err := ibctesting.GetPacketsFromEvents(res1.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Len(ps, 1)
+		p := ps[0]
+		res2, err := recvPacket(
+			suite.coordinator, suite.chainB, suite.chainA, clientB, p,
+		)
+		suite.Require().NoError(err)
+		suite.chainA.NextBlock()
+
+		// the step of chainA is committed immediately, and the next step is sent to chainB
+		ps, err = ibctesting.GetPacketsFromEvents(res2.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Len(ps, 1)
+		packetStep = ps[0]
+
+		txState, found := suite.chainA.App.AtomicKeeper.SagaKeeper().GetContractTransactionState(suite.chainA.GetContext(), txID, 0)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_COMMIT, txState.Status)
+		counter, err := suite.chainA.App.SamplemodKeeper.Counter(
+			sdk.WrapSDKContext(suite.chainA.GetContext()),
+			&samplemodtypes.QueryCounterRequest{Account: authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())},
+		)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(1), counter.Value)
+	}
+
+	// Relay the step packet to chainB, which fails to commit the step, so chainA compensates its step
+	{
+		suite.Require().NoError(
+			suite.coordinator.UpdateClient(suite.chainB, suite.chainA, clientB, exported.Tendermint),
+		)
+		res, err := relayPacket(suite.coordinator, suite.chainA, suite.chainB, clientA, clientB, packetStep)
+		suite.Require().NoError(err)
+		ps, err := ibctesting.GetPacketsFromEvents(res.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Len(ps, 0)
+
+		cs, found := suite.chainA.App.AtomicKeeper.SagaKeeper().GetCoordinatorState(suite.chainA.GetContext(), txID)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.COORDINATOR_PHASE_COMPLETED, cs.Phase)
+		suite.Require().Equal(atomictypes.COORDINATOR_DECISION_ABORT, cs.Decision)
+		suite.Require().Equal(atomictypes.ABORT_REASON_STEP_FAILED, cs.AbortReason)
+		suite.Require().Equal(atomictypes.SAGA_STEP_STATUS_COMPENSATED, cs.Saga.Steps[0].Status)
+		suite.Require().Equal(atomictypes.SAGA_STEP_STATUS_FAILED, cs.Saga.Steps[1].Status)
+
+		txState, found := suite.chainA.App.AtomicKeeper.SagaKeeper().GetContractTransactionState(suite.chainA.GetContext(), txID, 0)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_COMPENSATED, txState.Status)
+		// the compensation has undone the increment of the counter
+		counter, err := suite.chainA.App.SamplemodKeeper.Counter(
+			sdk.WrapSDKContext(suite.chainA.GetContext()),
+			&samplemodtypes.QueryCounterRequest{Account: authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())},
+		)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(0), counter.Value)
+		txState, found = suite.chainB.App.AtomicKeeper.SagaKeeper().GetContractTransactionState(suite.chainB.GetContext(), txID, 1)
+		suite.Require().True(found)
+		suite.Require().Equal(atomictypes.CONTRACT_TRANSACTION_STATUS_ABORT, txState.Status)
 	}
 }
 
@@ -559,6 +955,31 @@ func acknowledgePacket(coord *ibctesting.Coordinator,
 	return sendMsgs(coord, source, counterparty, counterpartyClient, ackMsg)
 }
 
+// relayPacket relays a packet from source to counterparty, and relays its acknowledgement back to source
+func relayPacket(coord *ibctesting.Coordinator,
+	source, counterparty *ibctesting.TestChain,
+	sourceClient, counterpartyClient string,
+	packet channeltypes.Packet,
+) (*sdk.Result, error) {
+	res, err := recvPacket(coord, source, counterparty, sourceClient, packet)
+	if err != nil {
+		return nil, err
+	}
+	counterparty.NextBlock()
+	acks, err := ibctesting.GetPacketAcknowledgementsFromEvents(res.GetEvents().ToABCIEvents())
+	if err != nil {
+		return nil, err
+	} else if len(acks) != 1 {
+		return nil, fmt.Errorf("expected one acknowledgement, but got %v", len(acks))
+	}
+	res, err = acknowledgePacket(coord, source, counterparty, counterpartyClient, packet, acks[0].Data())
+	if err != nil {
+		return nil, err
+	}
+	source.NextBlock()
+	return res, nil
+}
+
 func TestCrossTestSuite(t *testing.T) {
 	suite.Run(t, new(CrossTestSuite))
 }
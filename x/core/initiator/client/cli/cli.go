@@ -21,6 +21,9 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCreateContractTransaction(),
+		GetTxState(),
+		GetPendingTxs(),
+		GetPendingTxsBySigner(),
 	)
 
 	return queryCmd
@@ -38,6 +41,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewInitiateTxCmd(),
+		NewCancelTxCmd(),
 	)
 
 	return txCmd
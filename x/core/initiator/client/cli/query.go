@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"os"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -22,6 +24,7 @@ func GetCreateContractTransaction() *cobra.Command {
 	const (
 		flagInitiatorChain        = "initiator-chain"
 		flagInitiatorChainChannel = "initiator-chain-channel"
+		flagInitiatorChainID      = "initiator-chain-id"
 		flagSigners               = "signers"
 		flagCallInfo              = "call-info"
 	)
@@ -42,8 +45,15 @@ func GetCreateContractTransaction() *cobra.Command {
 
 			// Validations
 			initiatorChannel := viper.GetString(flagInitiatorChainChannel)
+			initiatorChainID := viper.GetString(flagInitiatorChainID)
 			isInitiator := viper.GetBool(flagInitiatorChain)
-			if isInitiator {
+			if initiatorChainID != "" {
+				// The initiator chain is resolved through the chain channel registry on each chain
+				anyXCC, err = xcctypes.PackCrossChainChannel(xcctypes.NewChainInfo(initiatorChainID))
+				if err != nil {
+					return err
+				}
+			} else if isInitiator {
 				// Query self-XCC to query server
 				crossClient := types.NewQueryClient(clientCtx)
 				res, err := crossClient.SelfXCC(ctx, &types.QuerySelfXCCRequest{})
@@ -69,7 +79,7 @@ func GetCreateContractTransaction() *cobra.Command {
 					return err
 				}
 				accountID := authtypes.AccountIDFromAccAddress(keyInfo.GetAddress())
-				if isInitiator {
+				if isInitiator && initiatorChainID == "" {
 					signers = append(signers, authtypes.NewAccount(accountID, authtypes.NewAuthTypeLocal()))
 				} else {
 					signers = append(signers, authtypes.NewAccount(accountID, authtypes.NewAuthTypeChannelWithAny(anyXCC)))
@@ -94,6 +104,7 @@ func GetCreateContractTransaction() *cobra.Command {
 
 	cmd.Flags().Bool(flagInitiatorChain, false, "A boolean value whether the chain is an initiator of the cross-chain tx includes this contract tx")
 	cmd.Flags().String(flagInitiatorChainChannel, "", "The channel info: '<channelID>:<portID>'")
+	cmd.Flags().String(flagInitiatorChainID, "", "The chain ID of the initiator chain. If specified, the contract transaction refers to the initiator by its chain ID instead of the channel")
 	cmd.Flags().StringSlice(flagSigners, nil, "Signers info")
 	cmd.Flags().String(flagCallInfo, "", "A contract call info")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
@@ -106,6 +117,93 @@ func GetCreateContractTransaction() *cobra.Command {
 	return cmd
 }
 
+func GetTxState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-state [TxID: hex encoding]",
+		Short: "Query the state of a tx initiated on this chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			txID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			q := types.NewQueryClient(clientCtx)
+			res, err := q.TxState(cmd.Context(), &types.QueryTxStateRequest{TxId: txID})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.TxState)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetPendingTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-txs",
+		Short: "Query the txs that are waiting for the signatures",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			q := types.NewQueryClient(clientCtx)
+			res, err := q.PendingTxs(cmd.Context(), &types.QueryPendingTxsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-txs")
+	return cmd
+}
+
+func GetPendingTxsBySigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-txs-by-signer [address]",
+		Short: "Query the pending txs that are waiting for the signature of a given account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			q := types.NewQueryClient(clientCtx)
+			res, err := q.PendingTxsBySigner(cmd.Context(), &types.QueryPendingTxsBySignerRequest{
+				SignerId:   authtypes.AccountIDFromAccAddress(addr),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-txs-by-signer")
+	return cmd
+}
+
 func parseChannelInfoFromString(s string) (*xcctypes.ChannelInfo, error) {
 	parts := strings.Split(s, ":")
 	if len(parts) != 2 {
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"io/ioutil"
 	"time"
 
@@ -10,7 +11,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
 	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
 	"github.com/spf13/cobra"
@@ -19,6 +19,7 @@ import (
 
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	"github.com/datachainlab/cross/x/core/initiator/types"
+	txcli "github.com/datachainlab/cross/x/core/tx/client/cli"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 )
@@ -49,7 +50,10 @@ func NewInitiateTxCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			version := clienttypes.ParseChainID(h.Header.ChainID)
+			timeoutHeight, timeoutTimestamp, err := txcli.QueryDefaultTimeout(clientCtx, h, height)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgInitiateTx(
 				[]authtypes.Account{authtypes.NewAccount(sender, authtypes.NewAuthTypeLocal())},
@@ -57,8 +61,8 @@ func NewInitiateTxCmd() *cobra.Command {
 				uint64(time.Now().Unix()),
 				txtypes.COMMIT_PROTOCOL_SIMPLE,
 				ctxs,
-				clienttypes.NewHeight(version, uint64(height)+100),
-				0,
+				timeoutHeight,
+				timeoutTimestamp,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -73,6 +77,32 @@ func NewInitiateTxCmd() *cobra.Command {
 	return cmd
 }
 
+// NewCancelTxCmd returns the command to create a MsgCancelTx transaction
+func NewCancelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-tx [TxID: hex encoding]",
+		Short: "Cancel a pending tx initiated by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelTx(txID, authtypes.AccountIDFromAccAddress(clientCtx.GetFromAddress()))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func readContractTransactions(m codec.JSONCodec, pathList []string) ([]types.ContractTransaction, error) {
 	var cTxs []types.ContractTransaction
 	for _, path := range pathList {
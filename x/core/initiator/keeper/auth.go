@@ -13,12 +13,11 @@ var _ authtypes.TxManager = (*Keeper)(nil)
 
 // IsActive implements TxManager interface
 func (a Keeper) IsActive(ctx sdk.Context, txID crosstypes.TxID) (bool, error) {
-	// TODO add timeout support to initiator?
-	_, found := a.getTxState(ctx, txID)
+	txState, found := a.getTxState(ctx, txID)
 	if !found {
 		return false, nil
 	}
-	return true, nil
+	return !txState.IsExpired(), nil
 }
 
 // OnPostAuth implements TxManager interface
@@ -30,7 +29,57 @@ func (a Keeper) OnPostAuth(ctx sdk.Context, txID crosstypes.TxID) error {
 	if txState.IsVerified() {
 		return fmt.Errorf("txState '%x' is already verified", txID)
 	}
+	if txState.IsExpired() {
+		return fmt.Errorf("txState '%x' is already expired", txID)
+	}
 	txState.Status = types.INITIATE_TX_STATUS_VERIFIED
 	a.setTxState(ctx, txID, *txState)
+	a.deleteTxTimeout(ctx, txID, &txState.Msg)
 	return a.runTx(ctx, txID, &txState.Msg)
 }
+
+// GetSignTargets implements TxManager interface
+func (a Keeper) GetSignTargets(ctx sdk.Context, txID crosstypes.TxID, signer authtypes.Account) ([]authtypes.SignTarget, error) {
+	txState, found := a.getTxState(ctx, txID)
+	if !found {
+		return nil, fmt.Errorf("txState '%x' not found", txID)
+	}
+	var targets []authtypes.SignTarget
+	for _, tx := range txState.Msg.ContractTransactions {
+		required, err := a.isRequiredSigner(ctx, tx, signer)
+		if err != nil {
+			return nil, err
+		} else if !required {
+			continue
+		}
+		xcc, err := tx.GetCrossChainChannel(a.m)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, authtypes.SignTarget{CrossChainChannel: xcc, CallInfo: tx.CallInfo})
+	}
+	return targets, nil
+}
+
+// isRequiredSigner returns a boolean whether a given signer is a signer or a member of the signer groups of the contract transaction.
+// The accounts of the contract transaction are normalized in the same way as the auth state.
+func (a Keeper) isRequiredSigner(ctx sdk.Context, tx types.ContractTransaction, signer authtypes.Account) (bool, error) {
+	accs := append([]authtypes.Account{}, tx.Signers...)
+	for _, g := range tx.SignerGroups {
+		accs = append(accs, g.Members...)
+	}
+	accs, err := authtypes.NormalizeChannelAccounts(ctx, a.m, a.xccResolver, accs)
+	if err != nil {
+		return false, err
+	}
+	return containsAccount(accs, signer), nil
+}
+
+func containsAccount(accs []authtypes.Account, acc authtypes.Account) bool {
+	for _, a := range accs {
+		if a.Equal(acc) {
+			return true
+		}
+	}
+	return false
+}
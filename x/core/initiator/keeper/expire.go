@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/datachainlab/cross/x/core/initiator/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+)
+
+// ExpireTxs expires the pending txs that have passed their timeout
+// It processes at most `limit` timeout entries and returns the number of processed entries.
+func (k Keeper) ExpireTxs(ctx sdk.Context, limit int) int {
+	n := k.expireTxsByIndex(
+		ctx,
+		types.KeyPrefixBytes(types.KeyTxTimeoutHeightPrefix),
+		// the tx is timed out if the current height is greater than or equal to the timeout height
+		uint64(ctx.BlockHeight()),
+		limit,
+	)
+	if n < limit {
+		n += k.expireTxsByIndex(
+			ctx,
+			types.KeyPrefixBytes(types.KeyTxTimeoutTimestampPrefix),
+			// the tx is timed out if the current time is greater than or equal to the timeout timestamp
+			uint64(ctx.BlockTime().Unix()),
+			limit-n,
+		)
+	}
+	return n
+}
+
+func (k Keeper) expireTxsByIndex(ctx sdk.Context, indexPrefix []byte, current uint64, limit int) int {
+	store := prefix.NewStore(k.store(ctx), indexPrefix)
+	// end is exclusive, so the entries whose timeout equals to `current` are included
+	it := store.Iterator(nil, sdk.Uint64ToBigEndian(current+1))
+	defer it.Close()
+
+	var txIDs []crosstypes.TxID
+	for ; it.Valid() && len(txIDs) < limit; it.Next() {
+		txIDs = append(txIDs, append(crosstypes.TxID{}, it.Key()[8:]...))
+	}
+	for _, txID := range txIDs {
+		if err := k.expireTx(ctx, txID); err != nil {
+			// the state is inconsistent, but the sweeper must not halt the chain
+			k.Logger(ctx).Error("failed to expire a tx", "txID", hex.EncodeToString(txID), "err", err)
+		}
+	}
+	return len(txIDs)
+}
+
+func (k Keeper) expireTx(ctx sdk.Context, txID crosstypes.TxID) error {
+	txState, found := k.getTxState(ctx, txID)
+	if !found {
+		return nil
+	}
+	k.deleteTxTimeout(ctx, txID, &txState.Msg)
+	if txState.Status != types.INITIATE_TX_STATUS_PENDING {
+		return nil
+	}
+	// the status and the auth state must be updated atomically, so the tx is left pending if the latter fails
+	cacheCtx, writeFn := ctx.CacheContext()
+	txState.Status = types.INITIATE_TX_STATUS_EXPIRED
+	k.setTxState(cacheCtx, txID, *txState)
+	if err := k.authenticator.DeleteAuthState(cacheCtx, txID); err != nil {
+		return err
+	}
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTxExpired,
+			sdk.NewAttribute(types.AttributeKeyTxID, hex.EncodeToString(txID)),
+		),
+	)
+	return nil
+}
+
+// setTxTimeout adds the tx to the timeout index
+func (k Keeper) setTxTimeout(ctx sdk.Context, txID crosstypes.TxID, msg *types.MsgInitiateTx) {
+	if !msg.TimeoutHeight.IsZero() {
+		k.store(ctx).Set(types.KeyTxTimeoutHeight(msg.TimeoutHeight.GetRevisionHeight(), txID), []byte{1})
+	}
+	if msg.TimeoutTimestamp > 0 {
+		k.store(ctx).Set(types.KeyTxTimeoutTimestamp(msg.TimeoutTimestamp, txID), []byte{1})
+	}
+}
+
+// deleteTxTimeout removes the tx from the timeout index
+func (k Keeper) deleteTxTimeout(ctx sdk.Context, txID crosstypes.TxID, msg *types.MsgInitiateTx) {
+	if !msg.TimeoutHeight.IsZero() {
+		k.store(ctx).Delete(types.KeyTxTimeoutHeight(msg.TimeoutHeight.GetRevisionHeight(), txID))
+	}
+	if msg.TimeoutTimestamp > 0 {
+		k.store(ctx).Delete(types.KeyTxTimeoutTimestamp(msg.TimeoutTimestamp, txID))
+	}
+}
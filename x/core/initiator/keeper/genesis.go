@@ -1,11 +1,36 @@
 package keeper
 
 import (
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/datachainlab/cross/x/core/initiator/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
 )
 
-// ExportGenesis exports bridge module's portID and denom trace info into its genesis state.
-func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	return &types.GenesisState{}
+// InitGenesis initializes the states of the initiated txs
+// The timeout index is rebuilt from the pending txs.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	if err := gs.Validate(); err != nil {
+		return err
+	}
+	for _, s := range gs.TxStates {
+		k.setTxState(ctx, s.TxId, s.TxState)
+		if s.TxState.Status == types.INITIATE_TX_STATUS_PENDING {
+			k.setTxTimeout(ctx, s.TxId, &s.TxState.Msg)
+		}
+	}
+	return nil
+}
+
+// ExportGenesis exports the states of the initiated txs
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	states, _, err := k.getTxStates(ctx, &query.PageRequest{Limit: math.MaxUint64}, func(crosstypes.TxID, *types.InitiateTxState) (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return types.NewGenesisState(states), nil
 }
@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	"github.com/datachainlab/cross/x/core/initiator/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 )
 
@@ -21,3 +25,54 @@ func (q Keeper) SelfXCC(c context.Context, req *types.QuerySelfXCCRequest) (*typ
 	}
 	return &types.QuerySelfXCCResponse{Xcc: anyXCC}, nil
 }
+
+// TxState returns the state of the tx corresponding to a given txID
+func (q Keeper) TxState(c context.Context, req *types.QueryTxStateRequest) (*types.QueryTxStateResponse, error) {
+	state, found := q.getTxState(sdk.UnwrapSDKContext(c), req.TxId)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", req.TxId)
+	}
+	return &types.QueryTxStateResponse{TxState: *state}, nil
+}
+
+// PendingTxs returns the txs that are waiting for the signatures of the signers
+func (q Keeper) PendingTxs(c context.Context, req *types.QueryPendingTxsRequest) (*types.QueryPendingTxsResponse, error) {
+	txs, pageRes, err := q.getTxStates(sdk.UnwrapSDKContext(c), req.Pagination, func(_ crosstypes.TxID, state *types.InitiateTxState) (bool, error) {
+		return state.Status == types.INITIATE_TX_STATUS_PENDING, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingTxsResponse{Txs: txs, Pagination: pageRes}, nil
+}
+
+// PendingTxsBySigner returns the pending txs that are waiting for the signature of a given signer
+func (q Keeper) PendingTxsBySigner(c context.Context, req *types.QueryPendingTxsBySignerRequest) (*types.QueryPendingTxsBySignerResponse, error) {
+	if len(req.SignerId) == 0 {
+		return nil, fmt.Errorf("signer_id must not be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	txs, pageRes, err := q.getTxStates(ctx, req.Pagination, func(txID crosstypes.TxID, state *types.InitiateTxState) (bool, error) {
+		if state.Status != types.INITIATE_TX_STATUS_PENDING {
+			return false, nil
+		}
+		remaining, err := q.authenticator.GetRemainingSigners(ctx, txID)
+		if err != nil {
+			return false, err
+		}
+		return containsAccountID(remaining, req.SignerId), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingTxsBySignerResponse{Txs: txs, Pagination: pageRes}, nil
+}
+
+func containsAccountID(accs []authtypes.Account, id authtypes.AccountID) bool {
+	for _, acc := range accs {
+		if bytes.Equal(acc.Id, id) {
+			return true
+		}
+	}
+	return false
+}
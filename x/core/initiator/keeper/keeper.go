@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	"github.com/datachainlab/cross/x/core/initiator/types"
@@ -21,6 +22,7 @@ import (
 type Keeper struct {
 	m                codec.Codec
 	storeKey         sdk.StoreKey
+	paramSpace       paramtypes.Subspace
 	portKeeper       types.PortKeeper
 	channelKeeper    types.ChannelKeeper
 	scopedKeeper     capabilitykeeper.ScopedKeeper
@@ -35,6 +37,7 @@ type Keeper struct {
 func NewKeeper(
 	m codec.Codec,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	channelKeeper types.ChannelKeeper,
 	authenticator authtypes.TxAuthenticator,
 	packetSendKeeper packets.PacketSendKeeper,
@@ -42,9 +45,14 @@ func NewKeeper(
 	xccResolver xcctypes.XCCResolver,
 	txRunner txtypes.TxRunner,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(txtypes.ParamKeyTable())
+	}
 	return Keeper{
 		m:                m,
 		storeKey:         storeKey,
+		paramSpace:       paramSpace,
 		channelKeeper:    channelKeeper,
 		authenticator:    authenticator,
 		packetMiddleware: packetMiddleware,
@@ -58,6 +66,16 @@ func (k Keeper) ChannelKeeper() types.ChannelKeeper {
 	return k.channelKeeper
 }
 
+// GetParams returns the total set of the cross parameters.
+func (k Keeper) GetParams(ctx sdk.Context) txtypes.Params {
+	return txtypes.LoadParams(ctx, k.paramSpace)
+}
+
+// SetParams sets the total set of the cross parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params txtypes.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
 // Logger returns a logger instance
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", crosstypes.ModuleName, types.SubModuleName))
@@ -2,9 +2,15 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
@@ -12,10 +18,12 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	samplemodtypes "github.com/datachainlab/cross/simapp/samplemod/types"
+	simpletypes "github.com/datachainlab/cross/x/core/atomic/protocol/simple/types"
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
 	crosstypes "github.com/datachainlab/cross/x/core/types"
+	xcckeeper "github.com/datachainlab/cross/x/core/xcc/keeper"
 	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 	ibctesting "github.com/datachainlab/cross/x/ibc/testing"
 	"github.com/datachainlab/cross/x/packets"
@@ -104,6 +112,29 @@ func (suite *KeeperTestSuite) TestInitiateTx() {
 	}
 	suite.chainA.NextBlock()
 
+	// the tx is waiting for the signature of chainB's signer only
+	qA := suite.chainA.App.CrossKeeper.InitiatorKeeper()
+	{
+		ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+		res, err := qA.TxState(ctx, &initiatortypes.QueryTxStateRequest{TxId: res0.TxID})
+		suite.Require().NoError(err)
+		suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, res.TxState.Status)
+
+		pending, err := qA.PendingTxs(ctx, &initiatortypes.QueryPendingTxsRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Len(pending.Txs, 1)
+		suite.Require().Equal(res0.TxID, pending.Txs[0].TxId)
+
+		bySigner, err := qA.PendingTxsBySigner(ctx, &initiatortypes.QueryPendingTxsBySignerRequest{SignerId: authtypes.AccountID(suite.chainB.SenderAccount.GetAddress())})
+		suite.Require().NoError(err)
+		suite.Require().Len(bySigner.Txs, 1)
+		suite.Require().Equal(res0.TxID, bySigner.Txs[0].TxId)
+
+		bySigner, err = qA.PendingTxsBySigner(ctx, &initiatortypes.QueryPendingTxsBySignerRequest{SignerId: authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())})
+		suite.Require().NoError(err)
+		suite.Require().Len(bySigner.Txs, 0)
+	}
+
 	// IBCSignTx on chainB
 	ps := ibctesting.NewCapturePacketSender(
 		packets.NewBasicPacketSender(suite.chainB.App.IBCKeeper.ChannelKeeper),
@@ -135,6 +166,16 @@ func (suite *KeeperTestSuite) TestInitiateTx() {
 		suite.Require().NoError(err)
 		suite.Require().Len(ps, 1)
 	}
+	{
+		ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+		res, err := qA.TxState(ctx, &initiatortypes.QueryTxStateRequest{TxId: res0.TxID})
+		suite.Require().NoError(err)
+		suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_VERIFIED, res.TxState.Status)
+
+		pending, err := qA.PendingTxs(ctx, &initiatortypes.QueryPendingTxsRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Len(pending.Txs, 0)
+	}
 
 	// Re-send IBCSignTx to chainB
 	ps = ibctesting.NewCapturePacketSender(
@@ -168,6 +209,550 @@ func (suite *KeeperTestSuite) TestInitiateTx() {
 	suite.Require().Equal(authtypes.IBC_SIGN_TX_STATUS_FAILED, inAck.Payload().(*authtypes.PacketAcknowledgementIBCSignTx).Status)
 }
 
+func (suite *KeeperTestSuite) TestExpireTxs() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelA, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+
+	chAB := xcctypes.ChannelInfo{Port: channelA.PortID, Channel: channelA.ID}
+	xccB, err := xcctypes.PackCrossChainChannel(&chAB)
+	suite.Require().NoError(err)
+
+	txs := []initiatortypes.ContractTransaction{
+		{
+			CrossChainChannel: xccB,
+			Signers: []authtypes.Account{
+				authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
+			},
+			CallInfo: samplemodtypes.NewContractCallRequest("nop").ContractCallInfo(suite.chainB.App.AppCodec()),
+		},
+	}
+
+	ik := suite.chainA.App.CrossKeeper.InitiatorKeeper()
+	timeoutHeight := uint64(suite.chainA.CurrentHeader.Height) + 100
+	timeoutTimestamp := uint64(suite.chainA.CurrentHeader.Time.Unix()) + 1000
+
+	// tx0 and tx1 have a timeout height, and tx2 has a timeout timestamp
+	var txIDs []crosstypes.TxID
+	for i, msg := range []*initiatortypes.MsgInitiateTx{
+		{TimeoutHeight: clienttypes.NewHeight(0, timeoutHeight)},
+		{TimeoutHeight: clienttypes.NewHeight(0, timeoutHeight)},
+		{TimeoutTimestamp: timeoutTimestamp},
+	} {
+		msg.ChainId = suite.chainA.ChainID
+		msg.Nonce = uint64(i)
+		msg.CommitProtocol = txtypes.COMMIT_PROTOCOL_SIMPLE
+		msg.ContractTransactions = txs
+		msg.Signers = []authtypes.Account{
+			authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+		}
+		res, err := ik.InitiateTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+		suite.Require().NoError(err)
+		suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, res.Status)
+		txIDs = append(txIDs, res.TxID)
+	}
+	suite.chainA.NextBlock()
+
+	// no txs are expired before the timeout
+	suite.Require().Equal(0, ik.ExpireTxs(suite.chainA.GetContext(), initiatortypes.MaxExpiredTxsPerBlock))
+
+	// the timeout height is reached, but only one tx is processed due to the limit
+	ctx := suite.chainA.GetContext().WithBlockHeight(int64(timeoutHeight))
+	suite.Require().Equal(1, ik.ExpireTxs(ctx, 1))
+	suite.Require().Len(ctx.EventManager().Events(), 1)
+	suite.Require().Equal(initiatortypes.EventTypeTxExpired, ctx.EventManager().Events()[0].Type)
+	suite.Require().Equal(1, ik.ExpireTxs(ctx, 1))
+	suite.Require().Equal(0, ik.ExpireTxs(ctx, 1))
+
+	for _, txID := range txIDs[:2] {
+		res, err := ik.TxState(sdk.WrapSDKContext(ctx), &initiatortypes.QueryTxStateRequest{TxId: txID})
+		suite.Require().NoError(err)
+		suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_EXPIRED, res.TxState.Status)
+		_, err = suite.chainA.App.CrossKeeper.AuthKeeper().TxAuthState(sdk.WrapSDKContext(ctx), &authtypes.QueryTxAuthStateRequest{TxID: txID})
+		suite.Require().Error(err)
+		active, err := ik.IsActive(ctx, txID)
+		suite.Require().NoError(err)
+		suite.Require().False(active)
+	}
+	{
+		pending, err := ik.PendingTxs(sdk.WrapSDKContext(ctx), &initiatortypes.QueryPendingTxsRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Len(pending.Txs, 1)
+		suite.Require().Equal(txIDs[2], pending.Txs[0].TxId)
+	}
+
+	// an expired tx cannot be signed
+	_, err = suite.chainA.App.CrossKeeper.AuthKeeper().Sign(ctx, txIDs[0], txs[0].Signers)
+	suite.Require().Error(err)
+
+	// the timeout timestamp is reached
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2000 * time.Second))
+	{
+		// the tx is left pending if its auth state cannot be deleted
+		failCtx, _ := ctx.CacheContext()
+		failCtx = failCtx.WithEventManager(sdk.NewEventManager())
+		suite.Require().NoError(suite.chainA.App.CrossKeeper.AuthKeeper().DeleteAuthState(failCtx, txIDs[2]))
+		suite.Require().Equal(1, ik.ExpireTxs(failCtx, initiatortypes.MaxExpiredTxsPerBlock))
+		suite.Require().Empty(failCtx.EventManager().Events())
+		res, err := ik.TxState(sdk.WrapSDKContext(failCtx), &initiatortypes.QueryTxStateRequest{TxId: txIDs[2]})
+		suite.Require().NoError(err)
+		suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, res.TxState.Status)
+	}
+	suite.Require().Equal(1, ik.ExpireTxs(ctx, initiatortypes.MaxExpiredTxsPerBlock))
+	res, err := ik.TxState(sdk.WrapSDKContext(ctx), &initiatortypes.QueryTxStateRequest{TxId: txIDs[2]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_EXPIRED, res.TxState.Status)
+}
+
+func (suite *KeeperTestSuite) TestCancelTx() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelA, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+
+	chAB := xcctypes.ChannelInfo{Port: channelA.PortID, Channel: channelA.ID}
+	xccB, err := xcctypes.PackCrossChainChannel(&chAB)
+	suite.Require().NoError(err)
+
+	ik := suite.chainA.App.CrossKeeper.InitiatorKeeper()
+	msg := &initiatortypes.MsgInitiateTx{
+		ChainId:        suite.chainA.ChainID,
+		Nonce:          0,
+		CommitProtocol: txtypes.COMMIT_PROTOCOL_SIMPLE,
+		ContractTransactions: []initiatortypes.ContractTransaction{
+			{
+				CrossChainChannel: xccB,
+				Signers: []authtypes.Account{
+					authtypes.NewAccount(authtypes.AccountID(suite.chainB.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(xccB)),
+				},
+				CallInfo: samplemodtypes.NewContractCallRequest("nop").ContractCallInfo(suite.chainB.App.AppCodec()),
+			},
+		},
+		Signers: []authtypes.Account{
+			authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+		},
+		TimeoutHeight: clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+	}
+	res0, err := ik.InitiateTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, res0.Status)
+	suite.chainA.NextBlock()
+
+	// only the local signers of the tx can cancel it
+	_, err = ik.CancelTx(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		initiatortypes.NewMsgCancelTx(res0.TxID, authtypes.AccountID(suite.chainB.SenderAccount.GetAddress())),
+	)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	ctx := suite.chainA.GetContext()
+	_, err = ik.CancelTx(
+		sdk.WrapSDKContext(ctx),
+		initiatortypes.NewMsgCancelTx(res0.TxID, authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initiatortypes.EventTypeTxCancelled, ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+	suite.chainA.NextBlock()
+
+	_, err = ik.TxState(sdk.WrapSDKContext(suite.chainA.GetContext()), &initiatortypes.QueryTxStateRequest{TxId: res0.TxID})
+	suite.Require().Error(err)
+	_, err = suite.chainA.App.CrossKeeper.AuthKeeper().TxAuthState(sdk.WrapSDKContext(suite.chainA.GetContext()), &authtypes.QueryTxAuthStateRequest{TxID: res0.TxID})
+	suite.Require().Error(err)
+
+	// a sign for the cancelled tx must fail
+	_, err = suite.chainA.App.CrossKeeper.AuthKeeper().ReceiveIBCSignTx(
+		suite.chainA.GetContext(),
+		chAB.Port, chAB.Channel,
+		authtypes.NewPacketDataIBCSignTx(res0.TxID, []authtypes.AccountID{suite.chainB.SenderAccount.GetAddress().Bytes()}, clienttypes.NewHeight(0, 100), 0),
+	)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "not active")
+
+	// the cancelled tx cannot be cancelled again
+	_, err = ik.CancelTx(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		initiatortypes.NewMsgCancelTx(res0.TxID, authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestInitiateTxWithSignerGroup() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelA, channelB := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+
+	chAB := xcctypes.ChannelInfo{Port: channelA.PortID, Channel: channelA.ID}
+	xccB, err := xcctypes.PackCrossChainChannel(&chAB)
+	suite.Require().NoError(err)
+	chBA := xcctypes.ChannelInfo{Port: channelB.PortID, Channel: channelB.ID}
+
+	xccSelf, err := xcctypes.PackCrossChainChannel(suite.chainA.App.XCCResolver.GetSelfCrossChainChannel(suite.chainA.GetContext()))
+	suite.Require().NoError(err)
+
+	// 2-of-3 accounts on chainB must sign the tx
+	var members []authtypes.Account
+	for i := 0; i < 3; i++ {
+		id := authtypes.AccountID(secp256k1.GenPrivKey().PubKey().Address())
+		members = append(members, authtypes.NewAccount(id, authtypes.NewAuthTypeChannelWithAny(xccB)))
+	}
+	txs := []initiatortypes.ContractTransaction{
+		{
+			CrossChainChannel: xccSelf,
+			Signers: []authtypes.Account{
+				authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+			},
+			CallInfo: samplemodtypes.NewContractCallRequest("nop").ContractCallInfo(suite.chainA.App.AppCodec()),
+		},
+		{
+			CrossChainChannel: xccB,
+			SignerGroups:      []authtypes.SignerGroup{authtypes.NewSignerGroup(members, 2)},
+			CallInfo:          samplemodtypes.NewContractCallRequest("nop").ContractCallInfo(suite.chainB.App.AppCodec()),
+		},
+	}
+
+	msg := &initiatortypes.MsgInitiateTx{
+		ChainId:              suite.chainA.ChainID,
+		Nonce:                0,
+		CommitProtocol:       txtypes.COMMIT_PROTOCOL_SIMPLE,
+		ContractTransactions: txs,
+		Signers: []authtypes.Account{
+			authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+		},
+		TimeoutHeight: clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+	}
+	suite.Require().NoError(msg.ValidateBasic())
+	res0, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().InitiateTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, res0.Status)
+	suite.chainA.NextBlock()
+
+	// an invalid threshold is rejected
+	{
+		invalid := *msg
+		invalid.ContractTransactions = []initiatortypes.ContractTransaction{txs[0], txs[1]}
+		invalid.ContractTransactions[1].SignerGroups = []authtypes.SignerGroup{authtypes.NewSignerGroup(members, 4)}
+		suite.Require().Error(invalid.ValidateBasic())
+	}
+
+	signOnB := func(signer authtypes.Account) *sdk.Result {
+		ps := ibctesting.NewCapturePacketSender(
+			packets.NewBasicPacketSender(suite.chainB.App.IBCKeeper.ChannelKeeper),
+		)
+		suite.Require().NoError(suite.chainB.App.CrossKeeper.AuthKeeper().SendIBCSignTx(
+			suite.chainB.GetContext(),
+			ps,
+			&chBA,
+			res0.TxID,
+			[]authtypes.AccountID{signer.Id},
+			clienttypes.NewHeight(0, uint64(suite.chainB.CurrentHeader.Height)+100),
+			0,
+		))
+		suite.chainB.NextBlock()
+		p := ps.Packets()[0]
+		res, _, err := suite.chainA.App.CrossKeeper.AuthKeeper().HandlePacket(
+			suite.chainA.GetContext(),
+			channeltypes.Packet{DestinationPort: p.GetDestPort(), DestinationChannel: p.GetDestChannel()},
+			p,
+		)
+		suite.Require().NoError(err)
+		suite.chainA.NextBlock()
+		return res
+	}
+
+	res1 := signOnB(members[2])
+	{
+		ps, err := ibctesting.GetPacketsFromEvents(res1.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Len(ps, 0)
+
+		// members[0] and members[1] are still able to complete the tx
+		bySigner, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().PendingTxsBySigner(
+			sdk.WrapSDKContext(suite.chainA.GetContext()),
+			&initiatortypes.QueryPendingTxsBySignerRequest{SignerId: members[0].Id},
+		)
+		suite.Require().NoError(err)
+		suite.Require().Len(bySigner.Txs, 1)
+	}
+
+	res2 := signOnB(members[0])
+	{
+		ps, err := ibctesting.GetPacketsFromEvents(res2.GetEvents().ToABCIEvents())
+		suite.Require().NoError(err)
+		suite.Require().Len(ps, 1)
+
+		// the signers of the tx are the members that actually signed
+		ip, err := packets.UnmarshalIncomingPacket(suite.chainA.App.AppCodec(), ps[0])
+		suite.Require().NoError(err)
+		call := ip.Payload().(*simpletypes.PacketDataCall)
+		suite.Require().Len(call.Tx.Signers, 2)
+		suite.Require().Equal(members[0].Id, call.Tx.Signers[0].Id)
+		suite.Require().Equal(members[2].Id, call.Tx.Signers[1].Id)
+	}
+}
+
+func (suite *KeeperTestSuite) TestInitiateTxWithChainInfoSignerGroup() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelA, channelB := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+
+	chAB := xcctypes.ChannelInfo{Port: channelA.PortID, Channel: channelA.ID}
+	xccB, err := xcctypes.PackCrossChainChannel(&chAB)
+	suite.Require().NoError(err)
+	chBA := xcctypes.ChannelInfo{Port: channelB.PortID, Channel: channelB.ID}
+	suite.Require().NoError(suite.chainA.App.XCCResolver.(xcckeeper.Keeper).RegisterChannel(suite.chainA.GetContext(), suite.chainB.ChainID, chAB))
+
+	xccSelf, err := xcctypes.PackCrossChainChannel(suite.chainA.App.XCCResolver.GetSelfCrossChainChannel(suite.chainA.GetContext()))
+	suite.Require().NoError(err)
+
+	// 2-of-3 accounts on chainB addressed by the chain ID must sign the tx
+	var members []authtypes.Account
+	for i := 0; i < 3; i++ {
+		id := authtypes.AccountID(secp256k1.GenPrivKey().PubKey().Address())
+		members = append(members, authtypes.NewAccount(id, authtypes.NewAuthTypeChannel(xcctypes.NewChainInfo(suite.chainB.ChainID))))
+	}
+	msg := &initiatortypes.MsgInitiateTx{
+		ChainId:        suite.chainA.ChainID,
+		Nonce:          0,
+		CommitProtocol: txtypes.COMMIT_PROTOCOL_SIMPLE,
+		ContractTransactions: []initiatortypes.ContractTransaction{
+			{
+				CrossChainChannel: xccSelf,
+				Signers: []authtypes.Account{
+					authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+				},
+				CallInfo: samplemodtypes.NewContractCallRequest("nop").ContractCallInfo(suite.chainA.App.AppCodec()),
+			},
+			{
+				CrossChainChannel: xccB,
+				SignerGroups:      []authtypes.SignerGroup{authtypes.NewSignerGroup(members, 2)},
+				CallInfo:          samplemodtypes.NewContractCallRequest("nop").ContractCallInfo(suite.chainB.App.AppCodec()),
+			},
+		},
+		Signers: []authtypes.Account{
+			authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+		},
+		TimeoutHeight: clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+	}
+	suite.Require().NoError(msg.ValidateBasic())
+	ik := suite.chainA.App.CrossKeeper.InitiatorKeeper()
+	res0, err := ik.InitiateTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, res0.Status)
+	suite.chainA.NextBlock()
+
+	// the member is identified by the channel that receives its sign
+	targets, err := ik.GetSignTargets(suite.chainA.GetContext(), res0.TxID, authtypes.NewAccount(members[0].Id, authtypes.NewAuthTypeChannel(&chAB)))
+	suite.Require().NoError(err)
+	suite.Require().Len(targets, 1)
+	suite.Require().Equal([]byte(msg.ContractTransactions[1].CallInfo), []byte(targets[0].CallInfo))
+
+	signOnB := func(signer authtypes.Account) *sdk.Result {
+		ps := ibctesting.NewCapturePacketSender(
+			packets.NewBasicPacketSender(suite.chainB.App.IBCKeeper.ChannelKeeper),
+		)
+		suite.Require().NoError(suite.chainB.App.CrossKeeper.AuthKeeper().SendIBCSignTx(
+			suite.chainB.GetContext(),
+			ps,
+			&chBA,
+			res0.TxID,
+			[]authtypes.AccountID{signer.Id},
+			clienttypes.NewHeight(0, uint64(suite.chainB.CurrentHeader.Height)+100),
+			0,
+		))
+		suite.chainB.NextBlock()
+		p := ps.Packets()[0]
+		res, _, err := suite.chainA.App.CrossKeeper.AuthKeeper().HandlePacket(
+			suite.chainA.GetContext(),
+			channeltypes.Packet{DestinationPort: p.GetDestPort(), DestinationChannel: p.GetDestChannel()},
+			p,
+		)
+		suite.Require().NoError(err)
+		suite.chainA.NextBlock()
+		return res
+	}
+
+	signOnB(members[1])
+	res := signOnB(members[2])
+	ps, err := ibctesting.GetPacketsFromEvents(res.GetEvents().ToABCIEvents())
+	suite.Require().NoError(err)
+	suite.Require().Len(ps, 1)
+
+	// the signers of the tx are the members that actually signed
+	ip, err := packets.UnmarshalIncomingPacket(suite.chainA.App.AppCodec(), ps[0])
+	suite.Require().NoError(err)
+	call := ip.Payload().(*simpletypes.PacketDataCall)
+	suite.Require().Len(call.Tx.Signers, 2)
+	suite.Require().Equal(members[1].Id, call.Tx.Signers[0].Id)
+	suite.Require().Equal(members[2].Id, call.Tx.Signers[1].Id)
+}
+
+func (suite *KeeperTestSuite) TestSignTxByGrant() {
+	xccSelf, err := xcctypes.PackCrossChainChannel(suite.chainA.App.XCCResolver.GetSelfCrossChainChannel(suite.chainA.GetContext()))
+	suite.Require().NoError(err)
+	xccOther, err := xcctypes.PackCrossChainChannel(&xcctypes.ChannelInfo{Port: crosstypes.PortID, Channel: "channel-100"})
+	suite.Require().NoError(err)
+
+	granter := authtypes.AccountID(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())
+	callInfo := samplemodtypes.NewContractCallRequest("nop").ContractCallInfo(suite.chainA.App.AppCodec())
+
+	msg := &initiatortypes.MsgInitiateTx{
+		ChainId:        suite.chainA.ChainID,
+		Nonce:          0,
+		CommitProtocol: txtypes.COMMIT_PROTOCOL_SIMPLE,
+		ContractTransactions: []initiatortypes.ContractTransaction{
+			{
+				CrossChainChannel: xccSelf,
+				Signers:           []authtypes.Account{authtypes.NewLocalAccount(granter)},
+				CallInfo:          callInfo,
+			},
+		},
+		Signers:       []authtypes.Account{authtypes.NewLocalAccount(grantee)},
+		TimeoutHeight: clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+	}
+	suite.Require().NoError(msg.ValidateBasic())
+	res0, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().InitiateTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, res0.Status)
+	suite.chainA.NextBlock()
+
+	ak := suite.chainA.App.CrossKeeper.AuthKeeper()
+	signTx := func() (*authtypes.MsgSignTxResponse, error) {
+		return ak.SignTx(
+			sdk.WrapSDKContext(suite.chainA.GetContext()),
+			&authtypes.MsgSignTx{TxID: res0.TxID, Signers: []authtypes.AccountID{grantee}, Granters: []authtypes.AccountID{granter}},
+		)
+	}
+	grant := func(anyXCC *codectypes.Any, prefix []byte, expiration uint64) error {
+		_, err := ak.GrantSign(
+			sdk.WrapSDKContext(suite.chainA.GetContext()),
+			authtypes.NewMsgGrantSign(granter, grantee, anyXCC, prefix, expiration),
+		)
+		return err
+	}
+
+	// the grantee cannot sign without any grants
+	_, err = signTx()
+	suite.Require().Error(err)
+
+	// an expired grant is rejected
+	suite.Require().Error(grant(nil, nil, uint64(suite.chainA.GetContext().BlockTime().Unix())))
+
+	// the grant restricts the call info
+	suite.Require().NoError(grant(nil, []byte("other"), 0))
+	_, err = signTx()
+	suite.Require().Error(err)
+
+	// the grant restricts the cross chain channel
+	suite.Require().NoError(grant(xccOther, nil, 0))
+	_, err = signTx()
+	suite.Require().Error(err)
+
+	// the grant is revoked
+	_, err = ak.RevokeSignGrant(sdk.WrapSDKContext(suite.chainA.GetContext()), authtypes.NewMsgRevokeSignGrant(granter, grantee))
+	suite.Require().NoError(err)
+	suite.Require().Len(ak.GetSignGrants(suite.chainA.GetContext(), granter), 0)
+	_, err = signTx()
+	suite.Require().Error(err)
+
+	expiration := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).Unix())
+	suite.Require().NoError(grant(xccSelf, callInfo, expiration))
+	grants, err := ak.SignGrants(sdk.WrapSDKContext(suite.chainA.GetContext()), &authtypes.QuerySignGrantsRequest{Granter: granter})
+	suite.Require().NoError(err)
+	suite.Require().Len(grants.Grants, 1)
+	suite.Require().Equal(grantee, grants.Grants[0].Grantee)
+
+	// the grantee signs the tx on behalf of the granter
+	res1, err := signTx()
+	suite.Require().NoError(err)
+	suite.Require().True(res1.TxAuthCompleted)
+}
+
+func (suite *KeeperTestSuite) TestGetParamsWithMissingParam() {
+	ctx := suite.chainA.GetContext()
+	params := suite.chainA.App.CrossKeeper.GetParams(ctx)
+	params.ThreePhaseCommitTimeout = txtypes.DefaultThreePhaseCommitTimeout * 2
+	params.MaxCallResults = 1
+	suite.chainA.App.CrossKeeper.SetParams(ctx, params)
+
+	// a chain upgraded from the version without the param doesn't have it in the store
+	store := prefix.NewStore(ctx.KVStore(suite.chainA.App.GetKey(paramstypes.StoreKey)), append([]byte(crosstypes.ModuleName), '/'))
+	suite.Require().True(store.Has(txtypes.KeyThreePhaseCommitTimeout))
+	store.Delete(txtypes.KeyThreePhaseCommitTimeout)
+
+	var res txtypes.Params
+	suite.Require().NotPanics(func() {
+		res = suite.chainA.App.CrossKeeper.GetParams(ctx)
+	})
+	suite.Require().Equal(txtypes.DefaultThreePhaseCommitTimeout, res.ThreePhaseCommitTimeout)
+	suite.Require().Equal(uint32(1), res.MaxCallResults)
+	suite.Require().Equal(txtypes.DefaultThreePhaseCommitTimeout, suite.chainA.App.AtomicKeeper.ThreePCKeeper().GetParams(ctx).ThreePhaseCommitTimeout)
+}
+
+func (suite *KeeperTestSuite) TestInitiateTxParams() {
+	xccSelf, err := xcctypes.PackCrossChainChannel(suite.chainA.App.XCCResolver.GetSelfCrossChainChannel(suite.chainA.GetContext()))
+	suite.Require().NoError(err)
+
+	// the tx keeps pending until the other signer signs it
+	other := authtypes.NewLocalAccount(authtypes.AccountID(secp256k1.GenPrivKey().PubKey().Address()))
+	newMsg := func(links ...[]initiatortypes.Link) *initiatortypes.MsgInitiateTx {
+		var txs []initiatortypes.ContractTransaction
+		for _, lks := range links {
+			txs = append(txs, initiatortypes.ContractTransaction{
+				CrossChainChannel: xccSelf,
+				Signers:           []authtypes.Account{other},
+				CallInfo:          samplemodtypes.NewContractCallRequest("nop").ContractCallInfo(suite.chainA.App.AppCodec()),
+				Links:             lks,
+			})
+		}
+		msg := &initiatortypes.MsgInitiateTx{
+			ChainId:              suite.chainA.ChainID,
+			CommitProtocol:       txtypes.COMMIT_PROTOCOL_TPC,
+			ContractTransactions: txs,
+			Signers: []authtypes.Account{
+				authtypes.NewLocalAccount(authtypes.AccountID(suite.chainA.SenderAccount.GetAddress())),
+			},
+			TimeoutHeight: clienttypes.NewHeight(0, uint64(suite.chainA.CurrentHeader.Height)+100),
+		}
+		suite.Require().NoError(msg.ValidateBasic())
+		return msg
+	}
+
+	cases := []struct {
+		name     string
+		malleate func(params *txtypes.Params)
+		msg      *initiatortypes.MsgInitiateTx
+		expPass  bool
+	}{
+		{"default params", func(params *txtypes.Params) {}, newMsg(nil, nil), true},
+		{"not allowed commit protocol", func(params *txtypes.Params) {
+			params.AllowedCommitProtocols = []txtypes.CommitProtocol{txtypes.COMMIT_PROTOCOL_SIMPLE}
+		}, newMsg(nil, nil), false},
+		{"too many contract transactions", func(params *txtypes.Params) {
+			params.MaxContractTransactions = 1
+		}, newMsg(nil, nil), false},
+		{"cross-chain calls are disabled", func(params *txtypes.Params) {
+			params.CrossChainCallsEnabled = false
+		}, newMsg(nil, []initiatortypes.Link{{SrcIndex: 0}}), false},
+		{"too many call results", func(params *txtypes.Params) {
+			params.MaxCallResults = 1
+		}, newMsg(nil, []initiatortypes.Link{{SrcIndex: 0}, {SrcIndex: 0}}), false},
+	}
+
+	for _, c := range cases {
+		suite.Run(c.name, func() {
+			ctx, _ := suite.chainA.GetContext().CacheContext()
+			params := suite.chainA.App.CrossKeeper.GetParams(ctx)
+			c.malleate(&params)
+			suite.Require().NoError(params.Validate())
+			suite.chainA.App.CrossKeeper.SetParams(ctx, params)
+
+			res, err := suite.chainA.App.CrossKeeper.InitiatorKeeper().InitiateTx(sdk.WrapSDKContext(ctx), c.msg)
+			if c.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(initiatortypes.INITIATE_TX_STATUS_PENDING, res.Status)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
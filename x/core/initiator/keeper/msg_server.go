@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	"github.com/datachainlab/cross/x/core/initiator/types"
 )
 
@@ -23,6 +26,8 @@ func (k Keeper) InitiateTx(goCtx context.Context, msg *types.MsgInitiateTx) (*ty
 		return nil, fmt.Errorf("the Msg is already timeout: current=%v timeout-height=%v", ctx.BlockHeight(), msg.TimeoutHeight)
 	} else if msg.TimeoutTimestamp > 0 && uint64(ctx.BlockTime().Unix()) >= msg.TimeoutTimestamp {
 		return nil, fmt.Errorf("the Msg is already timeout: current=%v timeout-timestamp=%v", ctx.BlockTime().Unix(), msg.TimeoutTimestamp)
+	} else if err := k.validateParams(ctx, msg); err != nil {
+		return nil, err
 	}
 
 	// Check if all participants sign the tx
@@ -46,3 +51,41 @@ func (k Keeper) InitiateTx(goCtx context.Context, msg *types.MsgInitiateTx) (*ty
 	}
 	return &types.MsgInitiateTxResponse{TxID: txID, Status: types.INITIATE_TX_STATUS_VERIFIED}, nil
 }
+
+// CancelTx defines a rpc handler method for MsgCancelTx.
+func (k Keeper) CancelTx(goCtx context.Context, msg *types.MsgCancelTx) (*types.MsgCancelTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	txState, found := k.getTxState(ctx, msg.TxID)
+	if !found {
+		return nil, fmt.Errorf("txID '%x' not found", msg.TxID)
+	} else if txState.Status != types.INITIATE_TX_STATUS_PENDING {
+		return nil, fmt.Errorf("txID '%x' cannot be cancelled: status=%v", msg.TxID, txState.Status)
+	}
+	if !isLocalSigner(txState.Msg.Signers, msg.Signer) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "'%v' is not a signer of txID '%x'", msg.Signer.AccAddress(), msg.TxID)
+	}
+
+	if err := k.authenticator.DeleteAuthState(ctx, msg.TxID); err != nil {
+		return nil, err
+	}
+	k.deleteTxTimeout(ctx, msg.TxID, &txState.Msg)
+	k.deleteTxState(ctx, msg.TxID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTxCancelled,
+			sdk.NewAttribute(types.AttributeKeyTxID, hex.EncodeToString(msg.TxID)),
+		),
+	)
+	return &types.MsgCancelTxResponse{}, nil
+}
+
+func isLocalSigner(signers []authtypes.Account, id authtypes.AccountID) bool {
+	for _, s := range signers {
+		if s.AuthType.Mode == authtypes.AuthMode_AUTH_MODE_LOCAL && bytes.Equal(s.Id, id) {
+			return true
+		}
+	}
+	return false
+}
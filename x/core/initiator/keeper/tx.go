@@ -5,6 +5,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	"github.com/datachainlab/cross/x/core/initiator/types"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
 	crosstypes "github.com/datachainlab/cross/x/core/types"
@@ -21,7 +23,7 @@ func (k Keeper) initTx(ctx sdk.Context, msg *types.MsgInitiateTx) (crosstypes.Tx
 
 	state := types.NewInitiateTxState(*msg)
 
-	if err := k.authenticator.InitAuthState(ctx, txID, msg.GetRequiredAccounts()); err != nil {
+	if err := k.authenticator.InitAuthStateWithSignerGroups(ctx, txID, msg.GetRequiredAccounts(), msg.GetRequiredSignerGroups()); err != nil {
 		return nil, false, err
 	}
 
@@ -35,6 +37,9 @@ func (k Keeper) initTx(ctx sdk.Context, msg *types.MsgInitiateTx) (crosstypes.Tx
 	}
 
 	k.setTxState(ctx, txID, state)
+	if !completed {
+		k.setTxTimeout(ctx, txID, msg)
+	}
 	return txID, completed, nil
 }
 
@@ -58,13 +63,45 @@ func (k Keeper) getTxState(ctx sdk.Context, txID crosstypes.TxID) (*types.Initia
 	return &state, true
 }
 
+func (k Keeper) deleteTxState(ctx sdk.Context, txID crosstypes.TxID) {
+	prefix.NewStore(k.store(ctx), types.KeyInitiateTxState()).Delete(txID)
+}
+
+// getTxStates returns a page of InitiateTxStates that match a given filter
+func (k Keeper) getTxStates(ctx sdk.Context, pageReq *query.PageRequest, filter func(crosstypes.TxID, *types.InitiateTxState) (bool, error)) ([]types.IdentifiedInitiateTxState, *query.PageResponse, error) {
+	var states []types.IdentifiedInitiateTxState
+	store := prefix.NewStore(k.store(ctx), types.KeyInitiateTxState())
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		var state types.InitiateTxState
+		if err := proto.Unmarshal(value, &state); err != nil {
+			return false, err
+		}
+		txID := append(crosstypes.TxID{}, key...)
+		if ok, err := filter(txID, &state); err != nil || !ok {
+			return false, err
+		}
+		if accumulate {
+			states = append(states, types.IdentifiedInitiateTxState{TxId: txID, TxState: state})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return states, pageRes, nil
+}
+
 func (k Keeper) runTx(ctx sdk.Context, txID crosstypes.TxID, msg *types.MsgInitiateTx) error {
 	wctx, ps, err := k.packetMiddleware.HandleMsg(ctx, msg, packets.NewBasicPacketSender(k.channelKeeper))
 	if err != nil {
 		return err
 	}
 
-	rtxs, err := k.ResolveTransactions(wctx, msg.ContractTransactions)
+	ctxs, err := k.resolveSigners(ctx, txID, msg.ContractTransactions)
+	if err != nil {
+		return err
+	}
+	rtxs, err := k.ResolveTransactions(wctx, ctxs)
 	if err != nil {
 		return err
 	}
@@ -77,8 +114,61 @@ func (k Keeper) runTx(ctx sdk.Context, txID crosstypes.TxID, msg *types.MsgIniti
 	}
 }
 
+// resolveSigners returns the contract transactions whose signers are replaced with the accounts that actually signed the tx
+// Each signer group is replaced with its members that have signed.
+func (k Keeper) resolveSigners(ctx sdk.Context, txID crosstypes.TxID, ctxs []types.ContractTransaction) ([]types.ContractTransaction, error) {
+	var signed []authtypes.Account
+	for _, ct := range ctxs {
+		if len(ct.SignerGroups) > 0 {
+			var err error
+			if signed, err = k.authenticator.GetSignedSigners(ctx, txID); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	var rctxs []types.ContractTransaction
+	for _, ct := range ctxs {
+		if len(ct.SignerGroups) > 0 {
+			signers := append([]authtypes.Account{}, ct.Signers...)
+			for _, g := range ct.SignerGroups {
+				// the signed signers are normalized by the authenticator, so the members must be normalized to compare with them
+				members, err := authtypes.NormalizeChannelAccounts(ctx, k.m, k.xccResolver, g.Members)
+				if err != nil {
+					return nil, err
+				}
+				for i, m := range members {
+					if containsAccount(signed, m) {
+						signers = append(signers, g.Members[i])
+					}
+				}
+			}
+			ct.Signers = signers
+			ct.SignerGroups = nil
+		}
+		rctxs = append(rctxs, ct)
+	}
+	return rctxs, nil
+}
+
+// validateParams checks if a given msg satisfies the limits of the cross parameters
+func (k Keeper) validateParams(ctx sdk.Context, msg *types.MsgInitiateTx) error {
+	params := k.GetParams(ctx)
+	if !params.IsAllowedCommitProtocol(msg.CommitProtocol) {
+		return fmt.Errorf("the commit protocol '%v' is not allowed", msg.CommitProtocol)
+	} else if err := params.ValidateContractTransactions(len(msg.ContractTransactions)); err != nil {
+		return err
+	}
+	for i, ct := range msg.ContractTransactions {
+		if err := params.ValidateCallResults(len(ct.Links)); err != nil {
+			return fmt.Errorf("txIndex=%v: %w", i, err)
+		}
+	}
+	return nil
+}
+
 func (k Keeper) ResolveTransactions(ctx sdk.Context, ctxs []types.ContractTransaction) ([]txtypes.ResolvedContractTransaction, error) {
-	lkr, err := types.MakeLinker(k.m, k.xccResolver, ctxs)
+	lkr, err := types.MakeLinker(k.m, k.xccResolver, k.GetParams(ctx), ctxs)
 	if err != nil {
 		return nil, err
 	}
@@ -99,11 +189,12 @@ func (k Keeper) ResolveTransactions(ctx sdk.Context, ctxs []types.ContractTransa
 			return nil, err
 		}
 		rt := txtypes.ResolvedContractTransaction{
-			CrossChainChannel: ct.CrossChainChannel,
-			Signers:           ct.Signers,
-			CallInfo:          ct.CallInfo,
-			ReturnValue:       ct.ReturnValue,
-			CallResults:       anyResults,
+			CrossChainChannel:    ct.CrossChainChannel,
+			Signers:              ct.Signers,
+			CallInfo:             ct.CallInfo,
+			ReturnValue:          ct.ReturnValue,
+			CallResults:          anyResults,
+			CompensationCallInfo: ct.CompensationCallInfo,
 		}
 		rtxs = append(rtxs, rt)
 	}
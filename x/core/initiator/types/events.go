@@ -0,0 +1,9 @@
+package types
+
+// initiator module event types
+const (
+	EventTypeTxExpired   = "tx_expired"
+	EventTypeTxCancelled = "tx_cancelled"
+
+	AttributeKeyTxID = "tx_id"
+)
@@ -1,8 +1,13 @@
 package types
 
-// NewGenesisState creates a new cross module GenesisState instance.
-func NewGenesisState() *GenesisState {
-	return &GenesisState{}
+import (
+	"bytes"
+	"fmt"
+)
+
+// NewGenesisState creates a new initiator GenesisState instance.
+func NewGenesisState(txStates []IdentifiedInitiateTxState) *GenesisState {
+	return &GenesisState{TxStates: txStates}
 }
 
 // DefaultGenesis returns a GenesisState instance
@@ -13,5 +18,17 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	txIDs := make(map[string]bool)
+	for _, s := range gs.TxStates {
+		if txIDs[string(s.TxId)] {
+			return fmt.Errorf("duplicate tx state: txID=%x", s.TxId)
+		}
+		txIDs[string(s.TxId)] = true
+		if s.TxState.Status == INITIATE_TX_STATUS_UNKNOWN {
+			return fmt.Errorf("tx state has an unknown status: txID=%x", s.TxId)
+		} else if txID := MakeTxID(&s.TxState.Msg); !bytes.Equal(txID, s.TxId) {
+			return fmt.Errorf("txID doesn't match the msg: expected=%x actual=%x", txID, s.TxId)
+		}
+	}
 	return nil
 }
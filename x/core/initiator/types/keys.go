@@ -1,13 +1,23 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+)
 
 const SubModuleName = "initiator"
 
 const (
 	KeyInitiateTxStatePrefix uint8 = iota
+	KeyTxTimeoutHeightPrefix
+	KeyTxTimeoutTimestampPrefix
 )
 
+// MaxExpiredTxsPerBlock is the maximum number of timeout entries that are processed in a block
+const MaxExpiredTxsPerBlock = 100
+
 // KeyPrefixBytes return the key prefix bytes from a URL string format
 func KeyPrefixBytes(prefix uint8) []byte {
 	return []byte(fmt.Sprintf("%d/", prefix))
@@ -16,3 +26,25 @@ func KeyPrefixBytes(prefix uint8) []byte {
 func KeyInitiateTxState() []byte {
 	return KeyPrefixBytes(KeyInitiateTxStatePrefix)
 }
+
+// KeyTxTimeoutHeight returns a key of the timeout index by height
+func KeyTxTimeoutHeight(height uint64, txID crosstypes.TxID) []byte {
+	return append(
+		append(
+			KeyPrefixBytes(KeyTxTimeoutHeightPrefix),
+			sdk.Uint64ToBigEndian(height)...,
+		),
+		txID...,
+	)
+}
+
+// KeyTxTimeoutTimestamp returns a key of the timeout index by timestamp
+func KeyTxTimeoutTimestamp(timestamp uint64, txID crosstypes.TxID) []byte {
+	return append(
+		append(
+			KeyPrefixBytes(KeyTxTimeoutTimestampPrefix),
+			sdk.Uint64ToBigEndian(timestamp)...,
+		),
+		txID...,
+	)
+}
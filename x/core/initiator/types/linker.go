@@ -19,12 +19,14 @@ import (
 type Linker struct {
 	cdc                       codec.Codec
 	crossChainChannelResolver xcctypes.XCCResolver
+	params                    txtypes.Params
 	objects                   map[crosstypes.TxIndex]lazyObject
 }
 
 // MakeLinker returns Linker
-func MakeLinker(cdc codec.Codec, xccResolver xcctypes.XCCResolver, txs []ContractTransaction) (*Linker, error) {
-	lkr := Linker{cdc: cdc, crossChainChannelResolver: xccResolver, objects: make(map[crosstypes.TxIndex]lazyObject, len(txs))}
+// The links are resolved within the limits of given params.
+func MakeLinker(cdc codec.Codec, xccResolver xcctypes.XCCResolver, params txtypes.Params, txs []ContractTransaction) (*Linker, error) {
+	lkr := Linker{cdc: cdc, crossChainChannelResolver: xccResolver, params: params, objects: make(map[crosstypes.TxIndex]lazyObject, len(txs))}
 	for i, tx := range txs {
 		idx := crosstypes.TxIndex(i)
 		tx := tx
@@ -45,6 +47,9 @@ func MakeLinker(cdc codec.Codec, xccResolver xcctypes.XCCResolver, txs []Contrac
 
 // Resolve resolves given links and returns resolved Object
 func (lkr Linker) Resolve(ctx sdk.Context, callerc xcctypes.XCC, lks []Link) ([]txtypes.CallResult, error) {
+	if err := lkr.params.ValidateCallResults(len(lks)); err != nil {
+		return nil, err
+	}
 	var results []txtypes.CallResult
 	for _, lk := range lks {
 		idx := lk.GetSrcIndex()
@@ -17,10 +17,12 @@ import (
 // msg types
 const (
 	TypeInitiateTx = "InitiateTx"
+	TypeCancelTx   = "CancelTx"
 )
 
 var (
 	_ sdk.Msg                            = (*MsgInitiateTx)(nil)
+	_ sdk.Msg                            = (*MsgCancelTx)(nil)
 	_ authtypes.ExtAuthMsg               = (*MsgInitiateTx)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgInitiateTx)(nil)
 )
@@ -58,6 +60,13 @@ func (msg MsgInitiateTx) ValidateBasic() error {
 	if len(msg.Signers) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing signer address")
 	}
+	for i, tx := range msg.ContractTransactions {
+		for _, g := range tx.SignerGroups {
+			if err := g.ValidateBasic(); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid signer group in contract transaction %v: %v", i, err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -99,6 +108,15 @@ func (msg MsgInitiateTx) GetRequiredAccounts() []authtypes.Account {
 	return accs
 }
 
+// GetRequiredSignerGroups returns the signer groups of all contract transactions
+func (msg MsgInitiateTx) GetRequiredSignerGroups() []authtypes.SignerGroup {
+	var groups []authtypes.SignerGroup
+	for _, tx := range msg.ContractTransactions {
+		groups = append(groups, tx.SignerGroups...)
+	}
+	return groups
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage
 func (msg *MsgInitiateTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, tx := range msg.ContractTransactions {
@@ -114,6 +132,46 @@ func (msg *MsgInitiateTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) erro
 	return nil
 }
 
+// NewMsgCancelTx creates a new MsgCancelTx instance
+func NewMsgCancelTx(txID crosstypes.TxID, signer authtypes.AccountID) *MsgCancelTx {
+	return &MsgCancelTx{
+		TxID:   txID,
+		Signer: signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgCancelTx) Route() string {
+	return crosstypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgCancelTx) Type() string {
+	return TypeCancelTx
+}
+
+// ValidateBasic performs a basic check of the MsgCancelTx fields.
+func (msg MsgCancelTx) ValidateBasic() error {
+	if len(msg.TxID) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "txID must not be empty")
+	}
+	if len(msg.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing signer address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgCancelTx) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCancelTx) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer.AccAddress()}
+}
+
 var _ codectypes.UnpackInterfacesMessage = (*ContractTransaction)(nil)
 
 func (tx *ContractTransaction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
@@ -125,6 +183,11 @@ func (tx *ContractTransaction) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 			return err
 		}
 	}
+	for _, g := range tx.SignerGroups {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
 	types2 "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	github_com_datachainlab_cross_x_core_auth_types "github.com/datachainlab/cross/x/core/auth/types"
 	types1 "github.com/datachainlab/cross/x/core/auth/types"
 	types "github.com/datachainlab/cross/x/core/tx/types"
 	github_com_datachainlab_cross_x_core_types "github.com/datachainlab/cross/x/core/types"
@@ -39,18 +40,22 @@ const (
 	INITIATE_TX_STATUS_UNKNOWN  InitiateTxStatus = 0
 	INITIATE_TX_STATUS_PENDING  InitiateTxStatus = 1
 	INITIATE_TX_STATUS_VERIFIED InitiateTxStatus = 2
+	// INITIATE_TX_STATUS_EXPIRED indicates that the tx has passed its timeout before all signers signed it
+	INITIATE_TX_STATUS_EXPIRED InitiateTxStatus = 3
 )
 
 var InitiateTxStatus_name = map[int32]string{
 	0: "INITIATE_TX_STATUS_UNKNOWN",
 	1: "INITIATE_TX_STATUS_PENDING",
 	2: "INITIATE_TX_STATUS_VERIFIED",
+	3: "INITIATE_TX_STATUS_EXPIRED",
 }
 
 var InitiateTxStatus_value = map[string]int32{
 	"INITIATE_TX_STATUS_UNKNOWN":  0,
 	"INITIATE_TX_STATUS_PENDING":  1,
 	"INITIATE_TX_STATUS_VERIFIED": 2,
+	"INITIATE_TX_STATUS_EXPIRED":  3,
 }
 
 func (x InitiateTxStatus) String() string {
@@ -67,10 +72,12 @@ type MsgInitiateTx struct {
 	CommitProtocol       types.CommitProtocol  `protobuf:"varint,3,opt,name=commit_protocol,json=commitProtocol,proto3,enum=cross.core.tx.CommitProtocol" json:"commit_protocol,omitempty"`
 	ContractTransactions []ContractTransaction `protobuf:"bytes,4,rep,name=contract_transactions,json=contractTransactions,proto3" json:"contract_transactions"`
 	Signers              []types1.Account      `protobuf:"bytes,5,rep,name=signers,proto3" json:"signers"`
-	// Timeout height relative to the current block height.
+	// Timeout height of the initiator chain, which is also the coordinator chain.
+	// It is not applied to the packets sent to the participants since they are timed out with the height of the counterparty chain.
 	// The timeout is disabled when set to 0.
 	TimeoutHeight types2.Height `protobuf:"bytes,6,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
-	// Timeout timestamp (in nanoseconds) relative to the current block timestamp.
+	// Timeout timestamp (in seconds since the Unix epoch).
+	// It is also applied to the packets sent to the participants after being converted to nanoseconds.
 	// The timeout is disabled when set to 0.
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
 }
@@ -147,57 +154,141 @@ func (m *MsgInitiateTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInitiateTxResponse proto.InternalMessageInfo
 
+// MsgCancelTx defines a msg to cancel a pending tx initiated by MsgInitiateTx.
+type MsgCancelTx struct {
+	TxID github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=txID,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"txID,omitempty"`
+	// signer must be one of the local signers of the original MsgInitiateTx
+	Signer github_com_datachainlab_cross_x_core_auth_types.AccountID `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/datachainlab/cross/x/core/auth/types.AccountID" json:"signer,omitempty"`
+}
+
+func (m *MsgCancelTx) Reset()         { *m = MsgCancelTx{} }
+func (m *MsgCancelTx) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTx) ProtoMessage()    {}
+func (*MsgCancelTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_316bc286678ddff1, []int{2}
+}
+func (m *MsgCancelTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTx.Merge(m, src)
+}
+func (m *MsgCancelTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTx proto.InternalMessageInfo
+
+// MsgCancelTxResponse defines the Msg/CancelTx response type.
+type MsgCancelTxResponse struct {
+}
+
+func (m *MsgCancelTxResponse) Reset()         { *m = MsgCancelTxResponse{} }
+func (m *MsgCancelTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTxResponse) ProtoMessage()    {}
+func (*MsgCancelTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_316bc286678ddff1, []int{3}
+}
+func (m *MsgCancelTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTxResponse.Merge(m, src)
+}
+func (m *MsgCancelTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTxResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cross.core.initiator.InitiateTxStatus", InitiateTxStatus_name, InitiateTxStatus_value)
 	proto.RegisterType((*MsgInitiateTx)(nil), "cross.core.initiator.MsgInitiateTx")
 	proto.RegisterType((*MsgInitiateTxResponse)(nil), "cross.core.initiator.MsgInitiateTxResponse")
+	proto.RegisterType((*MsgCancelTx)(nil), "cross.core.initiator.MsgCancelTx")
+	proto.RegisterType((*MsgCancelTxResponse)(nil), "cross.core.initiator.MsgCancelTxResponse")
 }
 
 func init() { proto.RegisterFile("cross/core/initiator/msgs.proto", fileDescriptor_316bc286678ddff1) }
 
 var fileDescriptor_316bc286678ddff1 = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xed, 0x26, 0xfd, 0xf3, 0xdb, 0xfe, 0x1a, 0xc2, 0x2a, 0x95, 0x5c, 0x97, 0xda, 0x51,
-	0x90, 0x50, 0x00, 0xc9, 0x56, 0x53, 0x0e, 0xa8, 0x07, 0xa4, 0xa6, 0x49, 0x8b, 0x85, 0x1a, 0x2a,
-	0xd7, 0x05, 0xc4, 0x01, 0xb3, 0xd9, 0x18, 0x67, 0xa5, 0xd8, 0x1b, 0x79, 0x37, 0x55, 0xf2, 0x06,
-	0x3d, 0xf2, 0x08, 0x95, 0xb8, 0xf1, 0x24, 0x3d, 0xf6, 0xc8, 0x29, 0x40, 0x7b, 0xe1, 0xdc, 0x23,
-	0x27, 0x94, 0xb5, 0xd3, 0xc4, 0x25, 0x48, 0x3d, 0x79, 0x67, 0xe6, 0x33, 0x5f, 0x8f, 0x67, 0x66,
-	0x0d, 0x74, 0x1c, 0x51, 0xc6, 0x4c, 0x4c, 0x23, 0xcf, 0x24, 0x21, 0xe1, 0x04, 0x71, 0x1a, 0x99,
-	0x01, 0xf3, 0x99, 0xd1, 0x8d, 0x28, 0xa7, 0xb0, 0x20, 0x00, 0x63, 0x04, 0x18, 0x37, 0x80, 0xba,
-	0xe6, 0x53, 0xea, 0x77, 0x3c, 0x53, 0x30, 0xcd, 0xde, 0x27, 0x13, 0x85, 0x83, 0x38, 0x41, 0x2d,
-	0xf8, 0xd4, 0xa7, 0xe2, 0x68, 0x8e, 0x4e, 0x89, 0x57, 0x27, 0x4d, 0x1c, 0xbf, 0x05, 0x77, 0x88,
-	0x17, 0x72, 0xf3, 0x64, 0x33, 0x39, 0x25, 0x40, 0x71, 0x66, 0x21, 0x7c, 0xd0, 0xf5, 0x92, 0x4a,
-	0xd4, 0xb5, 0x29, 0x82, 0xf7, 0x53, 0xa1, 0xf5, 0xa9, 0x10, 0xea, 0xf1, 0xf6, 0x74, 0xb0, 0xf4,
-	0x3d, 0x03, 0x56, 0x0e, 0x98, 0x6f, 0xc5, 0xa2, 0x9e, 0xd3, 0x87, 0x6b, 0x60, 0x09, 0xb7, 0x11,
-	0x09, 0x5d, 0xd2, 0x52, 0xe4, 0xa2, 0x5c, 0xfe, 0xcf, 0x5e, 0x14, 0xb6, 0xd5, 0x82, 0x05, 0x30,
-	0x1f, 0xd2, 0x10, 0x7b, 0xca, 0x5c, 0x51, 0x2e, 0x67, 0xed, 0xd8, 0x80, 0x7b, 0xe0, 0x1e, 0xa6,
-	0x41, 0x40, 0xb8, 0x2b, 0x24, 0x31, 0xed, 0x28, 0x99, 0xa2, 0x5c, 0xce, 0x55, 0x36, 0x8c, 0xa9,
-	0xf6, 0xf0, 0xbe, 0xb1, 0x2b, 0xa8, 0xc3, 0x04, 0xb2, 0x73, 0x38, 0x65, 0xc3, 0x16, 0x58, 0xc5,
-	0x34, 0xe4, 0x11, 0xc2, 0xdc, 0xe5, 0x11, 0x0a, 0x19, 0xc2, 0x9c, 0xd0, 0x90, 0x29, 0xd9, 0x62,
-	0xa6, 0xbc, 0x5c, 0x79, 0x6c, 0xcc, 0x6a, 0xb6, 0xb1, 0x9b, 0xa4, 0x38, 0x93, 0x8c, 0x6a, 0xf6,
-	0x7c, 0xa8, 0x4b, 0x76, 0x01, 0xff, 0x1d, 0x62, 0xf0, 0x39, 0x58, 0x64, 0xc4, 0x0f, 0xbd, 0x88,
-	0x29, 0xf3, 0x42, 0x57, 0x99, 0xd6, 0x1d, 0xf5, 0xc7, 0xd8, 0xc1, 0x98, 0xf6, 0x42, 0x9e, 0xc8,
-	0x8c, 0x71, 0xf8, 0x11, 0xe4, 0x38, 0x09, 0x3c, 0xda, 0xe3, 0x6e, 0xdb, 0x23, 0x7e, 0x9b, 0x2b,
-	0x0b, 0x45, 0xb9, 0xbc, 0x5c, 0x51, 0x0d, 0xd2, 0xc4, 0x71, 0x7a, 0x32, 0xb4, 0x93, 0x4d, 0xe3,
-	0xa5, 0x20, 0xaa, 0x1b, 0x23, 0x89, 0xeb, 0xa1, 0xbe, 0x3a, 0x40, 0x41, 0x67, 0xbb, 0x94, 0xce,
-	0x2f, 0xd9, 0x2b, 0x89, 0x23, 0xa6, 0xa1, 0x05, 0xee, 0x8f, 0x89, 0xd1, 0x93, 0x71, 0x14, 0x74,
-	0x95, 0xc5, 0x51, 0xaf, 0xab, 0x0f, 0xae, 0x87, 0xba, 0x92, 0x16, 0xb9, 0x41, 0x4a, 0x76, 0x3e,
-	0xf1, 0x39, 0x63, 0xd7, 0xf6, 0xd2, 0xe9, 0x99, 0x2e, 0xfd, 0x3a, 0xd3, 0xa5, 0xd2, 0x57, 0x19,
-	0xac, 0xa6, 0x26, 0x6c, 0x7b, 0xac, 0x4b, 0x43, 0xe6, 0xc1, 0x7d, 0x90, 0xe5, 0x7d, 0xab, 0x26,
-	0xa6, 0xfc, 0x7f, 0x75, 0xeb, 0xf7, 0x50, 0x37, 0x7d, 0xc2, 0xdb, 0xbd, 0xa6, 0x81, 0x69, 0x60,
-	0xb6, 0x10, 0x47, 0x62, 0xf0, 0x1d, 0xd4, 0x34, 0xe3, 0x15, 0xea, 0x27, 0xfb, 0x25, 0xf6, 0xc7,
-	0xe9, 0x5b, 0x35, 0x5b, 0x08, 0xc0, 0x17, 0x60, 0x81, 0x71, 0xc4, 0x7b, 0x4c, 0x2c, 0x46, 0xae,
-	0xf2, 0x68, 0xf6, 0xa8, 0x26, 0x25, 0x1c, 0x09, 0xda, 0x4e, 0xb2, 0x26, 0xc5, 0x3e, 0x19, 0x80,
-	0xfc, 0x6d, 0x0a, 0x6a, 0x40, 0xb5, 0x1a, 0x96, 0x63, 0xed, 0x38, 0x75, 0xd7, 0x79, 0xe7, 0x1e,
-	0x39, 0x3b, 0xce, 0xf1, 0x91, 0x7b, 0xdc, 0x78, 0xd5, 0x78, 0xfd, 0xb6, 0x91, 0x97, 0xfe, 0x11,
-	0x3f, 0xac, 0x37, 0x6a, 0x56, 0x63, 0x3f, 0x2f, 0x43, 0x1d, 0xac, 0xcf, 0x88, 0xbf, 0xa9, 0xdb,
-	0xd6, 0x9e, 0x55, 0xaf, 0xe5, 0xe7, 0xd4, 0xec, 0xe9, 0x17, 0x4d, 0xaa, 0x78, 0x20, 0x73, 0xc0,
-	0x7c, 0xf8, 0x01, 0x80, 0xa9, 0xcb, 0xf0, 0x70, 0xf6, 0x97, 0xa4, 0xfa, 0xa9, 0x3e, 0xbd, 0x03,
-	0x34, 0x6e, 0x7a, 0xd5, 0x3e, 0xff, 0xa9, 0x49, 0xe7, 0x97, 0x9a, 0x7c, 0x71, 0xa9, 0xc9, 0x3f,
-	0x2e, 0x35, 0xf9, 0xf3, 0x95, 0x26, 0x5d, 0x5c, 0x69, 0xd2, 0xb7, 0x2b, 0x4d, 0x7a, 0xff, 0xec,
-	0x4e, 0x03, 0xb8, 0xf5, 0x0b, 0x68, 0x2e, 0x88, 0x8b, 0xb7, 0xf5, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x80, 0xf5, 0x7e, 0x3f, 0xb0, 0x04, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x6d, 0x12, 0x02, 0x3b, 0x40, 0x36, 0xeb, 0x0d, 0x92, 0x31, 0x8b, 0x9d, 0x4d, 0xa5,
+	0x2a, 0xb4, 0x92, 0x2d, 0x42, 0x0f, 0x2d, 0x52, 0x2b, 0x11, 0x12, 0xa8, 0x55, 0x91, 0x22, 0x63,
+	0x5a, 0xd4, 0x43, 0xd3, 0xc9, 0xc4, 0x75, 0x46, 0x4a, 0x3c, 0x51, 0x66, 0x82, 0xc2, 0x37, 0xe0,
+	0xd8, 0x6b, 0x6f, 0x48, 0xbd, 0xf5, 0x0b, 0xf4, 0xd8, 0x2b, 0x47, 0x8e, 0x3d, 0xa5, 0x2d, 0x5c,
+	0x7a, 0xe6, 0xc8, 0xa9, 0xca, 0x78, 0x12, 0x1c, 0x1a, 0x54, 0x0e, 0x3d, 0x65, 0xe6, 0xbd, 0xdf,
+	0xfb, 0xe7, 0xe9, 0xfd, 0x9f, 0x07, 0x18, 0xa8, 0x4d, 0x28, 0xb5, 0x10, 0x69, 0x7b, 0x16, 0x0e,
+	0x30, 0xc3, 0x90, 0x91, 0xb6, 0xd5, 0xa4, 0x3e, 0x35, 0x5b, 0x6d, 0xc2, 0x88, 0x92, 0xe6, 0x80,
+	0xd9, 0x07, 0xcc, 0x21, 0xa0, 0x2d, 0xf8, 0x84, 0xf8, 0x0d, 0xcf, 0xe2, 0x4c, 0xb5, 0xf3, 0xd6,
+	0x82, 0xc1, 0x61, 0x58, 0xa0, 0xa5, 0x7d, 0xe2, 0x13, 0x7e, 0xb4, 0xfa, 0x27, 0x11, 0x35, 0x70,
+	0x15, 0x85, 0xff, 0x82, 0x1a, 0xd8, 0x0b, 0x98, 0x75, 0xb0, 0x22, 0x4e, 0x02, 0xc8, 0x8c, 0x6d,
+	0x84, 0x1d, 0xb6, 0x3c, 0xd1, 0x89, 0xb6, 0x10, 0x21, 0x58, 0x77, 0x24, 0xb5, 0x18, 0x49, 0xc1,
+	0x0e, 0xab, 0x47, 0x93, 0xd9, 0xaf, 0x31, 0x30, 0xb7, 0x4d, 0x7d, 0x3b, 0x14, 0xf5, 0xdc, 0xae,
+	0xb2, 0x00, 0xa6, 0x51, 0x1d, 0xe2, 0xa0, 0x82, 0x6b, 0xaa, 0x9c, 0x91, 0x73, 0x7f, 0x39, 0x53,
+	0xfc, 0x6e, 0xd7, 0x94, 0x34, 0x98, 0x0c, 0x48, 0x80, 0x3c, 0x75, 0x22, 0x23, 0xe7, 0xe2, 0x4e,
+	0x78, 0x51, 0x36, 0xc1, 0xdf, 0x88, 0x34, 0x9b, 0x98, 0x55, 0xb8, 0x24, 0x22, 0x0d, 0x35, 0x96,
+	0x91, 0x73, 0xc9, 0xfc, 0x92, 0x19, 0x19, 0x0f, 0xeb, 0x9a, 0x1b, 0x9c, 0xda, 0x11, 0x90, 0x93,
+	0x44, 0x23, 0x77, 0xa5, 0x06, 0xe6, 0x11, 0x09, 0x58, 0x1b, 0x22, 0x56, 0x61, 0x6d, 0x18, 0x50,
+	0x88, 0x18, 0x26, 0x01, 0x55, 0xe3, 0x99, 0x58, 0x6e, 0x26, 0xbf, 0x6c, 0x8e, 0x1b, 0xb6, 0xb9,
+	0x21, 0x4a, 0xdc, 0xab, 0x8a, 0x42, 0xfc, 0xa4, 0x67, 0x48, 0x4e, 0x1a, 0xfd, 0x9a, 0xa2, 0xca,
+	0x43, 0x30, 0x45, 0xb1, 0x1f, 0x78, 0x6d, 0xaa, 0x4e, 0x72, 0x5d, 0x35, 0xaa, 0xdb, 0x9f, 0x8f,
+	0xb9, 0x8e, 0x10, 0xe9, 0x04, 0x4c, 0xc8, 0x0c, 0x70, 0xe5, 0x0d, 0x48, 0x32, 0xdc, 0xf4, 0x48,
+	0x87, 0x55, 0xea, 0x1e, 0xf6, 0xeb, 0x4c, 0x4d, 0x64, 0xe4, 0xdc, 0x4c, 0x5e, 0x33, 0x71, 0x15,
+	0x85, 0xe5, 0xc2, 0xb4, 0x83, 0x15, 0xf3, 0x29, 0x27, 0x0a, 0x4b, 0x7d, 0x89, 0x8b, 0x9e, 0x31,
+	0x7f, 0x08, 0x9b, 0x8d, 0xb5, 0xec, 0x68, 0x7d, 0xd6, 0x99, 0x13, 0x81, 0x90, 0x56, 0x6c, 0xf0,
+	0xcf, 0x80, 0xe8, 0xff, 0x52, 0x06, 0x9b, 0x2d, 0x75, 0xaa, 0x3f, 0xeb, 0xc2, 0x7f, 0x17, 0x3d,
+	0x43, 0x1d, 0x15, 0x19, 0x22, 0x59, 0x27, 0x25, 0x62, 0xee, 0x20, 0xb4, 0x36, 0x7d, 0x74, 0x6c,
+	0x48, 0x3f, 0x8e, 0x0d, 0x29, 0xfb, 0x51, 0x06, 0xf3, 0x23, 0x0e, 0x3b, 0x1e, 0x6d, 0x91, 0x80,
+	0x7a, 0xca, 0x16, 0x88, 0xb3, 0xae, 0x5d, 0xe4, 0x2e, 0xcf, 0x16, 0x56, 0x2f, 0x7b, 0x86, 0xe5,
+	0x63, 0x56, 0xef, 0x54, 0x4d, 0x44, 0x9a, 0x56, 0x0d, 0x32, 0xc8, 0x8d, 0x6f, 0xc0, 0xaa, 0x15,
+	0xae, 0x50, 0x57, 0xec, 0x17, 0xdf, 0x1f, 0xb7, 0x6b, 0x17, 0x1d, 0x2e, 0xa0, 0x3c, 0x01, 0x09,
+	0xca, 0x20, 0xeb, 0x50, 0xbe, 0x18, 0xc9, 0xfc, 0xdd, 0xf1, 0x56, 0x5d, 0xb5, 0xb0, 0xcb, 0x69,
+	0x47, 0x54, 0x45, 0x9a, 0xfd, 0x24, 0x83, 0x99, 0x6d, 0xea, 0x6f, 0xc0, 0x00, 0x79, 0x0d, 0xb7,
+	0xfb, 0xe7, 0x5a, 0xdc, 0x03, 0x89, 0xd0, 0x47, 0xde, 0xe2, 0x6c, 0xe1, 0xf1, 0x65, 0xcf, 0x78,
+	0x74, 0x2b, 0xa9, 0xc8, 0x27, 0x23, 0x16, 0xc3, 0x2e, 0x3a, 0x42, 0x2c, 0xd2, 0xb9, 0x01, 0xfe,
+	0x8d, 0x34, 0x3e, 0x98, 0xf1, 0x15, 0x70, 0xef, 0xbd, 0x0c, 0x52, 0xd7, 0x27, 0xa0, 0xe8, 0x40,
+	0xb3, 0xcb, 0xb6, 0x6b, 0xaf, 0xbb, 0xa5, 0x8a, 0xbb, 0x5f, 0xd9, 0x75, 0xd7, 0xdd, 0xbd, 0xdd,
+	0xca, 0x5e, 0xf9, 0x59, 0xf9, 0xf9, 0xcb, 0x72, 0x4a, 0xba, 0x21, 0xbf, 0x53, 0x2a, 0x17, 0xed,
+	0xf2, 0x56, 0x4a, 0x56, 0x0c, 0xb0, 0x38, 0x26, 0xff, 0xa2, 0xe4, 0xd8, 0x9b, 0x76, 0xa9, 0x98,
+	0x9a, 0xb8, 0x41, 0xa0, 0xb4, 0xbf, 0x63, 0x3b, 0xa5, 0x62, 0x2a, 0xa6, 0xc5, 0x8f, 0x3e, 0xe8,
+	0x52, 0xfe, 0xb3, 0x0c, 0x62, 0xdb, 0xd4, 0x57, 0x5e, 0x03, 0x10, 0x79, 0x09, 0xee, 0x8c, 0xb7,
+	0x71, 0x64, 0x99, 0xb4, 0xfb, 0xb7, 0x80, 0x86, 0x1b, 0xb7, 0x0f, 0xa6, 0x87, 0xd6, 0xfe, 0x7f,
+	0x63, 0xe1, 0x00, 0xd1, 0x96, 0x7f, 0x8b, 0x0c, 0x94, 0x0b, 0xce, 0xc9, 0x77, 0x5d, 0x3a, 0x39,
+	0xd3, 0xe5, 0xd3, 0x33, 0x5d, 0xfe, 0x76, 0xa6, 0xcb, 0xef, 0xce, 0x75, 0xe9, 0xf4, 0x5c, 0x97,
+	0xbe, 0x9c, 0xeb, 0xd2, 0xab, 0x07, 0xb7, 0x72, 0xfa, 0xda, 0xcb, 0x5a, 0x4d, 0xf0, 0xf7, 0x6c,
+	0xf5, 0xe7, 0x00, 0xaf, 0xf0, 0x3a, 0x83, 0x07, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -214,6 +305,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// InitiateTx defines a rpc handler method for MsgInitiateTx.
 	InitiateTx(ctx context.Context, in *MsgInitiateTx, opts ...grpc.CallOption) (*MsgInitiateTxResponse, error)
+	// CancelTx defines a rpc handler method for MsgCancelTx.
+	CancelTx(ctx context.Context, in *MsgCancelTx, opts ...grpc.CallOption) (*MsgCancelTxResponse, error)
 }
 
 type msgClient struct {
@@ -233,10 +326,21 @@ func (c *msgClient) InitiateTx(ctx context.Context, in *MsgInitiateTx, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelTx(ctx context.Context, in *MsgCancelTx, opts ...grpc.CallOption) (*MsgCancelTxResponse, error) {
+	out := new(MsgCancelTxResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.initiator.Msg/CancelTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// InitiateTx defines a rpc handler method for MsgInitiateTx.
 	InitiateTx(context.Context, *MsgInitiateTx) (*MsgInitiateTxResponse, error)
+	// CancelTx defines a rpc handler method for MsgCancelTx.
+	CancelTx(context.Context, *MsgCancelTx) (*MsgCancelTxResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -246,6 +350,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) InitiateTx(ctx context.Context, req *MsgInitiateTx) (*MsgInitiateTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitiateTx not implemented")
 }
+func (*UnimplementedMsgServer) CancelTx(ctx context.Context, req *MsgCancelTx) (*MsgCancelTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTx not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -269,6 +376,24 @@ func _Msg_InitiateTx_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.initiator.Msg/CancelTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTx(ctx, req.(*MsgCancelTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cross.core.initiator.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -277,6 +402,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InitiateTx",
 			Handler:    _Msg_InitiateTx_Handler,
 		},
+		{
+			MethodName: "CancelTx",
+			Handler:    _Msg_CancelTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cross/core/initiator/msgs.proto",
@@ -400,6 +529,66 @@ func (m *MsgInitiateTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -463,6 +652,32 @@ func (m *MsgInitiateTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -818,6 +1033,180 @@ func (m *MsgInitiateTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = append(m.TxID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxID == nil {
+				m.TxID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
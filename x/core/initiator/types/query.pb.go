@@ -7,6 +7,9 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_datachainlab_cross_x_core_auth_types "github.com/datachainlab/cross/x/core/auth/types"
+	github_com_datachainlab_cross_x_core_types "github.com/datachainlab/cross/x/core/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -110,35 +113,353 @@ func (m *QuerySelfXCCResponse) GetXcc() *types.Any {
 	return nil
 }
 
+type QueryTxStateRequest struct {
+	TxId github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+}
+
+func (m *QueryTxStateRequest) Reset()         { *m = QueryTxStateRequest{} }
+func (m *QueryTxStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxStateRequest) ProtoMessage()    {}
+func (*QueryTxStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be8264664b954975, []int{2}
+}
+func (m *QueryTxStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxStateRequest.Merge(m, src)
+}
+func (m *QueryTxStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxStateRequest proto.InternalMessageInfo
+
+func (m *QueryTxStateRequest) GetTxId() github_com_datachainlab_cross_x_core_types.TxID {
+	if m != nil {
+		return m.TxId
+	}
+	return nil
+}
+
+type QueryTxStateResponse struct {
+	TxState InitiateTxState `protobuf:"bytes,1,opt,name=tx_state,json=txState,proto3" json:"tx_state"`
+}
+
+func (m *QueryTxStateResponse) Reset()         { *m = QueryTxStateResponse{} }
+func (m *QueryTxStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxStateResponse) ProtoMessage()    {}
+func (*QueryTxStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be8264664b954975, []int{3}
+}
+func (m *QueryTxStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxStateResponse.Merge(m, src)
+}
+func (m *QueryTxStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxStateResponse proto.InternalMessageInfo
+
+func (m *QueryTxStateResponse) GetTxState() InitiateTxState {
+	if m != nil {
+		return m.TxState
+	}
+	return InitiateTxState{}
+}
+
+type QueryPendingTxsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTxsRequest) Reset()         { *m = QueryPendingTxsRequest{} }
+func (m *QueryPendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTxsRequest) ProtoMessage()    {}
+func (*QueryPendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be8264664b954975, []int{4}
+}
+func (m *QueryPendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTxsRequest.Merge(m, src)
+}
+func (m *QueryPendingTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTxsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingTxsResponse struct {
+	Txs        []IdentifiedInitiateTxState `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs"`
+	Pagination *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTxsResponse) Reset()         { *m = QueryPendingTxsResponse{} }
+func (m *QueryPendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTxsResponse) ProtoMessage()    {}
+func (*QueryPendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be8264664b954975, []int{5}
+}
+func (m *QueryPendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTxsResponse.Merge(m, src)
+}
+func (m *QueryPendingTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTxsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingTxsResponse) GetTxs() []IdentifiedInitiateTxState {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *QueryPendingTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingTxsBySignerRequest struct {
+	// signer_id is an ID of the account that has not signed the tx yet
+	SignerId   github_com_datachainlab_cross_x_core_auth_types.AccountID `protobuf:"bytes,1,opt,name=signer_id,json=signerId,proto3,casttype=github.com/datachainlab/cross/x/core/auth/types.AccountID" json:"signer_id,omitempty"`
+	Pagination *query.PageRequest                                        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTxsBySignerRequest) Reset()         { *m = QueryPendingTxsBySignerRequest{} }
+func (m *QueryPendingTxsBySignerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTxsBySignerRequest) ProtoMessage()    {}
+func (*QueryPendingTxsBySignerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be8264664b954975, []int{6}
+}
+func (m *QueryPendingTxsBySignerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTxsBySignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTxsBySignerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTxsBySignerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTxsBySignerRequest.Merge(m, src)
+}
+func (m *QueryPendingTxsBySignerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTxsBySignerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTxsBySignerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTxsBySignerRequest proto.InternalMessageInfo
+
+func (m *QueryPendingTxsBySignerRequest) GetSignerId() github_com_datachainlab_cross_x_core_auth_types.AccountID {
+	if m != nil {
+		return m.SignerId
+	}
+	return nil
+}
+
+func (m *QueryPendingTxsBySignerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingTxsBySignerResponse struct {
+	Txs        []IdentifiedInitiateTxState `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs"`
+	Pagination *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTxsBySignerResponse) Reset()         { *m = QueryPendingTxsBySignerResponse{} }
+func (m *QueryPendingTxsBySignerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTxsBySignerResponse) ProtoMessage()    {}
+func (*QueryPendingTxsBySignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be8264664b954975, []int{7}
+}
+func (m *QueryPendingTxsBySignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTxsBySignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTxsBySignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTxsBySignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTxsBySignerResponse.Merge(m, src)
+}
+func (m *QueryPendingTxsBySignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTxsBySignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTxsBySignerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTxsBySignerResponse proto.InternalMessageInfo
+
+func (m *QueryPendingTxsBySignerResponse) GetTxs() []IdentifiedInitiateTxState {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *QueryPendingTxsBySignerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySelfXCCRequest)(nil), "cross.core.initiator.QuerySelfXCCRequest")
 	proto.RegisterType((*QuerySelfXCCResponse)(nil), "cross.core.initiator.QuerySelfXCCResponse")
+	proto.RegisterType((*QueryTxStateRequest)(nil), "cross.core.initiator.QueryTxStateRequest")
+	proto.RegisterType((*QueryTxStateResponse)(nil), "cross.core.initiator.QueryTxStateResponse")
+	proto.RegisterType((*QueryPendingTxsRequest)(nil), "cross.core.initiator.QueryPendingTxsRequest")
+	proto.RegisterType((*QueryPendingTxsResponse)(nil), "cross.core.initiator.QueryPendingTxsResponse")
+	proto.RegisterType((*QueryPendingTxsBySignerRequest)(nil), "cross.core.initiator.QueryPendingTxsBySignerRequest")
+	proto.RegisterType((*QueryPendingTxsBySignerResponse)(nil), "cross.core.initiator.QueryPendingTxsBySignerResponse")
 }
 
 func init() { proto.RegisterFile("cross/core/initiator/query.proto", fileDescriptor_be8264664b954975) }
 
 var fileDescriptor_be8264664b954975 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x4d, 0x4b, 0xf4, 0x30,
-	0x10, 0xc7, 0x37, 0xcf, 0xe3, 0x0b, 0xc4, 0x5b, 0xad, 0xa0, 0x8b, 0x66, 0x97, 0x82, 0xa0, 0x22,
-	0x09, 0xac, 0x7e, 0x01, 0x77, 0x3d, 0x0b, 0xae, 0x17, 0xf1, 0x96, 0xc6, 0xb4, 0x1b, 0xa8, 0x99,
-	0x6e, 0x93, 0x42, 0x7b, 0xf5, 0xe2, 0x55, 0xf1, 0x4b, 0xed, 0x71, 0xc1, 0x8b, 0x27, 0x91, 0xd6,
-	0x0f, 0x22, 0x7d, 0x51, 0x50, 0x7a, 0xf0, 0x36, 0x19, 0x7e, 0xf3, 0x9b, 0xf9, 0x07, 0x0f, 0x45,
-	0x02, 0xc6, 0x30, 0x01, 0x89, 0x64, 0x4a, 0x2b, 0xab, 0xb8, 0x85, 0x84, 0xcd, 0x53, 0x99, 0xe4,
-	0x34, 0x4e, 0xc0, 0x82, 0xe3, 0xd6, 0x04, 0xad, 0x08, 0xfa, 0x4d, 0xf4, 0x77, 0x43, 0x80, 0x30,
-	0x92, 0x8c, 0xc7, 0x8a, 0x71, 0xad, 0xc1, 0x72, 0xab, 0x40, 0x9b, 0x66, 0xa6, 0xef, 0x86, 0x10,
-	0x42, 0x5d, 0xb2, 0xaa, 0x6a, 0xbb, 0x3b, 0xed, 0x4c, 0xfd, 0xf2, 0xd3, 0x80, 0x71, 0xdd, 0x2e,
-	0xf1, 0xb6, 0xf0, 0xe6, 0x65, 0xb5, 0xf3, 0x4a, 0x46, 0xc1, 0xf5, 0x64, 0x32, 0x95, 0xf3, 0x54,
-	0x1a, 0xeb, 0x9d, 0x63, 0xf7, 0x67, 0xdb, 0xc4, 0xa0, 0x8d, 0x74, 0x8e, 0xf1, 0xff, 0x4c, 0x88,
-	0x6d, 0x34, 0x44, 0x07, 0x1b, 0x23, 0x97, 0x36, 0x5e, 0xfa, 0xe5, 0xa5, 0x67, 0x3a, 0x1f, 0xaf,
-	0x2c, 0xde, 0x06, 0x68, 0x5a, 0x61, 0xa3, 0x27, 0x84, 0x57, 0x6b, 0x8d, 0xf3, 0x80, 0xf0, 0x7a,
-	0xeb, 0x72, 0x0e, 0x69, 0x57, 0x30, 0xda, 0x71, 0x46, 0xff, 0xe8, 0x2f, 0x68, 0x73, 0x9a, 0xb7,
-	0x7f, 0xff, 0xf2, 0xf1, 0xfc, 0x6f, 0xe0, 0xec, 0xb1, 0xce, 0x9f, 0x35, 0x32, 0x0a, 0x32, 0x21,
-	0xc6, 0x17, 0x8b, 0x82, 0xa0, 0x65, 0x41, 0xd0, 0x7b, 0x41, 0xd0, 0x63, 0x49, 0x7a, 0xcb, 0x92,
-	0xf4, 0x5e, 0x4b, 0xd2, 0xbb, 0x39, 0x0d, 0x95, 0x9d, 0xa5, 0x3e, 0x15, 0x70, 0xc7, 0x6e, 0xb9,
-	0xe5, 0x62, 0xc6, 0x95, 0x8e, 0xb8, 0xdf, 0xfa, 0xb2, 0xdf, 0x46, 0x9b, 0xc7, 0xd2, 0xf8, 0x6b,
-	0x75, 0xf8, 0x93, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x3a, 0x00, 0xbc, 0xd0, 0x01, 0x00,
-	0x00,
+	// 677 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x3f, 0x4f, 0x14, 0x41,
+	0x18, 0xc6, 0x6f, 0xf8, 0x23, 0x38, 0x58, 0x8d, 0xa7, 0xe2, 0x46, 0xf7, 0xce, 0x35, 0x20, 0x20,
+	0x37, 0x13, 0xfe, 0x58, 0x58, 0x58, 0x70, 0x10, 0xf0, 0x1a, 0x83, 0x07, 0x85, 0xa1, 0x10, 0x67,
+	0x77, 0xe7, 0x96, 0x4d, 0x8e, 0x99, 0xe3, 0x66, 0xce, 0xec, 0xb5, 0x36, 0x5a, 0x9a, 0xe8, 0xb7,
+	0xb0, 0x34, 0xf1, 0x13, 0x58, 0x50, 0x92, 0xd8, 0x58, 0x11, 0x03, 0x96, 0x7e, 0x02, 0x2a, 0xb3,
+	0x33, 0x73, 0xc2, 0xc1, 0x02, 0xa7, 0x95, 0xdd, 0xec, 0xe4, 0x9d, 0xf7, 0xf9, 0x3d, 0xf3, 0xec,
+	0xbb, 0x0b, 0x8b, 0x41, 0x53, 0x48, 0x49, 0x02, 0xd1, 0x64, 0x24, 0xe6, 0xb1, 0x8a, 0xa9, 0x12,
+	0x4d, 0xb2, 0xd3, 0x62, 0xcd, 0x36, 0x6e, 0x34, 0x85, 0x12, 0x28, 0xaf, 0x2b, 0x70, 0x5a, 0x81,
+	0xff, 0x54, 0x38, 0x77, 0x22, 0x21, 0xa2, 0x3a, 0x23, 0xb4, 0x11, 0x13, 0xca, 0xb9, 0x50, 0x54,
+	0xc5, 0x82, 0x4b, 0x73, 0xc6, 0xc9, 0x47, 0x22, 0x12, 0x7a, 0x49, 0xd2, 0x95, 0xdd, 0xbd, 0x6d,
+	0xcf, 0xe8, 0x27, 0xbf, 0x55, 0x23, 0x94, 0x5b, 0x11, 0x67, 0x2a, 0x10, 0x72, 0x5b, 0x48, 0xe2,
+	0x53, 0xc9, 0x8c, 0x3a, 0x79, 0x3d, 0xe3, 0x33, 0x45, 0x67, 0x48, 0x83, 0x46, 0x31, 0xd7, 0xdd,
+	0x6d, 0x6d, 0x36, 0xb2, 0x54, 0x54, 0x31, 0x53, 0xe1, 0xdd, 0x80, 0xd7, 0x9f, 0xa7, 0x3d, 0xd6,
+	0x58, 0xbd, 0xf6, 0x62, 0x71, 0xb1, 0xca, 0x76, 0x5a, 0x4c, 0x2a, 0x6f, 0x09, 0xe6, 0xbb, 0xb7,
+	0x65, 0x43, 0x70, 0xc9, 0xd0, 0x34, 0xec, 0x4f, 0x82, 0x60, 0x14, 0x14, 0xc1, 0xc4, 0xc8, 0x6c,
+	0x1e, 0x1b, 0x4a, 0xdc, 0xa1, 0xc4, 0x0b, 0xbc, 0x5d, 0x1e, 0xd8, 0xdd, 0x2f, 0x80, 0x6a, 0x5a,
+	0xe6, 0x6d, 0xda, 0xe6, 0xeb, 0xc9, 0x5a, 0x2a, 0x69, 0x9b, 0xa3, 0xa7, 0x70, 0x50, 0x25, 0x9b,
+	0x71, 0xa8, 0xdb, 0x5c, 0x2b, 0xcf, 0x1d, 0xed, 0x17, 0x48, 0x14, 0xab, 0xad, 0x96, 0x8f, 0x03,
+	0xb1, 0x4d, 0x42, 0xaa, 0x68, 0xb0, 0x45, 0x63, 0x5e, 0xa7, 0x3e, 0x31, 0x06, 0x12, 0x63, 0x41,
+	0xb5, 0x1b, 0x4c, 0xe2, 0xf5, 0xa4, 0xb2, 0x54, 0x1d, 0x50, 0x49, 0x25, 0xf4, 0x5e, 0xc2, 0x7c,
+	0xb7, 0x80, 0xc5, 0x5c, 0x86, 0xc3, 0x2a, 0xd9, 0xd4, 0x3e, 0x2d, 0xeb, 0x18, 0xce, 0xca, 0x06,
+	0x57, 0xcc, 0x8a, 0xd9, 0x06, 0x1a, 0x3e, 0x57, 0x1d, 0x52, 0xe6, 0xd1, 0x7b, 0x05, 0x6f, 0xea,
+	0xfe, 0xab, 0x8c, 0x87, 0x31, 0x8f, 0xd6, 0x13, 0xd9, 0xf1, 0xb0, 0x0c, 0xe1, 0xf1, 0x6d, 0x5b,
+	0x8d, 0x71, 0x6c, 0xa2, 0xc1, 0x69, 0x34, 0xd8, 0xbc, 0x18, 0x36, 0x1a, 0xbc, 0x4a, 0xa3, 0x8e,
+	0xff, 0xea, 0x89, 0x93, 0xde, 0x27, 0x00, 0x6f, 0x9d, 0x91, 0xb0, 0x2e, 0x56, 0x60, 0xbf, 0x4a,
+	0xe4, 0x28, 0x28, 0xf6, 0x4f, 0x8c, 0xcc, 0x92, 0x73, 0x0c, 0x84, 0x8c, 0xab, 0xb8, 0x16, 0xb3,
+	0x30, 0xdb, 0x4a, 0xda, 0x01, 0xad, 0x74, 0xc1, 0xf6, 0x69, 0xd8, 0x07, 0x97, 0xc2, 0x1a, 0x8a,
+	0x2e, 0xda, 0xaf, 0x00, 0xba, 0xa7, 0x68, 0xcb, 0xed, 0xb5, 0x38, 0xe2, 0xac, 0xd9, 0xb9, 0x98,
+	0x0d, 0x78, 0x55, 0xea, 0x8d, 0xe3, 0x80, 0x9f, 0x1c, 0xed, 0x17, 0x1e, 0xf7, 0x14, 0x30, 0x6d,
+	0xa9, 0x2d, 0x9b, 0xf2, 0x42, 0x10, 0x88, 0x16, 0x57, 0x95, 0xa5, 0xea, 0xb0, 0xe9, 0x57, 0x09,
+	0xd1, 0x72, 0x86, 0x8f, 0x7f, 0xb9, 0xf4, 0xcf, 0x00, 0x16, 0xce, 0xb5, 0xf1, 0xbf, 0x5e, 0xfe,
+	0xec, 0xaf, 0x01, 0x38, 0xa8, 0xa9, 0xd1, 0x5b, 0x00, 0x87, 0xec, 0x64, 0xa2, 0xc9, 0x6c, 0xb4,
+	0x8c, 0xa1, 0x76, 0xa6, 0x7a, 0x29, 0x35, 0xc2, 0xde, 0xd8, 0x9b, 0x6f, 0x3f, 0x3f, 0xf4, 0x15,
+	0xd0, 0x5d, 0x92, 0xfd, 0x09, 0x61, 0xf5, 0x5a, 0x12, 0x04, 0xe8, 0x1d, 0x80, 0x43, 0xd6, 0xf3,
+	0x85, 0x24, 0xdd, 0x5f, 0x00, 0x67, 0xaa, 0x97, 0x52, 0x4b, 0x32, 0xae, 0x49, 0x8a, 0xc8, 0xcd,
+	0x26, 0x51, 0x49, 0x49, 0xcf, 0x39, 0xfa, 0x08, 0x20, 0x3c, 0xce, 0x13, 0x4d, 0x5f, 0x20, 0x71,
+	0x66, 0x9c, 0x9d, 0x52, 0x8f, 0xd5, 0x96, 0x69, 0x52, 0x33, 0xdd, 0x47, 0xf7, 0xb2, 0x99, 0x1a,
+	0xe6, 0x44, 0x29, 0x8d, 0xff, 0x0b, 0x80, 0xe8, 0xec, 0x6b, 0x86, 0xe6, 0x7b, 0x12, 0x3c, 0x35,
+	0x5c, 0xce, 0xa3, 0xbf, 0x3c, 0x65, 0x71, 0xe7, 0x34, 0x6e, 0x09, 0x3d, 0xbc, 0x14, 0xb7, 0xe4,
+	0xb7, 0x4b, 0x66, 0xdc, 0xca, 0xcf, 0x76, 0x0f, 0x5c, 0xb0, 0x77, 0xe0, 0x82, 0x1f, 0x07, 0x2e,
+	0x78, 0x7f, 0xe8, 0xe6, 0xf6, 0x0e, 0xdd, 0xdc, 0xf7, 0x43, 0x37, 0xb7, 0x31, 0xdf, 0xd3, 0x2c,
+	0x9f, 0x88, 0x28, 0x1d, 0x68, 0xff, 0x8a, 0xfe, 0x4b, 0xcc, 0xfd, 0x1e, 0x00, 0x27, 0x3c, 0x4c,
+	0x59, 0x47, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +475,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	SelfXCC(ctx context.Context, in *QuerySelfXCCRequest, opts ...grpc.CallOption) (*QuerySelfXCCResponse, error)
+	TxState(ctx context.Context, in *QueryTxStateRequest, opts ...grpc.CallOption) (*QueryTxStateResponse, error)
+	PendingTxs(ctx context.Context, in *QueryPendingTxsRequest, opts ...grpc.CallOption) (*QueryPendingTxsResponse, error)
+	PendingTxsBySigner(ctx context.Context, in *QueryPendingTxsBySignerRequest, opts ...grpc.CallOption) (*QueryPendingTxsBySignerResponse, error)
 }
 
 type queryClient struct {
@@ -173,9 +497,39 @@ func (c *queryClient) SelfXCC(ctx context.Context, in *QuerySelfXCCRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) TxState(ctx context.Context, in *QueryTxStateRequest, opts ...grpc.CallOption) (*QueryTxStateResponse, error) {
+	out := new(QueryTxStateResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.initiator.Query/TxState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingTxs(ctx context.Context, in *QueryPendingTxsRequest, opts ...grpc.CallOption) (*QueryPendingTxsResponse, error) {
+	out := new(QueryPendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.initiator.Query/PendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingTxsBySigner(ctx context.Context, in *QueryPendingTxsBySignerRequest, opts ...grpc.CallOption) (*QueryPendingTxsBySignerResponse, error) {
+	out := new(QueryPendingTxsBySignerResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.initiator.Query/PendingTxsBySigner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	SelfXCC(context.Context, *QuerySelfXCCRequest) (*QuerySelfXCCResponse, error)
+	TxState(context.Context, *QueryTxStateRequest) (*QueryTxStateResponse, error)
+	PendingTxs(context.Context, *QueryPendingTxsRequest) (*QueryPendingTxsResponse, error)
+	PendingTxsBySigner(context.Context, *QueryPendingTxsBySignerRequest) (*QueryPendingTxsBySignerResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -185,6 +539,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) SelfXCC(ctx context.Context, req *QuerySelfXCCRequest) (*QuerySelfXCCResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelfXCC not implemented")
 }
+func (*UnimplementedQueryServer) TxState(ctx context.Context, req *QueryTxStateRequest) (*QueryTxStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxState not implemented")
+}
+func (*UnimplementedQueryServer) PendingTxs(ctx context.Context, req *QueryPendingTxsRequest) (*QueryPendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTxs not implemented")
+}
+func (*UnimplementedQueryServer) PendingTxsBySigner(ctx context.Context, req *QueryPendingTxsBySignerRequest) (*QueryPendingTxsBySignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTxsBySigner not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -208,6 +571,60 @@ func _Query_SelfXCC_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TxState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.initiator.Query/TxState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxState(ctx, req.(*QueryTxStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.initiator.Query/PendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTxs(ctx, req.(*QueryPendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingTxsBySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTxsBySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTxsBySigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.initiator.Query/PendingTxsBySigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTxsBySigner(ctx, req.(*QueryPendingTxsBySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cross.core.initiator.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -216,6 +633,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SelfXCC",
 			Handler:    _Query_SelfXCC_Handler,
 		},
+		{
+			MethodName: "TxState",
+			Handler:    _Query_TxState_Handler,
+		},
+		{
+			MethodName: "PendingTxs",
+			Handler:    _Query_PendingTxs_Handler,
+		},
+		{
+			MethodName: "PendingTxsBySigner",
+			Handler:    _Query_PendingTxsBySigner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cross/core/initiator/query.proto",
@@ -279,22 +708,260 @@ func (m *QuerySelfXCCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTxStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySelfXCCRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *QueryTxStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TxState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTxsBySignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTxsBySignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTxsBySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignerId) > 0 {
+		i -= len(m.SignerId)
+		copy(dAtA[i:], m.SignerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTxsBySignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTxsBySignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTxsBySignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySelfXCCRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	return n
 }
@@ -312,6 +979,98 @@ func (m *QuerySelfXCCResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTxStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TxState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTxsBySignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTxsBySignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -460,6 +1219,637 @@ func (m *QuerySelfXCCResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTxStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, IdentifiedInitiateTxState{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingTxsBySignerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingTxsBySignerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingTxsBySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerId = append(m.SignerId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignerId == nil {
+				m.SignerId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingTxsBySignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingTxsBySignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingTxsBySignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, IdentifiedInitiateTxState{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_datachainlab_cross_x_core_types "github.com/datachainlab/cross/x/core/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -61,30 +62,116 @@ func (m *InitiateTxState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InitiateTxState proto.InternalMessageInfo
 
+// IdentifiedInitiateTxState defines an InitiateTxState with its txID
+type IdentifiedInitiateTxState struct {
+	TxId    github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	TxState InitiateTxState                                 `protobuf:"bytes,2,opt,name=tx_state,json=txState,proto3" json:"tx_state"`
+}
+
+func (m *IdentifiedInitiateTxState) Reset()         { *m = IdentifiedInitiateTxState{} }
+func (m *IdentifiedInitiateTxState) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedInitiateTxState) ProtoMessage()    {}
+func (*IdentifiedInitiateTxState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6152ff7daa2f5dd0, []int{1}
+}
+func (m *IdentifiedInitiateTxState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedInitiateTxState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedInitiateTxState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedInitiateTxState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedInitiateTxState.Merge(m, src)
+}
+func (m *IdentifiedInitiateTxState) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedInitiateTxState) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedInitiateTxState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedInitiateTxState proto.InternalMessageInfo
+
+// GenesisState defines the initiator module's genesis state
+type GenesisState struct {
+	TxStates []IdentifiedInitiateTxState `protobuf:"bytes,1,rep,name=tx_states,json=txStates,proto3" json:"tx_states"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6152ff7daa2f5dd0, []int{2}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*InitiateTxState)(nil), "cross.core.initiator.InitiateTxState")
+	proto.RegisterType((*IdentifiedInitiateTxState)(nil), "cross.core.initiator.IdentifiedInitiateTxState")
+	proto.RegisterType((*GenesisState)(nil), "cross.core.initiator.GenesisState")
 }
 
 func init() { proto.RegisterFile("cross/core/initiator/state.proto", fileDescriptor_6152ff7daa2f5dd0) }
 
 var fileDescriptor_6152ff7daa2f5dd0 = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x2e, 0xca, 0x2f,
-	0x2e, 0xd6, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0xcf, 0xcc, 0xcb, 0x2c, 0xc9, 0x4c, 0x2c, 0xc9, 0x2f,
-	0xd2, 0x2f, 0x2e, 0x49, 0x2c, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x01, 0xab,
-	0xd0, 0x03, 0xa9, 0xd0, 0x83, 0xab, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd0, 0x07,
-	0xb1, 0x20, 0x6a, 0xa5, 0xe4, 0xb1, 0x9a, 0x96, 0x5b, 0x9c, 0x5e, 0x0c, 0x51, 0xa0, 0x34, 0x85,
-	0x91, 0x8b, 0xdf, 0x13, 0x22, 0x91, 0x1a, 0x52, 0x11, 0x0c, 0xb2, 0x46, 0xc8, 0x8e, 0x8b, 0x0d,
-	0x64, 0x5f, 0x69, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x9f, 0x91, 0x9a, 0x1e, 0x36, 0x1b, 0xf5,
-	0x50, 0xb5, 0x95, 0x16, 0x07, 0x41, 0x75, 0x09, 0x59, 0x73, 0x31, 0xe7, 0x16, 0xa7, 0x4b, 0x30,
-	0x29, 0x30, 0x6a, 0x70, 0x1b, 0x29, 0x63, 0xd7, 0xec, 0x5b, 0x9c, 0x8e, 0xd0, 0xef, 0xc4, 0x72,
-	0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x48, 0x97, 0x15, 0xcb, 0x8b, 0x05, 0xf2, 0x0c, 0x4e, 0x41, 0x27,
-	0x1e, 0xca, 0x31, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x49, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x4a, 0x62, 0x49, 0x62, 0x72, 0x46,
-	0x62, 0x66, 0x5e, 0x4e, 0x62, 0x92, 0x3e, 0xc4, 0xb7, 0x15, 0xe8, 0xfe, 0x2d, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xd8, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xee, 0x36, 0x14,
-	0x62, 0x01, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x4e, 0xc2, 0x40,
+	0x18, 0xc7, 0x7b, 0x82, 0x88, 0x07, 0xd1, 0xa4, 0x61, 0x40, 0x86, 0x2b, 0xc1, 0x68, 0x98, 0xee,
+	0x12, 0x70, 0xd2, 0xc4, 0x81, 0x18, 0xb5, 0x83, 0x4b, 0x65, 0x72, 0x21, 0xa5, 0x3d, 0xcb, 0x25,
+	0xd2, 0x23, 0xbd, 0x8f, 0xa4, 0xbe, 0x85, 0x83, 0x0f, 0xe0, 0x3b, 0xf8, 0x12, 0x8c, 0x8c, 0x4e,
+	0x44, 0x61, 0xf1, 0x19, 0x9c, 0x4c, 0x7b, 0x55, 0xa2, 0x81, 0x84, 0xad, 0xcd, 0xfd, 0xff, 0xdf,
+	0xff, 0xff, 0xfd, 0xf2, 0xe1, 0xba, 0x17, 0x49, 0xa5, 0x98, 0x27, 0x23, 0xce, 0x44, 0x28, 0x40,
+	0xb8, 0x20, 0x23, 0xa6, 0xc0, 0x05, 0x4e, 0x47, 0x91, 0x04, 0x69, 0x56, 0x52, 0x05, 0x4d, 0x14,
+	0xf4, 0x57, 0x51, 0xab, 0x04, 0x32, 0x90, 0xa9, 0x80, 0x25, 0x5f, 0x5a, 0x5b, 0xb3, 0x56, 0x4e,
+	0x1b, 0xaa, 0x40, 0x69, 0x41, 0xe3, 0x19, 0xe1, 0x7d, 0x5b, 0x3f, 0xf0, 0x6e, 0x7c, 0x9b, 0xc4,
+	0x98, 0xe7, 0xb8, 0x90, 0xe4, 0x8d, 0x55, 0x15, 0xd5, 0x51, 0x73, 0xaf, 0x75, 0x4c, 0x57, 0x25,
+	0xd2, 0xbf, 0xb6, 0xb1, 0x72, 0x32, 0x97, 0x79, 0x86, 0x73, 0x43, 0x15, 0x54, 0xb7, 0xea, 0xa8,
+	0x59, 0x6a, 0x1d, 0xae, 0x36, 0xdf, 0xa8, 0x60, 0xe9, 0xef, 0xe4, 0x27, 0x33, 0xcb, 0x70, 0x12,
+	0xd7, 0x69, 0xfe, 0xf3, 0xc5, 0x32, 0x1a, 0xaf, 0x08, 0x1f, 0xd8, 0x3e, 0x0f, 0x41, 0xdc, 0x0b,
+	0xee, 0xff, 0x2f, 0x78, 0x8d, 0xb7, 0x21, 0xee, 0x09, 0x3f, 0xed, 0x57, 0xee, 0xb4, 0xbf, 0x66,
+	0x16, 0x0b, 0x04, 0x0c, 0xc6, 0x7d, 0xea, 0xc9, 0x21, 0xf3, 0x5d, 0x70, 0xbd, 0x81, 0x2b, 0xc2,
+	0x07, 0xb7, 0xcf, 0x34, 0x80, 0x58, 0x23, 0x80, 0xc7, 0x11, 0x57, 0xb4, 0x1b, 0xdb, 0x17, 0x4e,
+	0x1e, 0x62, 0xdb, 0x37, 0x2f, 0x71, 0x11, 0xe2, 0x5e, 0x4a, 0x37, 0xeb, 0x7b, 0xb4, 0xc9, 0xb2,
+	0x3c, 0x6b, 0xbc, 0x03, 0xfa, 0x37, 0x6b, 0x3d, 0xc0, 0xe5, 0x2b, 0x1e, 0x72, 0x25, 0x94, 0xee,
+	0xe9, 0xe0, 0xdd, 0x9f, 0xe9, 0x09, 0xcb, 0x5c, 0xb3, 0xd4, 0x62, 0x6b, 0xc6, 0xaf, 0xdb, 0x35,
+	0x0b, 0x2a, 0x66, 0x41, 0x4a, 0x27, 0x75, 0x9c, 0xc9, 0x07, 0x31, 0x26, 0x73, 0x82, 0xa6, 0x73,
+	0x82, 0xde, 0xe7, 0x04, 0x3d, 0x2d, 0x88, 0x31, 0x5d, 0x10, 0xe3, 0x6d, 0x41, 0x8c, 0xbb, 0x93,
+	0x8d, 0x60, 0x2c, 0xef, 0x21, 0xc5, 0xd2, 0x2f, 0xa4, 0x17, 0xd1, 0xfe, 0x1e, 0x00, 0x3a, 0x8b,
+	0x7b, 0xdf, 0x82, 0x02, 0x00, 0x00,
 }
 
 func (m *InitiateTxState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +212,83 @@ func (m *InitiateTxState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IdentifiedInitiateTxState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedInitiateTxState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedInitiateTxState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TxState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxStates) > 0 {
+		for iNdEx := len(m.TxStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -150,6 +314,36 @@ func (m *InitiateTxState) Size() (n int) {
 	return n
 }
 
+func (m *IdentifiedInitiateTxState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.TxState.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxStates) > 0 {
+		for _, e := range m.TxStates {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -261,6 +455,213 @@ func (m *InitiateTxState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IdentifiedInitiateTxState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedInitiateTxState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedInitiateTxState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxStates = append(m.TxStates, IdentifiedInitiateTxState{})
+			if err := m.TxStates[len(m.TxStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipState(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -43,3 +43,8 @@ func NewInitiateTxState(msg MsgInitiateTx) InitiateTxState {
 func (state InitiateTxState) IsVerified() bool {
 	return state.Status == INITIATE_TX_STATUS_VERIFIED
 }
+
+// IsExpired returns a boolean whether the tx is expired
+func (state InitiateTxState) IsExpired() bool {
+	return state.Status == INITIATE_TX_STATUS_EXPIRED
+}
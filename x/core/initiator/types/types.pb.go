@@ -34,6 +34,10 @@ type ContractTransaction struct {
 	CallInfo          github_com_datachainlab_cross_x_core_tx_types.ContractCallInfo `protobuf:"bytes,3,opt,name=call_info,json=callInfo,proto3,casttype=github.com/datachainlab/cross/x/core/tx/types.ContractCallInfo" json:"call_info,omitempty"`
 	ReturnValue       *types2.ReturnValue                                            `protobuf:"bytes,4,opt,name=return_value,json=returnValue,proto3" json:"return_value,omitempty"`
 	Links             []Link                                                         `protobuf:"bytes,5,rep,name=links,proto3" json:"links"`
+	// signer_groups are the groups of accounts that require M-of-N signatures in addition to the signers
+	SignerGroups []types1.SignerGroup `protobuf:"bytes,6,rep,name=signer_groups,json=signerGroups,proto3" json:"signer_groups"`
+	// compensation_call_info is the call that undoes the effects of call_info when a later transaction of the saga fails
+	CompensationCallInfo github_com_datachainlab_cross_x_core_tx_types.ContractCallInfo `protobuf:"bytes,7,opt,name=compensation_call_info,json=compensationCallInfo,proto3,casttype=github.com/datachainlab/cross/x/core/tx/types.ContractCallInfo" json:"compensation_call_info,omitempty"`
 }
 
 func (m *ContractTransaction) Reset()         { *m = ContractTransaction{} }
@@ -106,81 +110,46 @@ func (m *Link) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Link proto.InternalMessageInfo
 
-type GenesisState struct {
-}
-
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a6f064a72728169, []int{2}
-}
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
-}
-func (m *GenesisState) XXX_Size() int {
-	return m.Size()
-}
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
-
 func init() {
 	proto.RegisterType((*ContractTransaction)(nil), "cross.core.initiator.ContractTransaction")
 	proto.RegisterType((*Link)(nil), "cross.core.initiator.Link")
-	proto.RegisterType((*GenesisState)(nil), "cross.core.initiator.GenesisState")
 }
 
 func init() { proto.RegisterFile("cross/core/initiator/types.proto", fileDescriptor_8a6f064a72728169) }
 
 var fileDescriptor_8a6f064a72728169 = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0xed, 0x36, 0x85, 0xf6, 0x12, 0x90, 0x70, 0x33, 0xb8, 0x89, 0xe4, 0x44, 0x61, 0xc9,
-	0x74, 0x27, 0x15, 0x84, 0x10, 0x12, 0x48, 0x4d, 0x06, 0x54, 0xc4, 0x64, 0x10, 0x03, 0x4b, 0x74,
-	0xbe, 0x5c, 0x9c, 0x53, 0xaf, 0xdf, 0x55, 0x77, 0x9f, 0x51, 0xf2, 0x2f, 0xf8, 0x09, 0xfc, 0x9c,
-	0x8c, 0x1d, 0x99, 0x2a, 0x48, 0x16, 0xfe, 0x02, 0x4c, 0xc8, 0xe7, 0xa4, 0xb8, 0x9d, 0x58, 0xac,
-	0xfb, 0xf4, 0x3e, 0xaf, 0xfd, 0xbe, 0xdf, 0x99, 0xf4, 0x85, 0x35, 0xce, 0x31, 0x61, 0xac, 0x64,
-	0x0a, 0x14, 0x2a, 0x8e, 0xc6, 0x32, 0x5c, 0x5e, 0x49, 0x47, 0xaf, 0xac, 0x41, 0x13, 0xb5, 0x3d,
-	0x41, 0x4b, 0x82, 0xde, 0x12, 0x9d, 0x76, 0x6e, 0x72, 0xe3, 0x01, 0x56, 0x9e, 0x2a, 0xb6, 0x73,
-	0x92, 0x1b, 0x93, 0x6b, 0xc9, 0xfc, 0x94, 0x15, 0x33, 0xc6, 0x61, 0xb9, 0x95, 0xba, 0x28, 0x61,
-	0x2a, 0xed, 0xa5, 0x02, 0x64, 0x3c, 0x13, 0xaa, 0xfe, 0x8d, 0xce, 0x49, 0x2d, 0x05, 0x2e, 0xee,
-	0x48, 0xdd, 0x9a, 0xc4, 0x0b, 0x9c, 0xd7, 0xc5, 0xc1, 0xef, 0x3d, 0x72, 0x3c, 0x36, 0x80, 0x96,
-	0x0b, 0xfc, 0x68, 0x39, 0x38, 0x2e, 0x50, 0x19, 0x88, 0xde, 0x91, 0x63, 0x6f, 0x9b, 0x88, 0x39,
-	0x57, 0x50, 0x3e, 0x01, 0xa4, 0x8e, 0xc3, 0x7e, 0x38, 0x6c, 0x9e, 0xb6, 0x69, 0x95, 0x92, 0xee,
-	0x52, 0xd2, 0x33, 0x58, 0x8e, 0x1a, 0xab, 0x9b, 0x5e, 0x98, 0x3e, 0xf1, 0xb6, 0x71, 0xe9, 0x1a,
-	0x57, 0xa6, 0xe8, 0x25, 0x79, 0xe8, 0x54, 0x0e, 0xd2, 0xba, 0x78, 0xaf, 0xbf, 0x3f, 0x6c, 0x9e,
-	0xc6, 0xb4, 0xb6, 0x91, 0x32, 0x12, 0x3d, 0x13, 0xc2, 0x14, 0x80, 0xfe, 0x1d, 0x41, 0xba, 0xc3,
-	0xa3, 0x09, 0x39, 0x12, 0x5c, 0xeb, 0x89, 0x82, 0x99, 0x89, 0xf7, 0xfb, 0xe1, 0xb0, 0x35, 0x1a,
-	0xfd, 0xb9, 0xe9, 0xbd, 0xc9, 0x15, 0xce, 0x8b, 0x8c, 0x0a, 0x73, 0xc9, 0xa6, 0x1c, 0xb9, 0xcf,
-	0xa8, 0x79, 0xc6, 0xaa, 0xa6, 0x8b, 0x7b, 0x6b, 0xd8, 0xf5, 0x1b, 0x73, 0xad, 0xcf, 0x61, 0x66,
-	0xd2, 0x43, 0xb1, 0x3d, 0x45, 0xaf, 0x49, 0xcb, 0x4a, 0x2c, 0x2c, 0x4c, 0xbe, 0x70, 0x5d, 0xc8,
-	0xb8, 0xe1, 0xfb, 0x75, 0xea, 0xf9, 0x70, 0x41, 0x53, 0x8f, 0x7c, 0x2a, 0x89, 0xb4, 0x69, 0xff,
-	0x0d, 0xd1, 0x0b, 0x72, 0xa0, 0x15, 0x5c, 0xb8, 0xf8, 0xc0, 0xf7, 0xba, 0xe3, 0xbb, 0xbd, 0x69,
-	0xfa, 0x5e, 0xc1, 0xc5, 0xb6, 0x59, 0x85, 0xbf, 0x6a, 0xfc, 0xfa, 0xd6, 0x0b, 0x06, 0x4f, 0x49,
-	0xa3, 0x94, 0xa2, 0x2e, 0x39, 0x72, 0x56, 0x4c, 0x14, 0x4c, 0xe5, 0xc2, 0x6f, 0xf8, 0x51, 0x7a,
-	0xe8, 0xac, 0x38, 0x2f, 0xe7, 0xc1, 0x63, 0xd2, 0x7a, 0x2b, 0x41, 0x3a, 0xe5, 0x3e, 0x20, 0x47,
-	0x39, 0x4a, 0x57, 0x3f, 0x93, 0x60, 0xb5, 0x4e, 0xc2, 0xeb, 0x75, 0x12, 0xfe, 0x58, 0x27, 0xe1,
-	0xd7, 0x4d, 0x12, 0x5c, 0x6f, 0x92, 0xe0, 0xfb, 0x26, 0x09, 0x3e, 0x3f, 0xff, 0xaf, 0xcd, 0xdc,
-	0xfb, 0x4d, 0xb3, 0x07, 0xfe, 0x1e, 0x9f, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xf8, 0x68,
-	0x71, 0xcb, 0x02, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x13, 0xae, 0xf7, 0xcf, 0xed, 0x0d, 0xe4, 0x2a, 0x94, 0x6b, 0x51, 0x1a, 0x1d, 0x4b,
+	0x27, 0x47, 0x3a, 0x10, 0x42, 0x48, 0x20, 0x5d, 0x3b, 0x9c, 0x0e, 0x31, 0x05, 0xc4, 0xc0, 0x12,
+	0x39, 0xae, 0x9b, 0x5a, 0x97, 0xbe, 0xae, 0x6c, 0x07, 0xa5, 0xdf, 0x82, 0x8f, 0xc0, 0x07, 0xe1,
+	0x03, 0x74, 0xbc, 0x91, 0xe9, 0x04, 0xed, 0xc2, 0x67, 0x60, 0x42, 0x76, 0xda, 0x6b, 0x28, 0x0b,
+	0x03, 0x4b, 0x64, 0xfb, 0xfd, 0x3d, 0xf6, 0xf3, 0xbc, 0x76, 0x50, 0x48, 0xa5, 0x50, 0x2a, 0xa2,
+	0x42, 0xb2, 0x88, 0x03, 0xd7, 0x9c, 0x68, 0x21, 0x23, 0x3d, 0x9f, 0x31, 0x85, 0x67, 0x52, 0x68,
+	0xe1, 0xb5, 0x2d, 0x81, 0x0d, 0x81, 0xef, 0x89, 0x4e, 0x3b, 0x13, 0x99, 0xb0, 0x40, 0x64, 0x46,
+	0x15, 0xdb, 0x39, 0xcb, 0x84, 0xc8, 0x72, 0x16, 0xd9, 0x59, 0x5a, 0x8c, 0x23, 0x02, 0xf3, 0x75,
+	0xa9, 0xab, 0x19, 0x8c, 0x98, 0x9c, 0x72, 0xd0, 0x11, 0x49, 0x29, 0xaf, 0x9f, 0xd1, 0x39, 0xab,
+	0xb9, 0xd0, 0xe5, 0x1f, 0xa5, 0x6e, 0xad, 0x44, 0x0a, 0x3d, 0xa9, 0x17, 0xcf, 0xbf, 0x36, 0xd0,
+	0xe9, 0x50, 0x80, 0x96, 0x84, 0xea, 0xf7, 0x92, 0x80, 0x22, 0x54, 0x73, 0x01, 0xde, 0x1b, 0x74,
+	0x6a, 0x65, 0x09, 0x9d, 0x10, 0x0e, 0xe6, 0x0b, 0xc0, 0x72, 0xdf, 0x0d, 0xdd, 0x7e, 0xf3, 0xa2,
+	0x8d, 0x2b, 0x97, 0x78, 0xe3, 0x12, 0x5f, 0xc2, 0x7c, 0xd0, 0x58, 0xdc, 0xf5, 0xdc, 0xf8, 0xa1,
+	0x95, 0x0d, 0x8d, 0x6a, 0x58, 0x89, 0xbc, 0x17, 0xe8, 0x50, 0xf1, 0x0c, 0x98, 0x54, 0xfe, 0x83,
+	0x70, 0xaf, 0xdf, 0xbc, 0xf0, 0x71, 0xad, 0x23, 0xc6, 0x12, 0xbe, 0xa4, 0x54, 0x14, 0xa0, 0xed,
+	0x1e, 0x4e, 0xbc, 0xc1, 0xbd, 0x04, 0x1d, 0x53, 0x92, 0xe7, 0x09, 0x87, 0xb1, 0xf0, 0xf7, 0x42,
+	0xb7, 0xdf, 0x1a, 0x0c, 0x7e, 0xdd, 0xf5, 0x5e, 0x67, 0x5c, 0x4f, 0x8a, 0x14, 0x53, 0x31, 0x8d,
+	0x46, 0x44, 0x13, 0xeb, 0x31, 0x27, 0x69, 0x54, 0x25, 0x2d, 0x77, 0xda, 0xb0, 0xc9, 0x37, 0x24,
+	0x79, 0x7e, 0x0d, 0x63, 0x11, 0x1f, 0xd1, 0xf5, 0xc8, 0x7b, 0x85, 0x5a, 0x92, 0xe9, 0x42, 0x42,
+	0xf2, 0x89, 0xe4, 0x05, 0xf3, 0x1b, 0x36, 0x5f, 0xa7, 0xee, 0x4f, 0x97, 0x38, 0xb6, 0xc8, 0x07,
+	0x43, 0xc4, 0x4d, 0xb9, 0x9d, 0x78, 0xcf, 0xd1, 0x7e, 0xce, 0xe1, 0x46, 0xf9, 0xfb, 0xe1, 0xde,
+	0xae, 0xee, 0xfe, 0xa6, 0xf1, 0x5b, 0x0e, 0x37, 0xeb, 0x64, 0x15, 0xee, 0x5d, 0xa1, 0x93, 0x2a,
+	0x62, 0x92, 0x49, 0x51, 0xcc, 0x94, 0x7f, 0x60, 0xf5, 0x8f, 0xff, 0xea, 0xcb, 0x3b, 0x4b, 0x5d,
+	0x19, 0x68, 0xbd, 0x43, 0x4b, 0x6d, 0x97, 0x94, 0x57, 0xa2, 0x47, 0x54, 0x4c, 0x67, 0x0c, 0x14,
+	0x31, 0xd7, 0x96, 0x6c, 0xbb, 0x75, 0xf8, 0xdf, 0xba, 0xd5, 0xae, 0x9f, 0xb0, 0x59, 0x7d, 0xd9,
+	0xf8, 0xf9, 0xa5, 0xe7, 0x9c, 0x3f, 0x41, 0x0d, 0x93, 0xce, 0xeb, 0xa2, 0x63, 0x25, 0x69, 0xc2,
+	0x61, 0xc4, 0x4a, 0xfb, 0x48, 0x4e, 0xe2, 0x23, 0x25, 0xe9, 0xb5, 0x99, 0x0f, 0xe2, 0xc5, 0x8f,
+	0xc0, 0x59, 0x2c, 0x03, 0xf7, 0x76, 0x19, 0xb8, 0xdf, 0x97, 0x81, 0xfb, 0x79, 0x15, 0x38, 0xb7,
+	0xab, 0xc0, 0xf9, 0xb6, 0x0a, 0x9c, 0x8f, 0xcf, 0xfe, 0xc9, 0xde, 0xce, 0x9f, 0x95, 0x1e, 0xd8,
+	0xa7, 0xf7, 0xf4, 0xf7, 0x00, 0xf5, 0x9e, 0xd9, 0x7d, 0x7e, 0x03, 0x00, 0x00,
 }
 
 func (m *ContractTransaction) Marshal() (dAtA []byte, err error) {
@@ -203,6 +172,27 @@ func (m *ContractTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CompensationCallInfo) > 0 {
+		i -= len(m.CompensationCallInfo)
+		copy(dAtA[i:], m.CompensationCallInfo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CompensationCallInfo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SignerGroups) > 0 {
+		for iNdEx := len(m.SignerGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Links) > 0 {
 		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -293,29 +283,6 @@ func (m *Link) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -357,6 +324,16 @@ func (m *ContractTransaction) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.SignerGroups) > 0 {
+		for _, e := range m.SignerGroups {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.CompensationCallInfo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -372,15 +349,6 @@ func (m *Link) Size() (n int) {
 	return n
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -590,64 +558,45 @@ func (m *ContractTransaction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerGroups", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Link) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SignerGroups = append(m.SignerGroups, types1.SignerGroup{})
+			if err := m.SignerGroups[len(m.SignerGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Link: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIndex", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationCallInfo", wireType)
 			}
-			m.SrcIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -657,11 +606,26 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcIndex |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompensationCallInfo = append(m.CompensationCallInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompensationCallInfo == nil {
+				m.CompensationCallInfo = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -686,7 +650,7 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *Link) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -709,12 +673,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIndex", wireType)
+			}
+			m.SrcIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
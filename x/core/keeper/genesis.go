@@ -4,11 +4,13 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	genesistypes "github.com/datachainlab/cross/x/core/genesis/types"
 	"github.com/datachainlab/cross/x/core/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 )
 
-// InitGenesis initializes the bridge module state and binds to PortID.
-func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+// InitGenesis initializes the cross module state and binds to PortID.
+func (k Keeper) InitGenesis(ctx sdk.Context, state genesistypes.GenesisState) {
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
 	if !k.IsBound(ctx, types.PortID) {
@@ -19,9 +21,36 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 			panic(fmt.Sprintf("could not claim port capability: %v", err))
 		}
 	}
+	k.SetParams(ctx, state.Params)
+	if err := k.initiatorKeeper.InitGenesis(ctx, state.Initiator); err != nil {
+		panic(fmt.Sprintf("failed to initialize initiator state: %v", err))
+	}
+	if err := k.authKeeper.InitGenesis(ctx, state.Auth); err != nil {
+		panic(fmt.Sprintf("failed to initialize auth state: %v", err))
+	}
+	if len(state.Xcc.ChainChannels) > 0 && k.xccRegistry == nil {
+		panic("xcc resolver doesn't support the chain channel registry")
+	}
+	for _, cc := range state.Xcc.ChainChannels {
+		if err := k.xccRegistry.SetChainChannel(ctx, cc); err != nil {
+			panic(fmt.Sprintf("failed to initialize xcc state: %v", err))
+		}
+	}
 }
 
-// ExportGenesis exports bridge module's portID and denom trace info into its genesis state.
-func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	return &types.GenesisState{}
+// ExportGenesis exports the initiator, auth and xcc states and the parameters of cross module into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*genesistypes.GenesisState, error) {
+	initiator, err := k.initiatorKeeper.ExportGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := k.authKeeper.ExportGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	xcc := xcctypes.DefaultGenesis()
+	if k.xccRegistry != nil {
+		xcc.ChainChannels = k.xccRegistry.GetChainChannels(ctx)
+	}
+	return genesistypes.NewGenesisState(*initiator, *auth, *xcc, k.GetParams(ctx)), nil
 }
@@ -2,17 +2,73 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
 )
 
 var _ initiatortypes.QueryServer = (*Keeper)(nil)
+var _ xcctypes.QueryServer = (*Keeper)(nil)
+var _ txtypes.QueryServer = (*Keeper)(nil)
+
+// Params implements the Query/Params gRPC method
+func (q Keeper) Params(c context.Context, req *txtypes.QueryParamsRequest) (*txtypes.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &txtypes.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
 
 func (q Keeper) SelfXCC(c context.Context, req *initiatortypes.QuerySelfXCCRequest) (*initiatortypes.QuerySelfXCCResponse, error) {
 	return q.initiatorKeeper.SelfXCC(c, req)
 }
 
+func (q Keeper) TxState(c context.Context, req *initiatortypes.QueryTxStateRequest) (*initiatortypes.QueryTxStateResponse, error) {
+	return q.initiatorKeeper.TxState(c, req)
+}
+
+func (q Keeper) PendingTxs(c context.Context, req *initiatortypes.QueryPendingTxsRequest) (*initiatortypes.QueryPendingTxsResponse, error) {
+	return q.initiatorKeeper.PendingTxs(c, req)
+}
+
+func (q Keeper) PendingTxsBySigner(c context.Context, req *initiatortypes.QueryPendingTxsBySignerRequest) (*initiatortypes.QueryPendingTxsBySignerResponse, error) {
+	return q.initiatorKeeper.PendingTxsBySigner(c, req)
+}
+
 func (q Keeper) TxAuthState(c context.Context, req *authtypes.QueryTxAuthStateRequest) (*authtypes.QueryTxAuthStateResponse, error) {
 	return q.authKeeper.TxAuthState(c, req)
 }
+
+func (q Keeper) IBCSignTxRecord(c context.Context, req *authtypes.QueryIBCSignTxRecordRequest) (*authtypes.QueryIBCSignTxRecordResponse, error) {
+	return q.authKeeper.IBCSignTxRecord(c, req)
+}
+
+func (q Keeper) IBCSignTxRecords(c context.Context, req *authtypes.QueryIBCSignTxRecordsRequest) (*authtypes.QueryIBCSignTxRecordsResponse, error) {
+	return q.authKeeper.IBCSignTxRecords(c, req)
+}
+
+func (q Keeper) SignGrants(c context.Context, req *authtypes.QuerySignGrantsRequest) (*authtypes.QuerySignGrantsResponse, error) {
+	return q.authKeeper.SignGrants(c, req)
+}
+
+func (q Keeper) ChainChannel(c context.Context, req *xcctypes.QueryChainChannelRequest) (*xcctypes.QueryChainChannelResponse, error) {
+	if q.xccRegistry == nil {
+		return nil, fmt.Errorf("the xcc resolver doesn't support the chain channel registry")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := q.xccRegistry.GetChainChannel(ctx, req.ChainId)
+	if !found {
+		return nil, fmt.Errorf("no channel is registered for the chain '%v'", req.ChainId)
+	}
+	return &xcctypes.QueryChainChannelResponse{ChainChannel: xcctypes.NewChainChannel(req.ChainId, *channel)}, nil
+}
+
+func (q Keeper) ChainChannels(c context.Context, req *xcctypes.QueryChainChannelsRequest) (*xcctypes.QueryChainChannelsResponse, error) {
+	if q.xccRegistry == nil {
+		return nil, fmt.Errorf("the xcc resolver doesn't support the chain channel registry")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &xcctypes.QueryChainChannelsResponse{ChainChannels: q.xccRegistry.GetChainChannels(ctx)}, nil
+}
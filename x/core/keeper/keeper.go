@@ -1,11 +1,16 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/tendermint/tendermint/libs/log"
 
 	authkeeper "github.com/datachainlab/cross/x/core/auth/keeper"
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
@@ -26,10 +31,11 @@ type Keeper struct {
 	router          router.Router
 	initiatorKeeper initiatorkeeper.Keeper
 	authKeeper      authkeeper.Keeper
+	xccRegistry     xcctypes.ChainChannelRegistry
 }
 
 func NewKeeper(
-	cdc codec.Codec, initiatorStoreKey, authStoreKey sdk.StoreKey,
+	cdc codec.Codec, initiatorStoreKey, authStoreKey sdk.StoreKey, paramSpace paramtypes.Subspace,
 	channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
 	packetMiddleware packets.PacketMiddleware, xccResolver xcctypes.XCCResolver, txRunner txtypes.TxRunner, router router.Router,
 ) Keeper {
@@ -44,7 +50,7 @@ func NewKeeper(
 		xccResolver,
 	)
 	initiatorKeeper := initiatorkeeper.NewKeeper(
-		cdc, initiatorStoreKey, channelKeeper,
+		cdc, initiatorStoreKey, paramSpace, channelKeeper,
 		authKeeper,
 		packetSendKeeper,
 		packetMiddleware,
@@ -53,6 +59,8 @@ func NewKeeper(
 	)
 	authKeeper.SetTxManager(initiatorKeeper)
 	router.AddRoute(authtypes.PacketType, authKeeper)
+	// the registry is optional, and only available if the resolver supports it
+	xccRegistry, _ := xccResolver.(xcctypes.ChainChannelRegistry)
 
 	return Keeper{
 		m:             cdc,
@@ -63,6 +71,7 @@ func NewKeeper(
 
 		initiatorKeeper: initiatorKeeper,
 		authKeeper:      authKeeper,
+		xccRegistry:     xccRegistry,
 	}
 }
 
@@ -74,6 +83,53 @@ func (k Keeper) AuthKeeper() authkeeper.Keeper {
 	return k.authKeeper
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of the cross parameters.
+func (k Keeper) GetParams(ctx sdk.Context) txtypes.Params {
+	return k.initiatorKeeper.GetParams(ctx)
+}
+
+// SetParams sets the total set of the cross parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params txtypes.Params) {
+	k.initiatorKeeper.SetParams(ctx, params)
+}
+
+// OnChainChannelOpened notifies that a given channel for the counterparty chain is opened if the xcc resolver has a registry.
+// The channel isn't registered automatically because anyone can create a light client that claims any chain ID,
+// so it must be registered with RegisterChainChannelProposal.
+// If the chain ID cannot be derived from the light client, it is skipped without failing the channel handshake.
+func (k Keeper) OnChainChannelOpened(ctx sdk.Context, portID, channelID string) {
+	if k.xccRegistry == nil {
+		return
+	}
+	ci := xcctypes.ChannelInfo{Port: portID, Channel: channelID}
+	chainID, err := k.xccRegistry.GetCounterpartyChainID(ctx, ci)
+	if err != nil {
+		k.Logger(ctx).Info("skip the chain channel notification", "port", portID, "channel", channelID, "err", err)
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			xcctypes.EventTypeChainChannelOpened,
+			sdk.NewAttribute(xcctypes.AttributeKeyChainID, chainID),
+			sdk.NewAttribute(xcctypes.AttributeKeyPort, portID),
+			sdk.NewAttribute(xcctypes.AttributeKeyChannel, channelID),
+		),
+	)
+}
+
+// HandleRegisterChainChannelProposal registers a given channel for the chain on behalf of the governance
+func (k Keeper) HandleRegisterChainChannelProposal(ctx sdk.Context, p *xcctypes.RegisterChainChannelProposal) error {
+	if k.xccRegistry == nil {
+		return errors.New("the xcc resolver doesn't support the chain channel registry")
+	}
+	return k.xccRegistry.RegisterChannel(ctx, p.ChainId, p.Channel)
+}
+
 // IsBound checks if the transfer module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
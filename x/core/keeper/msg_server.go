@@ -13,6 +13,10 @@ func (k Keeper) InitiateTx(ctx context.Context, msg *initiatortypes.MsgInitiateT
 	return k.initiatorKeeper.InitiateTx(ctx, msg)
 }
 
+func (k Keeper) CancelTx(ctx context.Context, msg *initiatortypes.MsgCancelTx) (*initiatortypes.MsgCancelTxResponse, error) {
+	return k.initiatorKeeper.CancelTx(ctx, msg)
+}
+
 func (k Keeper) SignTx(ctx context.Context, msg *authtypes.MsgSignTx) (*authtypes.MsgSignTxResponse, error) {
 	return k.authKeeper.SignTx(ctx, msg)
 }
@@ -24,3 +28,19 @@ func (k Keeper) IBCSignTx(ctx context.Context, msg *authtypes.MsgIBCSignTx) (*au
 func (k Keeper) ExtSignTx(ctx context.Context, msg *authtypes.MsgExtSignTx) (*authtypes.MsgExtSignTxResponse, error) {
 	return k.authKeeper.ExtSignTx(ctx, msg)
 }
+
+func (k Keeper) RevokeSign(ctx context.Context, msg *authtypes.MsgRevokeSign) (*authtypes.MsgRevokeSignResponse, error) {
+	return k.authKeeper.RevokeSign(ctx, msg)
+}
+
+func (k Keeper) IBCRevokeSign(ctx context.Context, msg *authtypes.MsgIBCRevokeSign) (*authtypes.MsgIBCRevokeSignResponse, error) {
+	return k.authKeeper.IBCRevokeSign(ctx, msg)
+}
+
+func (k Keeper) GrantSign(ctx context.Context, msg *authtypes.MsgGrantSign) (*authtypes.MsgGrantSignResponse, error) {
+	return k.authKeeper.GrantSign(ctx, msg)
+}
+
+func (k Keeper) RevokeSignGrant(ctx context.Context, msg *authtypes.MsgRevokeSignGrant) (*authtypes.MsgRevokeSignGrantResponse, error) {
+	return k.authKeeper.RevokeSignGrant(ctx, msg)
+}
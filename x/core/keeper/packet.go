@@ -39,3 +39,35 @@ func (p Keeper) ReceivePacketAcknowledgement(ctx sdk.Context, packet channeltype
 	}
 	return res, nil
 }
+
+func (p Keeper) ReceivePacketTimeout(ctx sdk.Context, packet channeltypes.Packet) (*sdk.Result, error) {
+	ip, err := packets.UnmarshalIncomingPacket(p.m, packet)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized IBC packet type: %T: %v", packet, err)
+	}
+	route, found := p.router.GetRoute(ip.Payload().Type())
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "route not found: %v", ip.Payload().Type())
+	}
+	res, err := route.HandleTimeout(ctx, packet, ip)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p Keeper) ReceivePacketErrorAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, errMsg string) (*sdk.Result, error) {
+	ip, err := packets.UnmarshalIncomingPacket(p.m, packet)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized IBC packet type: %T: %v", packet, err)
+	}
+	route, found := p.router.GetRoute(ip.Payload().Type())
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "route not found: %v", ip.Payload().Type())
+	}
+	res, err := route.HandleErrorACK(ctx, packet, ip, errMsg)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/ibc-go/modules/core/exported"
 	authtypes "github.com/datachainlab/cross/x/core/auth/types"
 	"github.com/datachainlab/cross/x/core/client/cli"
+	genesistypes "github.com/datachainlab/cross/x/core/genesis/types"
 	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
 	"github.com/datachainlab/cross/x/core/keeper"
 	txtypes "github.com/datachainlab/cross/x/core/tx/types"
@@ -65,12 +66,12 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 
 // DefaultGenesis returns the capability module's default genesis state.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(types.DefaultGenesis())
+	return cdc.MustMarshalJSON(genesistypes.DefaultGenesis())
 }
 
 // ValidateGenesis performs genesis state validation for the capability module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-	var genState types.GenesisState
+	var genState genesistypes.GenesisState
 	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
@@ -84,6 +85,7 @@ func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Rout
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
 	initiatortypes.RegisterQueryHandlerClient(context.Background(), mux, initiatortypes.NewQueryClient(clientCtx))
 	authtypes.RegisterQueryHandlerClient(context.Background(), mux, authtypes.NewQueryClient(clientCtx))
+	txtypes.RegisterQueryHandlerClient(context.Background(), mux, txtypes.NewQueryClient(clientCtx))
 }
 
 // GetTxCmd returns the capability module's root tx command.
@@ -142,12 +144,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	initiatortypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 	authtypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 	authtypes.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	xcctypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	txtypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
-	var genState types.GenesisState
+	var genState genesistypes.GenesisState
 	// Initialize global index to index in genesis state
 	cdc.MustUnmarshalJSON(gs, &genState)
 	am.keeper.InitGenesis(ctx, genState)
@@ -156,7 +160,10 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.Ra
 
 // ExportGenesis returns the capability module's exported genesis state as raw JSON bytes.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	genState := am.keeper.ExportGenesis(ctx)
+	genState, err := am.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return cdc.MustMarshalJSON(genState)
 }
 
@@ -167,8 +174,11 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
-// returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// expires the pending txs that have passed their timeout, prunes the IBCSignTx records that have passed their retention
+// and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.InitiatorKeeper().ExpireTxs(ctx, initiatortypes.MaxExpiredTxsPerBlock)
+	am.keeper.AuthKeeper().PruneIBCSignTxRecords(ctx, am.keeper.GetParams(ctx).IbcSignTxRecordRetention, authtypes.MaxPrunedIBCSignTxRecordsPerBlock)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -261,6 +271,7 @@ func (am AppModule) OnChanOpenAck(
 	if counterpartyVersion != types.Version {
 		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
 	}
+	am.keeper.OnChainChannelOpened(ctx, portID, channelID)
 	return nil
 }
 
@@ -270,6 +281,7 @@ func (am AppModule) OnChanOpenConfirm(
 	portID,
 	channelID string,
 ) error {
+	am.keeper.OnChainChannelOpened(ctx, portID, channelID)
 	return nil
 }
 
@@ -328,8 +340,7 @@ func (am AppModule) OnAcknowledgementPacket(
 		}
 		return am.keeper.ReceivePacketAcknowledgement(ctx, packet, parsedAck)
 	} else {
-		// TODO handle an error case
-		panic(string(ack.Result))
+		return am.keeper.ReceivePacketErrorAcknowledgement(ctx, packet, string(ack.Result))
 	}
 }
 
@@ -339,7 +350,5 @@ func (am AppModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) (*sdk.Result, error) {
-	return &sdk.Result{
-		Events: ctx.EventManager().Events().ToABCIEvents(),
-	}, nil
+	return am.keeper.ReceivePacketTimeout(ctx, packet)
 }
@@ -25,6 +25,17 @@ type PacketHandler interface {
 		ip packets.IncomingPacket,
 		ipa packets.IncomingPacketAcknowledgement,
 	) (*sdk.Result, error)
+	HandleTimeout(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		ip packets.IncomingPacket,
+	) (*sdk.Result, error)
+	HandleErrorACK(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		ip packets.IncomingPacket,
+		errMsg string,
+	) (*sdk.Result, error)
 }
 
 type router struct {
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/datachainlab/cross/x/core/store/types"
+)
+
+var _ sdk.KVStore = (*commitKVStoreAdapter)(nil)
+
+// commitKVStoreAdapter is an adapter that implements sdk.KVStore with a KVStoreI and a context
+type commitKVStoreAdapter struct {
+	ctx   sdk.Context
+	store types.KVStoreI
+}
+
+func newCommitKVStoreAdapter(ctx sdk.Context, store types.KVStoreI) commitKVStoreAdapter {
+	return commitKVStoreAdapter{ctx: ctx, store: store}
+}
+
+func (a commitKVStoreAdapter) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeIAVL
+}
+
+func (a commitKVStoreAdapter) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(a)
+}
+
+func (a commitKVStoreAdapter) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(a, w, tc))
+}
+
+func (a commitKVStoreAdapter) CacheWrapWithListeners(storeKey storetypes.StoreKey, listeners []storetypes.WriteListener) storetypes.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(a, storeKey, listeners))
+}
+
+func (a commitKVStoreAdapter) Get(key []byte) []byte {
+	return a.store.Get(a.ctx, key)
+}
+
+func (a commitKVStoreAdapter) Has(key []byte) bool {
+	return a.store.Has(a.ctx, key)
+}
+
+func (a commitKVStoreAdapter) Set(key, value []byte) {
+	a.store.Set(a.ctx, key, value)
+}
+
+func (a commitKVStoreAdapter) Delete(key []byte) {
+	a.store.Delete(a.ctx, key)
+}
+
+func (a commitKVStoreAdapter) Iterator(start, end []byte) sdk.Iterator {
+	return a.store.Iterator(a.ctx, start, end)
+}
+
+func (a commitKVStoreAdapter) ReverseIterator(start, end []byte) sdk.Iterator {
+	return a.store.ReverseIterator(a.ctx, start, end)
+}
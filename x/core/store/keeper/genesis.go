@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/datachainlab/cross/x/core/store/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// InitGenesis initializes the store with a given genesis state
+// The range locks are restored from the precommitted transactions.
+func (s CommitKVStore) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	if err := gs.Validate(); err != nil {
+		return err
+	}
+	for _, e := range gs.Entries {
+		s.stateStore.Set(ctx, e.Key, e.Value)
+	}
+	for _, tx := range gs.Txs {
+		bz, err := proto.Marshal(&tx.LockOps)
+		if err != nil {
+			return err
+		}
+		s.txStore.Set(ctx, tx.Id, bz)
+		s.rangeLockStore.Lock(ctx, tx.Id, tx.LockOps.Ranges)
+	}
+	for _, lk := range gs.Locks {
+		if lk.Exclusive {
+			s.lockStore.Lock(ctx, lk.Key)
+		} else {
+			for i := uint64(0); i < lk.SharedCount; i++ {
+				s.lockStore.LockShared(ctx, lk.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the committed entries, the precommitted transactions and their locks
+func (s CommitKVStore) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	var gs types.GenesisState
+
+	stateIter := s.stateStore.Iterator(ctx, nil, nil)
+	defer stateIter.Close()
+	for ; stateIter.Valid(); stateIter.Next() {
+		gs.Entries = append(gs.Entries, types.KVPair{Key: stateIter.Key(), Value: stateIter.Value()})
+	}
+
+	txIter := s.txStore.Iterator(ctx, nil, nil)
+	defer txIter.Close()
+	for ; txIter.Valid(); txIter.Next() {
+		var lks types.LockOPs
+		if err := proto.Unmarshal(txIter.Value(), &lks); err != nil {
+			return nil, err
+		}
+		gs.Txs = append(gs.Txs, types.PrecommittedTx{Id: txIter.Key(), LockOps: lks})
+	}
+
+	lockIter := s.lockStore.Iterator(ctx)
+	defer lockIter.Close()
+	for ; lockIter.Valid(); lockIter.Next() {
+		v := lockIter.Value()
+		switch v[0] {
+		case lockTypeExclusive:
+			gs.Locks = append(gs.Locks, types.Lock{Key: lockIter.Key(), Exclusive: true})
+		case lockTypeShared:
+			gs.Locks = append(gs.Locks, types.Lock{Key: lockIter.Key(), SharedCount: sdk.BigEndianToUint64(v[1:])})
+		default:
+			return nil, fmt.Errorf("unknown lock type '%v'", v[0])
+		}
+	}
+	return &gs, nil
+}
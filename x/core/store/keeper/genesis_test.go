@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/datachainlab/cross/x/core/store/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreGenesis(t *testing.T) {
+	require := require.New(t)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	k0, v0 := []byte("k0"), []byte("v0")
+	k1, v1 := []byte("k1"), []byte("v1")
+	id0, id1 := []byte("id0"), []byte("id1")
+
+	stk := sdk.NewKVStoreKey("main")
+	cms := makeCMStore(t, stk)
+	st := NewStore(cdc, stk)
+	{
+		ctx := makeContext(cms)
+		st.Set(ctx, k0, v0)
+	}
+	{
+		ctx := makeAtomicModeContext(cms, types.NewLockManager())
+		require.Equal(v0, st.Get(ctx, k0))
+		st.Set(ctx, k1, v1)
+		require.NoError(st.Precommit(ctx, id0))
+	}
+	{
+		ctx := makeAtomicModeContext(cms, types.NewLockManager())
+		require.Equal(v0, st.Get(ctx, k0))
+		require.NoError(st.Precommit(ctx, id1))
+	}
+	cms.Commit()
+
+	gs, err := st.ExportGenesis(makeContext(cms))
+	require.NoError(err)
+	require.NoError(gs.Validate())
+	require.Equal([]types.KVPair{{Key: k0, Value: v0}}, gs.Entries)
+	require.Len(gs.Txs, 2)
+	require.Equal([]types.Lock{{Key: k0, SharedCount: 2}, {Key: k1, Exclusive: true}}, gs.Locks)
+
+	// import the exported state into a new store
+	stk2 := sdk.NewKVStoreKey("main")
+	cms2 := makeCMStore(t, stk2)
+	st2 := NewStore(cdc, stk2)
+	require.NoError(st2.InitGenesis(makeContext(cms2), *gs))
+	cms2.Commit()
+
+	gs2, err := st2.ExportGenesis(makeContext(cms2))
+	require.NoError(err)
+	require.Equal(gs, gs2)
+
+	// the imported locks must prevent concurrent access
+	require.Panics(func() {
+		ctx, _ := makeContext(cms2).CacheContext()
+		_ = st2.Get(ctx, k1)
+	})
+	require.Panics(func() {
+		ctx, _ := makeContext(cms2).CacheContext()
+		st2.Set(ctx, k0, v1)
+	})
+
+	// the precommitted tx can be committed after import
+	{
+		ctx := makeContext(cms2)
+		require.NoError(st2.Commit(ctx, id0))
+		require.NoError(st2.Abort(ctx, id1))
+		require.Equal(v1, st2.Get(ctx, k1))
+	}
+
+	// a lock that doesn't belong to any precommitted txs is invalid
+	invalid := *gs
+	invalid.Locks = append([]types.Lock{{Key: []byte("k2"), Exclusive: true}}, gs.Locks...)
+	require.Error(invalid.Validate())
+
+	// a missing lock is invalid
+	invalid = *gs
+	invalid.Locks = gs.Locks[1:]
+	require.Error(invalid.Validate())
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/datachainlab/cross/x/core/store/types"
+)
+
+var _ sdk.Iterator = (*mergedIterator)(nil)
+
+// mergedIterator merges the committed state with the pending writes of a transaction.
+// A pending write overrides the committed value of the same key, and a pending delete hides it.
+type mergedIterator struct {
+	parent    sdk.Iterator
+	pending   []types.LockOP // sorted in the iteration order
+	idx       int
+	ascending bool
+}
+
+func newMergedIterator(parent sdk.Iterator, pending []types.LockOP, ascending bool) *mergedIterator {
+	it := &mergedIterator{parent: parent, pending: pending, ascending: ascending}
+	it.skipDeleted()
+	return it
+}
+
+func (it *mergedIterator) Domain() ([]byte, []byte) {
+	return it.parent.Domain()
+}
+
+func (it *mergedIterator) Valid() bool {
+	return it.parent.Valid() || it.idx < len(it.pending)
+}
+
+func (it *mergedIterator) Next() {
+	switch it.compare() {
+	case -1:
+		it.parent.Next()
+	case 0:
+		it.parent.Next()
+		it.idx++
+	case 1:
+		it.idx++
+	default:
+		panic("invalid iterator")
+	}
+	it.skipDeleted()
+}
+
+func (it *mergedIterator) Key() []byte {
+	if it.compare() < 0 {
+		return it.parent.Key()
+	}
+	return it.pending[it.idx].Key()
+}
+
+func (it *mergedIterator) Value() []byte {
+	if it.compare() < 0 {
+		return it.parent.Value()
+	}
+	return it.pending[it.idx].Value()
+}
+
+func (it *mergedIterator) Error() error {
+	return it.parent.Error()
+}
+
+func (it *mergedIterator) Close() error {
+	return it.parent.Close()
+}
+
+// compare returns -1 if the current item comes from the parent, 1 if it comes from the pending writes,
+// and 0 if both have the same key. It returns -2 if the iterator is invalid.
+func (it *mergedIterator) compare() int {
+	pValid, cValid := it.parent.Valid(), it.idx < len(it.pending)
+	switch {
+	case !pValid && !cValid:
+		return -2
+	case !cValid:
+		return -1
+	case !pValid:
+		return 1
+	}
+	c := bytes.Compare(it.parent.Key(), it.pending[it.idx].Key())
+	if !it.ascending {
+		c = -c
+	}
+	return c
+}
+
+// skipDeleted skips the items that are deleted by the pending writes
+func (it *mergedIterator) skipDeleted() {
+	for {
+		switch it.compare() {
+		case 0:
+			if it.pending[it.idx].Value() != nil {
+				return
+			}
+			it.parent.Next()
+			it.idx++
+		case 1:
+			if it.pending[it.idx].Value() != nil {
+				return
+			}
+			it.idx++
+		default:
+			return
+		}
+	}
+}
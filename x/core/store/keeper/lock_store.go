@@ -1,18 +1,42 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/datachainlab/cross/x/core/store/types"
+	"github.com/gogo/protobuf/proto"
 )
 
+// LockStore manages the locks of keys held by precommitted transactions.
+// An exclusive lock is held by a writer, and shared locks are held by readers.
 type LockStore interface {
+	// Lock acquires an exclusive lock for the key
 	Lock(ctx sdk.Context, key []byte)
+	// Unlock releases an exclusive lock for the key
 	Unlock(ctx sdk.Context, key []byte)
+	// LockShared acquires a shared lock for the key
+	LockShared(ctx sdk.Context, key []byte)
+	// UnlockShared releases a shared lock for the key
+	UnlockShared(ctx sdk.Context, key []byte)
+
+	// IsLocked returns a boolean whether the key is locked by either an exclusive lock or shared locks
 	IsLocked(ctx sdk.Context, key []byte) bool
+	// IsExclusivelyLocked returns a boolean whether the key is locked by an exclusive lock
+	IsExclusivelyLocked(ctx sdk.Context, key []byte) bool
+	// IsExclusivelyLockedInRange returns a boolean whether any key in the range [start, end) is locked by an exclusive lock
+	IsExclusivelyLockedInRange(ctx sdk.Context, start, end []byte) bool
+	// Iterator returns an iterator over all locks
+	Iterator(ctx sdk.Context) sdk.Iterator
 
 	Prefix(prefix []byte) LockStore
 }
 
+const (
+	lockTypeExclusive byte = 1
+	lockTypeShared    byte = 2
+)
+
 type lockStore struct {
 	store types.KVStoreI
 }
@@ -26,20 +50,205 @@ func newLockStore(store types.KVStoreI) lockStore {
 func (s lockStore) Lock(ctx sdk.Context, key []byte) {
 	lock := s.store.Get(ctx, key)
 	if lock != nil {
-		panic("fatal error")
+		panic(fmt.Errorf("fatal error: key '%x' is already locked", key))
 	}
-	s.store.Set(ctx, key, []byte{1})
+	s.store.Set(ctx, key, []byte{lockTypeExclusive})
 }
 
 func (s lockStore) Unlock(ctx sdk.Context, key []byte) {
+	lock := s.store.Get(ctx, key)
+	if lock == nil || lock[0] != lockTypeExclusive {
+		panic(fmt.Errorf("fatal error: key '%x' isn't locked exclusively", key))
+	}
 	s.store.Delete(ctx, key)
 }
 
+func (s lockStore) LockShared(ctx sdk.Context, key []byte) {
+	var count uint64
+	lock := s.store.Get(ctx, key)
+	if lock != nil {
+		if lock[0] != lockTypeShared {
+			panic(fmt.Errorf("fatal error: key '%x' is locked exclusively", key))
+		}
+		count = sdk.BigEndianToUint64(lock[1:])
+	}
+	s.store.Set(ctx, key, makeSharedLock(count+1))
+}
+
+func (s lockStore) UnlockShared(ctx sdk.Context, key []byte) {
+	lock := s.store.Get(ctx, key)
+	if lock == nil || lock[0] != lockTypeShared {
+		panic(fmt.Errorf("fatal error: key '%x' isn't locked by shared locks", key))
+	}
+	count := sdk.BigEndianToUint64(lock[1:])
+	if count <= 1 {
+		s.store.Delete(ctx, key)
+	} else {
+		s.store.Set(ctx, key, makeSharedLock(count-1))
+	}
+}
+
 func (s lockStore) IsLocked(ctx sdk.Context, key []byte) bool {
 	return s.store.Get(ctx, key) != nil
 }
 
+func (s lockStore) IsExclusivelyLocked(ctx sdk.Context, key []byte) bool {
+	lock := s.store.Get(ctx, key)
+	return lock != nil && lock[0] == lockTypeExclusive
+}
+
+func (s lockStore) IsExclusivelyLockedInRange(ctx sdk.Context, start, end []byte) bool {
+	iter := s.store.Iterator(ctx, start, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if iter.Value()[0] == lockTypeExclusive {
+			return true
+		}
+	}
+	return false
+}
+
+func (s lockStore) Iterator(ctx sdk.Context) sdk.Iterator {
+	return s.store.Iterator(ctx, nil, nil)
+}
+
 func (s lockStore) Prefix(prefix []byte) LockStore {
 	s.store = s.store.Prefix(prefix)
 	return s
 }
+
+func makeSharedLock(count uint64) []byte {
+	return append([]byte{lockTypeShared}, sdk.Uint64ToBigEndian(count)...)
+}
+
+// RangeLockStore manages the range locks held by precommitted transactions.
+// A range lock prevents other transactions from inserting, updating or deleting a key within the range.
+type RangeLockStore interface {
+	// Lock acquires the range locks for the transaction
+	Lock(ctx sdk.Context, id []byte, ranges []types.Range)
+	// Unlock releases all range locks held by the transaction
+	Unlock(ctx sdk.Context, id []byte)
+	// IsLocked returns a boolean whether the key is included in any range locks
+	IsLocked(ctx sdk.Context, key []byte) bool
+}
+
+const (
+	rangeLockTxPrefix      byte = 1
+	rangeLockSegmentPrefix byte = 2
+)
+
+// rangeLockStore keeps the range locks of each transaction, and indexes them as disjoint segments of the key space.
+// Each entry of the index maps a boundary key to the number of range locks that cover the keys from it to the next boundary,
+// so IsLocked only needs to seek the nearest boundary at or before the key regardless of the number of range locks.
+// Lock and Unlock update the boundaries within the range, and remove the ones that have the same count as the previous segment.
+type rangeLockStore struct {
+	store types.KVStoreI
+}
+
+var _ RangeLockStore = (*rangeLockStore)(nil)
+
+func newRangeLockStore(store types.KVStoreI) rangeLockStore {
+	return rangeLockStore{store: store}
+}
+
+func (s rangeLockStore) Lock(ctx sdk.Context, id []byte, ranges []types.Range) {
+	if len(ranges) == 0 {
+		return
+	}
+	if s.store.Has(ctx, rangeLockTxKey(id)) {
+		panic(fmt.Errorf("fatal error: id '%x' already holds range locks", id))
+	}
+	bz, err := proto.Marshal(&types.RangeLocks{Ranges: ranges})
+	if err != nil {
+		panic(err)
+	}
+	s.store.Set(ctx, rangeLockTxKey(id), bz)
+	for _, r := range ranges {
+		s.addSegments(ctx, r, 1)
+	}
+}
+
+func (s rangeLockStore) Unlock(ctx sdk.Context, id []byte) {
+	bz := s.store.Get(ctx, rangeLockTxKey(id))
+	if bz == nil {
+		return
+	}
+	var lks types.RangeLocks
+	if err := proto.Unmarshal(bz, &lks); err != nil {
+		panic(err)
+	}
+	for _, r := range lks.Ranges {
+		s.addSegments(ctx, r, -1)
+	}
+	s.store.Delete(ctx, rangeLockTxKey(id))
+}
+
+func (s rangeLockStore) IsLocked(ctx sdk.Context, key []byte) bool {
+	return s.segmentCount(ctx, append(rangeLockSegmentKey(key), 0)) > 0
+}
+
+// addSegments adds delta to the counts of the segments within the range
+func (s rangeLockStore) addSegments(ctx sdk.Context, r types.Range, delta int) {
+	s.split(ctx, r.Start)
+	end := []byte{rangeLockSegmentPrefix + 1}
+	if len(r.End) > 0 {
+		s.split(ctx, r.End)
+		end = rangeLockSegmentKey(r.End)
+	}
+
+	iter := s.store.Iterator(ctx, rangeLockSegmentKey(r.Start), end)
+	var (
+		keys   [][]byte
+		counts []uint64
+	)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+		counts = append(counts, sdk.BigEndianToUint64(iter.Value()))
+	}
+	iter.Close()
+	for i, key := range keys {
+		if delta < 0 && counts[i] == 0 {
+			panic(fmt.Errorf("fatal error: segment '%x' isn't locked", key[1:]))
+		}
+		s.store.Set(ctx, key, sdk.Uint64ToBigEndian(uint64(int64(counts[i])+int64(delta))))
+	}
+
+	s.merge(ctx, r.Start)
+	if len(r.End) > 0 {
+		s.merge(ctx, r.End)
+	}
+}
+
+// split makes the key a boundary of the segments if it isn't yet
+func (s rangeLockStore) split(ctx sdk.Context, key []byte) {
+	if sk := rangeLockSegmentKey(key); !s.store.Has(ctx, sk) {
+		s.store.Set(ctx, sk, sdk.Uint64ToBigEndian(s.segmentCount(ctx, sk)))
+	}
+}
+
+// merge removes the boundary at the key if the segment has the same count as the previous one
+func (s rangeLockStore) merge(ctx sdk.Context, key []byte) {
+	sk := rangeLockSegmentKey(key)
+	bz := s.store.Get(ctx, sk)
+	if bz != nil && sdk.BigEndianToUint64(bz) == s.segmentCount(ctx, sk) {
+		s.store.Delete(ctx, sk)
+	}
+}
+
+// segmentCount returns the count of the last segment that starts before the given segment key
+func (s rangeLockStore) segmentCount(ctx sdk.Context, before []byte) uint64 {
+	iter := s.store.ReverseIterator(ctx, []byte{rangeLockSegmentPrefix}, before)
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0
+	}
+	return sdk.BigEndianToUint64(iter.Value())
+}
+
+func rangeLockTxKey(id []byte) []byte {
+	return append([]byte{rangeLockTxPrefix}, id...)
+}
+
+func rangeLockSegmentKey(key []byte) []byte {
+	return append([]byte{rangeLockSegmentPrefix}, key...)
+}
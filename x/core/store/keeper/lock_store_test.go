@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/datachainlab/cross/x/core/store/types"
 	"github.com/stretchr/testify/require"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -32,3 +33,119 @@ func TestLockStore(t *testing.T) {
 	require.True(st.IsLocked(ctx, k0))
 	st.Unlock(ctx, k0)
 }
+
+func TestSharedLockStore(t *testing.T) {
+	require := require.New(t)
+	stk := sdk.NewKVStoreKey("main")
+
+	cms := makeCMStore(t, stk)
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, tmlog.NewNopLogger())
+	st := newLockStore(newKVStore(stk))
+	k0 := []byte("k0")
+
+	st.LockShared(ctx, k0)
+	st.LockShared(ctx, k0)
+	require.True(st.IsLocked(ctx, k0))
+	require.False(st.IsExclusivelyLocked(ctx, k0))
+	// a writer cannot acquire the lock while readers hold it
+	require.Panics(func() {
+		st.Lock(ctx, k0)
+	})
+	require.Panics(func() {
+		st.Unlock(ctx, k0)
+	})
+
+	st.UnlockShared(ctx, k0)
+	require.True(st.IsLocked(ctx, k0))
+	st.UnlockShared(ctx, k0)
+	require.False(st.IsLocked(ctx, k0))
+	require.Panics(func() {
+		st.UnlockShared(ctx, k0)
+	})
+
+	st.Lock(ctx, k0)
+	require.True(st.IsExclusivelyLocked(ctx, k0))
+	// a reader cannot acquire the lock while a writer holds it
+	require.Panics(func() {
+		st.LockShared(ctx, k0)
+	})
+	st.Unlock(ctx, k0)
+	require.False(st.IsLocked(ctx, k0))
+}
+
+func TestRangeLockStore(t *testing.T) {
+	require := require.New(t)
+	stk := sdk.NewKVStoreKey("main")
+
+	cms := makeCMStore(t, stk)
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, tmlog.NewNopLogger())
+	st := newRangeLockStore(newKVStore(stk))
+	id0, id1 := []byte("id0"), []byte("id1")
+
+	st.Lock(ctx, id0, []types.Range{
+		{Start: []byte("k"), End: []byte("k2")},
+		{Start: []byte("m")},
+	})
+	st.Lock(ctx, id1, []types.Range{
+		{Start: []byte("k"), End: []byte("k1")},
+		{End: []byte("b")},
+	})
+	require.Panics(func() {
+		st.Lock(ctx, id0, []types.Range{{Start: []byte("x")}})
+	})
+
+	var cases = []struct {
+		key      string
+		expected bool
+	}{
+		{"a", true},
+		{"b", false},
+		{"k", true},
+		{"k0", true},
+		{"k1", true},
+		{"k2", false},
+		{"l", false},
+		{"m", true},
+		{"z", true},
+	}
+	for _, c := range cases {
+		require.Equal(c.expected, st.IsLocked(ctx, []byte(c.key)), c.key)
+	}
+
+	// the range locks that share the same start key are released independently
+	st.Unlock(ctx, id0)
+	for _, c := range []struct {
+		key      string
+		expected bool
+	}{{"a", true}, {"k0", true}, {"k1", false}, {"m", false}} {
+		require.Equal(c.expected, st.IsLocked(ctx, []byte(c.key)), c.key)
+	}
+	st.Unlock(ctx, id0)
+
+	// overlapping and nested range locks
+	id2, id3 := []byte("id2"), []byte("id3")
+	st.Lock(ctx, id2, []types.Range{{Start: []byte("c"), End: []byte("h")}})
+	st.Lock(ctx, id3, []types.Range{{Start: []byte("d"), End: []byte("f")}, {Start: []byte("g"), End: []byte("j")}})
+	for _, c := range []struct {
+		key      string
+		expected bool
+	}{{"c", true}, {"e", true}, {"g", true}, {"h", true}, {"i", true}, {"j", false}} {
+		require.Equal(c.expected, st.IsLocked(ctx, []byte(c.key)), c.key)
+	}
+	st.Unlock(ctx, id2)
+	for _, c := range []struct {
+		key      string
+		expected bool
+	}{{"c", false}, {"d", true}, {"f", false}, {"g", true}, {"i", true}, {"j", false}} {
+		require.Equal(c.expected, st.IsLocked(ctx, []byte(c.key)), c.key)
+	}
+	st.Unlock(ctx, id3)
+
+	st.Unlock(ctx, id1)
+	for _, c := range cases {
+		require.False(st.IsLocked(ctx, []byte(c.key)), c.key)
+	}
+	iter := st.store.Iterator(ctx, nil, nil)
+	defer iter.Close()
+	require.False(iter.Valid())
+}
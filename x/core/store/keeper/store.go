@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -51,6 +53,14 @@ func (s kvStore) Delete(ctx sdk.Context, key []byte) {
 	s.KVStore(ctx).Delete(key)
 }
 
+func (s kvStore) Iterator(ctx sdk.Context, start, end []byte) sdk.Iterator {
+	return s.KVStore(ctx).Iterator(start, end)
+}
+
+func (s kvStore) ReverseIterator(ctx sdk.Context, start, end []byte) sdk.Iterator {
+	return s.KVStore(ctx).ReverseIterator(start, end)
+}
+
 func (s kvStore) store(ctx sdk.Context) sdk.KVStore {
 	switch storeKey := s.storeKey.(type) {
 	case *crosstypes.PrefixStoreKey:
@@ -61,23 +71,25 @@ func (s kvStore) store(ctx sdk.Context) sdk.KVStore {
 }
 
 type CommitKVStore struct {
-	storeKey   sdk.StoreKey
-	m          codec.Codec
-	stateStore types.KVStoreI
-	lockStore  LockStore
-	txStore    types.KVStoreI
-	prefix     []byte
+	storeKey       sdk.StoreKey
+	m              codec.Codec
+	stateStore     types.KVStoreI
+	lockStore      LockStore
+	txStore        types.KVStoreI
+	rangeLockStore RangeLockStore
+	prefix         []byte
 }
 
 var _ types.CommitKVStoreI = (*CommitKVStore)(nil)
 
 func NewStore(m codec.Codec, storeKey sdk.StoreKey) CommitKVStore {
 	return CommitKVStore{
-		storeKey:   storeKey,
-		m:          m,
-		stateStore: newKVStore(storeKey).Prefix([]byte{0}),
-		lockStore:  newLockStore(newKVStore(storeKey).Prefix([]byte{1})),
-		txStore:    newKVStore(storeKey).Prefix([]byte{2}),
+		storeKey:       storeKey,
+		m:              m,
+		stateStore:     newKVStore(storeKey).Prefix([]byte{0}),
+		lockStore:      newLockStore(newKVStore(storeKey).Prefix([]byte{1})),
+		txStore:        newKVStore(storeKey).Prefix([]byte{2}),
+		rangeLockStore: newRangeLockStore(newKVStore(storeKey).Prefix([]byte{3})),
 	}
 }
 
@@ -92,20 +104,22 @@ func (s CommitKVStore) Prefix(prefix []byte) types.KVStoreI {
 	return s
 }
 
+// KVStore returns a sdk.KVStore that is bound to the given context.
+// All operations via the returned store follow the commit mode of the context.
 func (s CommitKVStore) KVStore(ctx sdk.Context) sdk.KVStore {
-	panic("not implemented error")
+	return newCommitKVStoreAdapter(ctx, s)
 }
 
 func (s CommitKVStore) Set(ctx sdk.Context, key, value []byte) {
-	if s.lockStore.IsLocked(ctx, key) {
-		panic(fmt.Errorf("currently key '%x' is non-available", key))
-	}
+	s.ensureWritable(ctx, key)
 	switch contracttypes.CommitModeFromContext(ctx.Context()) {
 	case contracttypes.UnspecifiedMode, contracttypes.BasicMode:
 		s.stateStore.Set(ctx, key, value)
 		return
 	case contracttypes.AtomicMode:
-		types.LockManagerFromContext(ctx.Context()).AddWrite(s.buildKey(key), value)
+		if err := types.LockManagerFromContext(ctx.Context()).AddWrite(s.buildKey(key), value); err != nil {
+			panic(err)
+		}
 		return
 	default:
 		panic(fmt.Sprintf("unknown mode '%v'", contracttypes.CommitModeFromContext(ctx.Context())))
@@ -113,7 +127,7 @@ func (s CommitKVStore) Set(ctx sdk.Context, key, value []byte) {
 }
 
 func (s CommitKVStore) Get(ctx sdk.Context, key []byte) []byte {
-	if s.lockStore.IsLocked(ctx, key) {
+	if s.lockStore.IsExclusivelyLocked(ctx, key) {
 		panic(fmt.Errorf("currently key '%x' is non-available", key))
 	}
 	switch contracttypes.CommitModeFromContext(ctx.Context()) {
@@ -121,8 +135,12 @@ func (s CommitKVStore) Get(ctx sdk.Context, key []byte) []byte {
 		return s.stateStore.Get(ctx, key)
 	case contracttypes.AtomicMode:
 		lkmgr := types.LockManagerFromContext(ctx.Context())
-		v, ok := lkmgr.GetUpdatedValue(s.buildKey(key))
+		k := s.buildKey(key)
+		v, ok := lkmgr.GetUpdatedValue(k)
 		if !ok {
+			if err := lkmgr.AddRead(k); err != nil {
+				panic(err)
+			}
 			v = s.stateStore.Get(ctx, key)
 		}
 		return v
@@ -132,7 +150,7 @@ func (s CommitKVStore) Get(ctx sdk.Context, key []byte) []byte {
 }
 
 func (s CommitKVStore) Has(ctx sdk.Context, key []byte) bool {
-	if s.lockStore.IsLocked(ctx, key) {
+	if s.lockStore.IsExclusivelyLocked(ctx, key) {
 		panic(fmt.Errorf("currently key '%x' is non-available", key))
 	}
 	switch contracttypes.CommitModeFromContext(ctx.Context()) {
@@ -140,37 +158,101 @@ func (s CommitKVStore) Has(ctx sdk.Context, key []byte) bool {
 		return s.stateStore.Has(ctx, key)
 	case contracttypes.AtomicMode:
 		lkmgr := types.LockManagerFromContext(ctx.Context())
-		found := false
-		v, ok := lkmgr.GetUpdatedValue(s.buildKey(key))
+		k := s.buildKey(key)
+		v, ok := lkmgr.GetUpdatedValue(k)
 		if !ok {
-			v = s.stateStore.Get(ctx, key)
-			if v != nil {
-				found = true
+			if err := lkmgr.AddRead(k); err != nil {
+				panic(err)
 			}
-		} else {
-			found = true
+			v = s.stateStore.Get(ctx, key)
 		}
-		return found
+		return v != nil
 	default:
 		panic(fmt.Sprintf("unknown mode '%v'", contracttypes.CommitModeFromContext(ctx.Context())))
 	}
 }
 
 func (s CommitKVStore) Delete(ctx sdk.Context, key []byte) {
-	if s.lockStore.IsLocked(ctx, key) {
-		panic(fmt.Errorf("currently key '%x' is non-available", key))
-	}
+	s.ensureWritable(ctx, key)
 	switch contracttypes.CommitModeFromContext(ctx.Context()) {
 	case contracttypes.UnspecifiedMode, contracttypes.BasicMode:
 		s.stateStore.Delete(ctx, key)
 		return
 	case contracttypes.AtomicMode:
-		types.LockManagerFromContext(ctx.Context()).AddWrite(s.buildKey(key), nil)
+		if err := types.LockManagerFromContext(ctx.Context()).AddDelete(s.buildKey(key)); err != nil {
+			panic(err)
+		}
 	default:
 		panic(fmt.Sprintf("unknown mode '%v'", contracttypes.CommitModeFromContext(ctx.Context())))
 	}
 }
 
+func (s CommitKVStore) Iterator(ctx sdk.Context, start, end []byte) sdk.Iterator {
+	return s.iterator(ctx, start, end, true)
+}
+
+func (s CommitKVStore) ReverseIterator(ctx sdk.Context, start, end []byte) sdk.Iterator {
+	return s.iterator(ctx, start, end, false)
+}
+
+func (s CommitKVStore) iterator(ctx sdk.Context, start, end []byte, ascending bool) sdk.Iterator {
+	if s.lockStore.IsExclusivelyLockedInRange(ctx, start, end) {
+		panic(fmt.Errorf("currently range ['%x', '%x') is non-available", start, end))
+	}
+	var parent sdk.Iterator
+	if ascending {
+		parent = s.stateStore.Iterator(ctx, start, end)
+	} else {
+		parent = s.stateStore.ReverseIterator(ctx, start, end)
+	}
+	switch contracttypes.CommitModeFromContext(ctx.Context()) {
+	case contracttypes.UnspecifiedMode, contracttypes.BasicMode:
+		return parent
+	case contracttypes.AtomicMode:
+		lkmgr := types.LockManagerFromContext(ctx.Context())
+		r := s.buildRange(start, end)
+		if err := lkmgr.AddReadRange(r.Start, r.End); err != nil {
+			parent.Close()
+			panic(err)
+		}
+		return newMergedIterator(parent, s.pendingOPs(lkmgr, start, end, ascending), ascending)
+	default:
+		parent.Close()
+		panic(fmt.Sprintf("unknown mode '%v'", contracttypes.CommitModeFromContext(ctx.Context())))
+	}
+}
+
+// pendingOPs returns the pending writes within the range [start, end) in the iteration order.
+// The prefix of the store is removed from the keys of the returned ops.
+func (s CommitKVStore) pendingOPs(lkmgr types.LockManager, start, end []byte, ascending bool) []types.LockOP {
+	r := types.Range{Start: start, End: end}
+	var ops []types.LockOP
+	for _, op := range lkmgr.LockOPs().Ops {
+		if !bytes.HasPrefix(op.K, s.prefix) {
+			continue
+		}
+		k := op.K[len(s.prefix):]
+		if !r.Contains(k) {
+			continue
+		}
+		ops = append(ops, types.LockOP{K: k, V: op.V})
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ascending {
+			return bytes.Compare(ops[i].K, ops[j].K) < 0
+		}
+		return bytes.Compare(ops[i].K, ops[j].K) > 0
+	})
+	return ops
+}
+
+// ensureWritable panics if the key is locked by other transactions
+func (s CommitKVStore) ensureWritable(ctx sdk.Context, key []byte) {
+	if s.lockStore.IsLocked(ctx, key) || s.rangeLockStore.IsLocked(ctx, s.buildKey(key)) {
+		panic(fmt.Errorf("currently key '%x' is non-available", key))
+	}
+}
+
 func (s CommitKVStore) Precommit(ctx sdk.Context, id []byte) error {
 	if s.txStore.Has(ctx, id) {
 		return fmt.Errorf("id '%x' already exists", id)
@@ -180,10 +262,29 @@ func (s CommitKVStore) Precommit(ctx sdk.Context, id []byte) error {
 	if err != nil {
 		return err
 	}
+	for _, lk := range lks.Ops {
+		if s.lockStore.IsLocked(ctx, lk.Key()) || s.rangeLockStore.IsLocked(ctx, lk.Key()) {
+			return fmt.Errorf("key '%x' is locked by another transaction", lk.Key())
+		}
+	}
+	for _, k := range lks.Reads {
+		if s.lockStore.IsExclusivelyLocked(ctx, k) {
+			return fmt.Errorf("key '%x' is locked exclusively by another transaction", k)
+		}
+	}
+	for _, r := range lks.Ranges {
+		if s.lockStore.IsExclusivelyLockedInRange(ctx, r.Start, r.End) {
+			return fmt.Errorf("range ['%x', '%x') is locked exclusively by another transaction", r.Start, r.End)
+		}
+	}
 	s.txStore.Set(ctx, id, bz)
 	for _, lk := range lks.Ops {
 		s.lockStore.Lock(ctx, lk.Key())
 	}
+	for _, k := range lks.Reads {
+		s.lockStore.LockShared(ctx, k)
+	}
+	s.rangeLockStore.Lock(ctx, id, lks.Ranges)
 	return nil
 }
 
@@ -197,7 +298,7 @@ func (s CommitKVStore) Abort(ctx sdk.Context, id []byte) error {
 	if err := proto.Unmarshal(bz, &lks); err != nil {
 		return err
 	}
-	s.clean(ctx, id, lks.Ops)
+	s.clean(ctx, id, lks)
 	return nil
 }
 
@@ -211,7 +312,7 @@ func (s CommitKVStore) Commit(ctx sdk.Context, id []byte) error {
 		return err
 	}
 	s.apply(ctx, lks.Ops)
-	s.clean(ctx, id, lks.Ops)
+	s.clean(ctx, id, lks)
 	return nil
 }
 
@@ -226,14 +327,18 @@ func (s CommitKVStore) apply(ctx sdk.Context, ops []types.LockOP) {
 	}
 }
 
-func (s CommitKVStore) clean(ctx sdk.Context, id []byte, ops []types.LockOP) {
+func (s CommitKVStore) clean(ctx sdk.Context, id []byte, lks types.LockOPs) {
 	if !s.txStore.Has(ctx, id) {
 		panic(fmt.Errorf("id '%x' not found", id))
 	}
 	s.txStore.Delete(ctx, id)
-	for _, op := range ops {
+	for _, op := range lks.Ops {
 		s.lockStore.Unlock(ctx, op.Key())
 	}
+	for _, k := range lks.Reads {
+		s.lockStore.UnlockShared(ctx, k)
+	}
+	s.rangeLockStore.Unlock(ctx, id)
 }
 
 func (s CommitKVStore) buildKey(key []byte) []byte {
@@ -242,3 +347,17 @@ func (s CommitKVStore) buildKey(key []byte) []byte {
 	copy(newkey[len(s.prefix):], key)
 	return newkey
 }
+
+// buildRange returns a range that the prefix of the store is prepended to
+func (s CommitKVStore) buildRange(start, end []byte) types.Range {
+	var r types.Range
+	if len(start) > 0 || len(s.prefix) > 0 {
+		r.Start = s.buildKey(start)
+	}
+	if len(end) > 0 {
+		r.End = s.buildKey(end)
+	} else {
+		r.End = sdk.PrefixEndBytes(s.prefix)
+	}
+	return r
+}
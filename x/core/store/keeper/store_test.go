@@ -131,6 +131,7 @@ func TestCommitStore(t *testing.T) {
 		require.NoError(st.Precommit(ctx, id1))
 		require.Equal(1, len(lkmgr.LockOPs().Ops))
 		require.Equal(types.LockOP{K: k1, V: v1}, lkmgr.LockOPs().Ops[0])
+		require.Equal([][]byte{k0}, lkmgr.LockOPs().Reads)
 		cms.Commit()
 
 		// check if concurrent access is failed
@@ -144,6 +145,30 @@ func TestCommitStore(t *testing.T) {
 			ctx, _ := makeContext(cms).CacheContext()
 			_ = st.Get(ctx, k0)
 		})
+
+		// check if concurrent write access to the read key is failed
+		require.Panics(func() {
+			ctx, _ := makeContext(cms).CacheContext()
+			st.Set(ctx, k0, v1)
+		})
+
+		// check if another transaction that reads the same key can be precommitted
+		{
+			lkmgr := types.NewLockManager()
+			ctx, _ := makeAtomicModeContext(cms, lkmgr).CacheContext()
+			require.Equal(v0, st.Get(ctx, k0))
+			require.NoError(st.Precommit(ctx, []byte("id2")))
+			require.NoError(st.Abort(ctx, []byte("id2")))
+		}
+
+		// check if another transaction that writes the read key cannot be precommitted
+		{
+			lkmgr := types.NewLockManager()
+			ctx, _ := makeAtomicModeContext(cms, lkmgr).CacheContext()
+			require.NoError(lkmgr.AddWrite(k0, v1))
+			require.Error(st.Precommit(ctx, []byte("id3")))
+		}
+
 		require.NoError(st.Commit(ctx, id1))
 		cms.Commit()
 
@@ -151,10 +176,110 @@ func TestCommitStore(t *testing.T) {
 			ctx, _ := makeContext(cms).CacheContext()
 			_ = st.Get(ctx, k1)
 			_ = st.Get(ctx, k0)
+			st.Set(ctx, k0, v1)
 		})
 	}
 }
 
+func TestCommitStoreIterator(t *testing.T) {
+	require := require.New(t)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	stk := sdk.NewKVStoreKey("main")
+	cms := makeCMStore(t, stk)
+	root := NewStore(cdc, stk)
+	st := root.Prefix([]byte("p/"))
+
+	var collect = func(it sdk.Iterator) (keys []string, values []string) {
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, string(it.Key()))
+			values = append(values, string(it.Value()))
+		}
+		return
+	}
+
+	{
+		ctx := makeContext(cms)
+		st.Set(ctx, []byte("k1"), []byte("v1"))
+		st.Set(ctx, []byte("k2"), []byte("v2"))
+		st.Set(ctx, []byte("k4"), []byte("v4"))
+		cms.Commit()
+	}
+
+	{
+		ctx, _ := makeContext(cms).CacheContext()
+		keys, values := collect(st.Iterator(ctx, nil, nil))
+		require.Equal([]string{"k1", "k2", "k4"}, keys)
+		require.Equal([]string{"v1", "v2", "v4"}, values)
+		keys, _ = collect(st.ReverseIterator(ctx, []byte("k2"), nil))
+		require.Equal([]string{"k4", "k2"}, keys)
+	}
+
+	lkmgr := types.NewLockManager()
+	ctx := makeAtomicModeContext(cms, lkmgr)
+	id0 := []byte("id0")
+	{
+		st.Set(ctx, []byte("k3"), []byte("v3"))
+		st.Set(ctx, []byte("k1"), []byte("v1-1"))
+		st.Delete(ctx, []byte("k2"))
+		st.Set(ctx, []byte("k5"), []byte("v5"))
+		st.Delete(ctx, []byte("k5"))
+
+		keys, values := collect(st.Iterator(ctx, nil, nil))
+		require.Equal([]string{"k1", "k3", "k4"}, keys)
+		require.Equal([]string{"v1-1", "v3", "v4"}, values)
+		keys, values = collect(st.ReverseIterator(ctx, nil, []byte("k4")))
+		require.Equal([]string{"k3", "k1"}, keys)
+		require.Equal([]string{"v3", "v1-1"}, values)
+		// the iterator via sdk.KVStore returns the same result
+		keys, _ = collect(st.KVStore(ctx).Iterator(nil, nil))
+		require.Equal([]string{"k1", "k3", "k4"}, keys)
+		require.False(st.Has(ctx, []byte("k2")))
+
+		require.NoError(root.Precommit(ctx, id0))
+		require.Equal(3, len(lkmgr.LockOPs().Ranges))
+		cms.Commit()
+	}
+
+	// check if an iteration over the locked keys is failed
+	require.Panics(func() {
+		ctx, _ := makeContext(cms).CacheContext()
+		collect(st.Iterator(ctx, nil, nil))
+	})
+	// check if an insertion into the locked range is failed
+	require.Panics(func() {
+		ctx, _ := makeContext(cms).CacheContext()
+		st.Set(ctx, []byte("k0"), []byte("v0"))
+	})
+	// check if an insertion out of the locked range is success
+	require.NotPanics(func() {
+		ctx, _ := makeContext(cms).CacheContext()
+		root.Prefix([]byte("q/")).Set(ctx, []byte("k0"), []byte("v0"))
+	})
+	// check if another transaction that inserts a key into the locked range cannot be precommitted
+	{
+		lkmgr := types.NewLockManager()
+		ctx, _ := makeAtomicModeContext(cms, lkmgr).CacheContext()
+		require.NoError(lkmgr.AddWrite([]byte("p/k6"), []byte("v6")))
+		require.Error(root.Precommit(ctx, []byte("id1")))
+	}
+
+	require.NoError(root.Commit(ctx, id0))
+	cms.Commit()
+
+	{
+		ctx, _ := makeContext(cms).CacheContext()
+		keys, values := collect(st.Iterator(ctx, nil, nil))
+		require.Equal([]string{"k1", "k3", "k4"}, keys)
+		require.Equal([]string{"v1-1", "v3", "v4"}, values)
+		st.Set(ctx, []byte("k0"), []byte("v0"))
+	}
+}
+
 func makeContext(cms sdk.CommitMultiStore) sdk.Context {
 	return sdk.NewContext(cms, tmproto.Header{}, false, tmlog.NewNopLogger())
 }
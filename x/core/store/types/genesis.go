@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new cross store GenesisState instance.
+func NewGenesisState(entries []KVPair, txs []PrecommittedTx, locks []Lock) *GenesisState {
+	return &GenesisState{
+		Entries: entries,
+		Txs:     txs,
+		Locks:   locks,
+	}
+}
+
+// DefaultGenesis returns a GenesisState instance
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+// Every lock must be held by the precommitted transactions, and every lock that the transactions require must exist.
+func (gs GenesisState) Validate() error {
+	entries := make(map[string]bool)
+	for _, e := range gs.Entries {
+		if len(e.Key) == 0 {
+			return fmt.Errorf("entry key must not be empty")
+		} else if e.Value == nil {
+			return fmt.Errorf("entry '%x' has no value", e.Key)
+		} else if entries[string(e.Key)] {
+			return fmt.Errorf("duplicate entry '%x'", e.Key)
+		}
+		entries[string(e.Key)] = true
+	}
+
+	// expected locks that are computed from the precommitted transactions
+	expected := make(map[string]Lock)
+	txs := make(map[string]bool)
+	for _, tx := range gs.Txs {
+		if len(tx.Id) == 0 {
+			return fmt.Errorf("tx id must not be empty")
+		} else if txs[string(tx.Id)] {
+			return fmt.Errorf("duplicate tx '%x'", tx.Id)
+		}
+		txs[string(tx.Id)] = true
+		for _, op := range tx.LockOps.Ops {
+			if _, ok := expected[string(op.Key())]; ok {
+				return fmt.Errorf("key '%x' is locked by multiple transactions", op.Key())
+			}
+			expected[string(op.Key())] = Lock{Key: op.Key(), Exclusive: true}
+		}
+		for _, k := range tx.LockOps.Reads {
+			lk, ok := expected[string(k)]
+			if ok && lk.Exclusive {
+				return fmt.Errorf("key '%x' is locked exclusively by another transaction", k)
+			}
+			expected[string(k)] = Lock{Key: k, SharedCount: lk.SharedCount + 1}
+		}
+	}
+
+	for _, lk := range gs.Locks {
+		if err := lk.ValidateBasic(); err != nil {
+			return err
+		}
+		exp, ok := expected[string(lk.Key)]
+		if !ok {
+			return fmt.Errorf("lock for key '%x' isn't held by any precommitted transactions", lk.Key)
+		} else if exp.Exclusive != lk.Exclusive || exp.SharedCount != lk.SharedCount {
+			return fmt.Errorf("lock for key '%x' is inconsistent with the precommitted transactions: expected=%v actual=%v", lk.Key, exp, lk)
+		}
+		delete(expected, string(lk.Key))
+	}
+	for _, lk := range expected {
+		return fmt.Errorf("lock for key '%x' not found", lk.Key)
+	}
+	return nil
+}
+
+// ValidateBasic validates the lock
+func (lk Lock) ValidateBasic() error {
+	if len(lk.Key) == 0 {
+		return fmt.Errorf("lock key must not be empty")
+	} else if lk.Exclusive && lk.SharedCount != 0 {
+		return fmt.Errorf("exclusive lock for key '%x' must not have shared locks", lk.Key)
+	} else if !lk.Exclusive && lk.SharedCount == 0 {
+		return fmt.Errorf("lock for key '%x' must be either exclusive or shared", lk.Key)
+	}
+	return nil
+}
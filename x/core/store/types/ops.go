@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,20 +23,39 @@ func (lo LockOP) ApplyTo(kvs sdk.KVStore) {
 	}
 }
 
+// Contains returns a boolean whether the key is included in the range
+func (r Range) Contains(key []byte) bool {
+	if len(r.Start) > 0 && bytes.Compare(key, r.Start) < 0 {
+		return false
+	}
+	if len(r.End) > 0 && bytes.Compare(key, r.End) >= 0 {
+		return false
+	}
+	return true
+}
+
 type LockManager interface {
 	AddWrite(key, value []byte) error
+	AddDelete(key []byte) error
+	AddRead(key []byte) error
+	AddReadRange(start, end []byte) error
 	GetUpdatedValue(key []byte) ([]byte, bool)
 	LockOPs() LockOPs
 }
 
 // NewLockManager returns a LockManager instance
 func NewLockManager() LockManager {
-	return &lockManager{changes: make(map[string]uint64)}
+	return &lockManager{changes: make(map[string]uint64), readSet: make(map[string]struct{})}
 }
 
 type lockManager struct {
 	ops     []LockOP
 	changes map[string]uint64
+
+	reads   [][]byte
+	readSet map[string]struct{}
+
+	ranges []Range
 }
 
 func (m *lockManager) AddWrite(k, v []byte) error {
@@ -46,11 +66,44 @@ func (m *lockManager) AddWrite(k, v []byte) error {
 		return errors.New("value cannot be nil")
 	}
 
-	m.ops = append(m.ops, LockOP{k, v})
+	m.ops = append(m.ops, LockOP{K: k, V: v})
+	m.changes[string(k)] = uint64(len(m.ops) - 1)
+	return nil
+}
+
+// AddDelete records a deletion of the key
+func (m *lockManager) AddDelete(k []byte) error {
+	if len(k) == 0 {
+		return errors.New("key cannot be empty")
+	}
+
+	m.ops = append(m.ops, LockOP{K: k})
 	m.changes[string(k)] = uint64(len(m.ops) - 1)
 	return nil
 }
 
+// AddRead records a key that is read by the transaction
+func (m *lockManager) AddRead(k []byte) error {
+	if len(k) == 0 {
+		return errors.New("key cannot be empty")
+	}
+	if _, ok := m.readSet[string(k)]; ok {
+		return nil
+	}
+	m.readSet[string(k)] = struct{}{}
+	m.reads = append(m.reads, k)
+	return nil
+}
+
+// AddReadRange records a range of keys [start, end) that is iterated by the transaction
+func (m *lockManager) AddReadRange(start, end []byte) error {
+	if len(start) > 0 && len(end) > 0 && bytes.Compare(start, end) >= 0 {
+		return errors.New("start must be less than end")
+	}
+	m.ranges = append(m.ranges, Range{Start: start, End: end})
+	return nil
+}
+
 func (m lockManager) GetUpdatedValue(key []byte) ([]byte, bool) {
 	idx, ok := m.changes[string(key)]
 	if !ok {
@@ -70,5 +123,12 @@ func (m lockManager) LockOPs() LockOPs {
 			ops = append(ops, op)
 		}
 	}
-	return LockOPs{Ops: ops}
+	// a key that is also written is locked exclusively, so it doesn't need a shared lock
+	var reads [][]byte
+	for _, k := range m.reads {
+		if _, ok := items[string(k)]; !ok {
+			reads = append(reads, k)
+		}
+	}
+	return LockOPs{Ops: ops, Reads: reads, Ranges: m.ranges}
 }
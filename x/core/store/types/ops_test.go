@@ -11,10 +11,14 @@ func TestLockManager(t *testing.T) {
 	type input struct {
 		K []byte
 		V []byte
+		R bool
 	}
 
 	var W = func(k, v string) input {
-		return input{[]byte(k), []byte(v)}
+		return input{[]byte(k), []byte(v), false}
+	}
+	var R = func(k string) input {
+		return input{[]byte(k), nil, true}
 	}
 	var L = func(k, v string) LockOP {
 		return LockOP{K: []byte(k), V: []byte(v)}
@@ -23,22 +27,37 @@ func TestLockManager(t *testing.T) {
 	var cases = []struct {
 		inputs  []input
 		LockOPs []LockOP
+		Reads   [][]byte
 	}{
 		{
 			[]input{},
 			[]LockOP{},
+			nil,
 		},
 		{
 			[]input{W("k1", "v1-1")},
 			[]LockOP{L("k1", "v1-1")},
+			nil,
 		},
 		{
 			[]input{W("k1", "v1-1"), W("k2", "v2-1")},
 			[]LockOP{L("k1", "v1-1"), L("k2", "v2-1")},
+			nil,
 		},
 		{
 			[]input{W("k1", "v1-1"), W("k1", "v1-2")},
 			[]LockOP{L("k1", "v1-2")},
+			nil,
+		},
+		{
+			[]input{R("k1"), R("k2"), R("k1")},
+			[]LockOP{},
+			[][]byte{[]byte("k1"), []byte("k2")},
+		},
+		{
+			[]input{R("k1"), W("k1", "v1-1"), R("k2")},
+			[]LockOP{L("k1", "v1-1")},
+			[][]byte{[]byte("k2")},
 		},
 	}
 
@@ -47,9 +66,35 @@ func TestLockManager(t *testing.T) {
 			require := require.New(t)
 			m := NewLockManager()
 			for _, in := range cs.inputs {
-				require.NoError(m.AddWrite(in.K, in.V))
+				if in.R {
+					require.NoError(m.AddRead(in.K))
+				} else {
+					require.NoError(m.AddWrite(in.K, in.V))
+				}
 			}
-			require.Equal(LockOPs{Ops: cs.LockOPs}, m.LockOPs())
+			require.Equal(LockOPs{Ops: cs.LockOPs, Reads: cs.Reads}, m.LockOPs())
+		})
+	}
+}
+
+func TestRange(t *testing.T) {
+	var cases = []struct {
+		r        Range
+		key      string
+		contains bool
+	}{
+		{Range{}, "k1", true},
+		{Range{Start: []byte("k1")}, "k1", true},
+		{Range{Start: []byte("k1")}, "k0", false},
+		{Range{End: []byte("k2")}, "k1", true},
+		{Range{End: []byte("k2")}, "k2", false},
+		{Range{Start: []byte("k1"), End: []byte("k2")}, "k1/a", true},
+		{Range{Start: []byte("k1"), End: []byte("k2")}, "k3", false},
+	}
+
+	for i, cs := range cases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			require.Equal(t, cs.contains, cs.r.Contains([]byte(cs.key)))
 		})
 	}
 }
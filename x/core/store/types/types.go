@@ -14,6 +14,13 @@ type KVStoreI interface {
 	Get(ctx sdk.Context, key []byte) []byte
 	Has(ctx sdk.Context, key []byte) bool
 	Delete(ctx sdk.Context, key []byte)
+
+	// Iterator returns an iterator over a domain of keys [start, end) in ascending order.
+	// Iterator must be closed by caller.
+	Iterator(ctx sdk.Context, start, end []byte) sdk.Iterator
+	// ReverseIterator returns an iterator over a domain of keys [start, end) in descending order.
+	// Iterator must be closed by caller.
+	ReverseIterator(ctx sdk.Context, start, end []byte) sdk.Iterator
 }
 
 // CommitKVStoreI defines the expected key-value commit store
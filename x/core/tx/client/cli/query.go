@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+	"github.com/spf13/cobra"
+
+	"github.com/datachainlab/cross/x/core/tx/types"
+)
+
+// GetParamsCmd returns the command to query the cross parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current cross parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			q := types.NewQueryClient(clientCtx)
+			res, err := q.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryDefaultTimeout returns the default timeout height and timestamp relative to a given header
+// The offsets are given by the cross parameters of the chain.
+func QueryDefaultTimeout(clientCtx client.Context, h ibctmtypes.Header, height int64) (clienttypes.Height, uint64, error) {
+	q := types.NewQueryClient(clientCtx)
+	res, err := q.Params(context.Background(), &types.QueryParamsRequest{})
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+	timeoutHeight := clienttypes.NewHeight(
+		clienttypes.ParseChainID(h.Header.ChainID),
+		uint64(height)+res.Params.DefaultTimeoutHeightOffset,
+	)
+	var timeoutTimestamp uint64
+	if offset := res.Params.DefaultTimeoutTimestampOffset; offset > 0 {
+		timeoutTimestamp = uint64(h.Header.Time.Unix()) + offset
+	}
+	return timeoutHeight, timeoutTimestamp, nil
+}
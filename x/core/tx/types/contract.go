@@ -28,6 +28,11 @@ type ContractManager interface {
 		txID crosstypes.TxID,
 		txIndex crosstypes.TxIndex,
 	) error
+
+	// GetContractCallResults returns the results of the prepared contract transactions that are not committed or aborted yet
+	GetContractCallResults(ctx sdk.Context) ([]IdentifiedContractCallResult, error)
+	// SetContractCallResult restores the result of a prepared contract transaction
+	SetContractCallResult(ctx sdk.Context, result IdentifiedContractCallResult)
 }
 
 // GetData returns Data
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultMaxContractTransactions is the default value for the MaxContractTransactions param
+	DefaultMaxContractTransactions uint32 = 10
+	// DefaultMaxCallResults is the default value for the MaxCallResults param
+	DefaultMaxCallResults uint32 = 10
+	// DefaultTimeoutHeightOffset is the default value for the DefaultTimeoutHeightOffset param
+	DefaultTimeoutHeightOffset uint64 = 100
+	// DefaultThreePhaseCommitTimeout is the default value for the ThreePhaseCommitTimeout param
+	DefaultThreePhaseCommitTimeout uint64 = 600
+	// DefaultIBCSignTxRecordRetention is the default value for the IBCSignTxRecordRetention param
+	DefaultIBCSignTxRecordRetention uint64 = 7 * 24 * 60 * 60
+)
+
+// Parameter store keys
+var (
+	KeyAllowedCommitProtocols        = []byte("AllowedCommitProtocols")
+	KeyMaxContractTransactions       = []byte("MaxContractTransactions")
+	KeyMaxCallResults                = []byte("MaxCallResults")
+	KeyCrossChainCallsEnabled        = []byte("CrossChainCallsEnabled")
+	KeyDefaultTimeoutHeightOffset    = []byte("DefaultTimeoutHeightOffset")
+	KeyDefaultTimeoutTimestampOffset = []byte("DefaultTimeoutTimestampOffset")
+	KeyThreePhaseCommitTimeout       = []byte("ThreePhaseCommitTimeout")
+	KeyIBCSignTxRecordRetention      = []byte("IBCSignTxRecordRetention")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table for the cross modules' parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	allowedCommitProtocols []CommitProtocol,
+	maxContractTransactions, maxCallResults uint32,
+	crossChainCallsEnabled bool,
+	defaultTimeoutHeightOffset, defaultTimeoutTimestampOffset uint64,
+	threePhaseCommitTimeout uint64,
+	ibcSignTxRecordRetention uint64,
+) Params {
+	return Params{
+		AllowedCommitProtocols:        allowedCommitProtocols,
+		MaxContractTransactions:       maxContractTransactions,
+		MaxCallResults:                maxCallResults,
+		CrossChainCallsEnabled:        crossChainCallsEnabled,
+		DefaultTimeoutHeightOffset:    defaultTimeoutHeightOffset,
+		DefaultTimeoutTimestampOffset: defaultTimeoutTimestampOffset,
+		ThreePhaseCommitTimeout:       threePhaseCommitTimeout,
+		IbcSignTxRecordRetention:      ibcSignTxRecordRetention,
+	}
+}
+
+// DefaultParams returns the default parameters
+func DefaultParams() Params {
+	return NewParams(
+		[]CommitProtocol{COMMIT_PROTOCOL_SIMPLE, COMMIT_PROTOCOL_TPC, COMMIT_PROTOCOL_3PC, COMMIT_PROTOCOL_SAGA},
+		DefaultMaxContractTransactions,
+		DefaultMaxCallResults,
+		true,
+		DefaultTimeoutHeightOffset,
+		0,
+		DefaultThreePhaseCommitTimeout,
+		DefaultIBCSignTxRecordRetention,
+	)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowedCommitProtocols, &p.AllowedCommitProtocols, validateAllowedCommitProtocols),
+		paramtypes.NewParamSetPair(KeyMaxContractTransactions, &p.MaxContractTransactions, validatePositiveUint32),
+		paramtypes.NewParamSetPair(KeyMaxCallResults, &p.MaxCallResults, validatePositiveUint32),
+		paramtypes.NewParamSetPair(KeyCrossChainCallsEnabled, &p.CrossChainCallsEnabled, validateBool),
+		paramtypes.NewParamSetPair(KeyDefaultTimeoutHeightOffset, &p.DefaultTimeoutHeightOffset, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyDefaultTimeoutTimestampOffset, &p.DefaultTimeoutTimestampOffset, validateUint64),
+		paramtypes.NewParamSetPair(KeyThreePhaseCommitTimeout, &p.ThreePhaseCommitTimeout, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyIBCSignTxRecordRetention, &p.IbcSignTxRecordRetention, validatePositiveUint64),
+	}
+}
+
+// LoadParams returns the params stored in a given subspace.
+// A param that doesn't exist in the store falls back to its default value, so that a chain upgraded from the version without the param doesn't panic.
+func LoadParams(ctx sdk.Context, paramSpace paramtypes.Subspace) Params {
+	params := DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	return params
+}
+
+// Validate performs basic validation on the parameters
+func (p Params) Validate() error {
+	if err := validateAllowedCommitProtocols(p.AllowedCommitProtocols); err != nil {
+		return err
+	} else if err := validatePositiveUint32(p.MaxContractTransactions); err != nil {
+		return fmt.Errorf("invalid max contract transactions: %w", err)
+	} else if err := validatePositiveUint32(p.MaxCallResults); err != nil {
+		return fmt.Errorf("invalid max call results: %w", err)
+	} else if err := validatePositiveUint64(p.DefaultTimeoutHeightOffset); err != nil {
+		return fmt.Errorf("invalid default timeout height offset: %w", err)
+	} else if err := validatePositiveUint64(p.ThreePhaseCommitTimeout); err != nil {
+		return fmt.Errorf("invalid three-phase commit timeout: %w", err)
+	} else if err := validatePositiveUint64(p.IbcSignTxRecordRetention); err != nil {
+		return fmt.Errorf("invalid IBCSignTx record retention: %w", err)
+	}
+	return nil
+}
+
+// IsAllowedCommitProtocol returns true if a given commit protocol is allowed
+func (p Params) IsAllowedCommitProtocol(protocol CommitProtocol) bool {
+	for _, cp := range p.AllowedCommitProtocols {
+		if cp == protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateContractTransactions checks if a given number of contract transactions doesn't exceed the limit
+func (p Params) ValidateContractTransactions(n int) error {
+	if n > int(p.MaxContractTransactions) {
+		return fmt.Errorf("the number of contract transactions exceeds the limit: %v > %v", n, p.MaxContractTransactions)
+	}
+	return nil
+}
+
+// ValidateCallResults checks if a contract transaction can have a given number of call results
+func (p Params) ValidateCallResults(n int) error {
+	if n == 0 {
+		return nil
+	} else if !p.CrossChainCallsEnabled {
+		return errors.New("cross-chain calls are disabled")
+	} else if n > int(p.MaxCallResults) {
+		return fmt.Errorf("the number of call results exceeds the limit: %v > %v", n, p.MaxCallResults)
+	}
+	return nil
+}
+
+// ValidateResolvedContractTransactions checks if given transactions satisfy the limits of the parameters
+func (p Params) ValidateResolvedContractTransactions(txs []ResolvedContractTransaction) error {
+	if err := p.ValidateContractTransactions(len(txs)); err != nil {
+		return err
+	}
+	for i, tx := range txs {
+		if err := p.ValidateCallResults(len(tx.CallResults)); err != nil {
+			return fmt.Errorf("txIndex=%v: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateAllowedCommitProtocols(i interface{}) error {
+	protocols, ok := i.([]CommitProtocol)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[CommitProtocol]bool)
+	for _, cp := range protocols {
+		if _, ok := CommitProtocol_name[int32(cp)]; !ok || cp == COMMIT_PROTOCOL_UNKNOWN {
+			return fmt.Errorf("invalid commit protocol: %v", cp)
+		} else if seen[cp] {
+			return fmt.Errorf("duplicate commit protocol: %v", cp)
+		}
+		seen[cp] = true
+	}
+	return nil
+}
+
+func validatePositiveUint32(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if v == 0 {
+		return fmt.Errorf("value must be positive: %v", v)
+	}
+	return nil
+}
+
+func validatePositiveUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	} else if v == 0 {
+		return fmt.Errorf("value must be positive: %v", v)
+	}
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
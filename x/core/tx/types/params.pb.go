@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cross/core/tx/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the cross modules.
+type Params struct {
+	// allowed_commit_protocols is a list of the commit protocols that can be used for a cross-chain tx.
+	AllowedCommitProtocols []CommitProtocol `protobuf:"varint,1,rep,packed,name=allowed_commit_protocols,json=allowedCommitProtocols,proto3,enum=cross.core.tx.CommitProtocol" json:"allowed_commit_protocols,omitempty" yaml:"allowed_commit_protocols"`
+	// max_contract_transactions is the maximum number of contract transactions per tx.
+	MaxContractTransactions uint32 `protobuf:"varint,2,opt,name=max_contract_transactions,json=maxContractTransactions,proto3" json:"max_contract_transactions,omitempty" yaml:"max_contract_transactions"`
+	// max_call_results is the maximum number of call results per contract transaction.
+	MaxCallResults uint32 `protobuf:"varint,3,opt,name=max_call_results,json=maxCallResults,proto3" json:"max_call_results,omitempty" yaml:"max_call_results"`
+	// cross_chain_calls_enabled enables the cross-chain calls between the contract transactions.
+	CrossChainCallsEnabled bool `protobuf:"varint,4,opt,name=cross_chain_calls_enabled,json=crossChainCallsEnabled,proto3" json:"cross_chain_calls_enabled,omitempty" yaml:"cross_chain_calls_enabled"`
+	// default_timeout_height_offset is the timeout height relative to the latest block height.
+	// The clients use it as the default value.
+	DefaultTimeoutHeightOffset uint64 `protobuf:"varint,5,opt,name=default_timeout_height_offset,json=defaultTimeoutHeightOffset,proto3" json:"default_timeout_height_offset,omitempty" yaml:"default_timeout_height_offset"`
+	// default_timeout_timestamp_offset is the timeout timestamp (in seconds) relative to the latest block time.
+	// The clients use it as the default value. The timeout is disabled when set to 0.
+	DefaultTimeoutTimestampOffset uint64 `protobuf:"varint,6,opt,name=default_timeout_timestamp_offset,json=defaultTimeoutTimestampOffset,proto3" json:"default_timeout_timestamp_offset,omitempty" yaml:"default_timeout_timestamp_offset"`
+	// three_phase_commit_timeout is the duration (in seconds) of each phase of the three-phase commit.
+	// A participant that doesn't hear from the coordinator within this duration terminates the tx by itself.
+	// A participant chain must not halt longer than this duration while it has any prepared txs, otherwise the txs may not be atomic.
+	ThreePhaseCommitTimeout uint64 `protobuf:"varint,7,opt,name=three_phase_commit_timeout,json=threePhaseCommitTimeout,proto3" json:"three_phase_commit_timeout,omitempty" yaml:"three_phase_commit_timeout"`
+	// ibc_sign_tx_record_retention is the duration (in seconds) for which a record of the sign sent to other chain is kept after its last update.
+	IbcSignTxRecordRetention uint64 `protobuf:"varint,8,opt,name=ibc_sign_tx_record_retention,json=ibcSignTxRecordRetention,proto3" json:"ibc_sign_tx_record_retention,omitempty" yaml:"ibc_sign_tx_record_retention"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbbe6d6cea342ee3, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cross.core.tx.Params")
+}
+
+func init() { proto.RegisterFile("cross/core/tx/params.proto", fileDescriptor_fbbe6d6cea342ee3) }
+
+var fileDescriptor_fbbe6d6cea342ee3 = []byte{
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6a, 0xdb, 0x40,
+	0x14, 0x86, 0xad, 0x26, 0x75, 0x83, 0x20, 0xa1, 0x88, 0x92, 0x28, 0x6e, 0x23, 0xa9, 0x4a, 0x4a,
+	0x0c, 0xa5, 0x12, 0xb4, 0xbb, 0x2e, 0x1d, 0x02, 0x85, 0x2e, 0x6a, 0xa6, 0x5e, 0x75, 0x33, 0x1d,
+	0x8d, 0xc7, 0x92, 0xe8, 0x48, 0x63, 0x66, 0x9e, 0xa9, 0x02, 0x3d, 0x44, 0x8f, 0xd4, 0x65, 0x96,
+	0x59, 0x76, 0x25, 0x5a, 0xfb, 0x06, 0x3a, 0x41, 0xd1, 0x8c, 0x0c, 0x76, 0xa8, 0xb3, 0xb2, 0xd1,
+	0xf7, 0xbf, 0xff, 0x13, 0xe2, 0x3d, 0x7b, 0x40, 0xa5, 0x50, 0x2a, 0xa6, 0x42, 0xb2, 0x18, 0xaa,
+	0x78, 0x4e, 0x24, 0x29, 0x54, 0x34, 0x97, 0x02, 0x84, 0x73, 0xa8, 0x59, 0xd4, 0xb2, 0x08, 0xaa,
+	0xc1, 0xb3, 0x54, 0xa4, 0x42, 0x93, 0xb8, 0xfd, 0x67, 0x42, 0x83, 0xd3, 0xed, 0x02, 0xb8, 0x99,
+	0xb3, 0x6e, 0x3e, 0xfc, 0xd5, 0xb7, 0xfb, 0x63, 0x5d, 0xe8, 0xfc, 0xb0, 0x5d, 0xc2, 0xb9, 0xf8,
+	0xce, 0xa6, 0x98, 0x8a, 0xa2, 0xc8, 0x01, 0xeb, 0x08, 0x15, 0x5c, 0xb9, 0x56, 0xb0, 0x37, 0x3c,
+	0x7a, 0x7b, 0x16, 0x6d, 0xd9, 0xa2, 0x2b, 0x1d, 0x1b, 0x77, 0xa9, 0xd1, 0x79, 0x53, 0xfb, 0xfe,
+	0x0d, 0x29, 0xf8, 0xfb, 0x70, 0x57, 0x51, 0x88, 0x8e, 0x3b, 0xb4, 0x3d, 0xab, 0x9c, 0xaf, 0xf6,
+	0x69, 0x41, 0x2a, 0x4c, 0x45, 0x09, 0x92, 0x50, 0xc0, 0x20, 0x49, 0xa9, 0x08, 0x85, 0x5c, 0x94,
+	0xca, 0x7d, 0x14, 0x58, 0xc3, 0xc3, 0xd1, 0x45, 0x53, 0xfb, 0x81, 0xe9, 0xdf, 0x19, 0x0d, 0xd1,
+	0x49, 0x41, 0xaa, 0xab, 0x0e, 0x4d, 0x36, 0x88, 0x73, 0x6d, 0x3f, 0xd5, 0x63, 0x84, 0x73, 0x2c,
+	0x99, 0x5a, 0x70, 0x50, 0xee, 0x9e, 0x2e, 0x7e, 0xde, 0xd4, 0xfe, 0xc9, 0x46, 0xf1, 0x46, 0x22,
+	0x44, 0x47, 0x6d, 0x1f, 0xe1, 0x1c, 0x99, 0x07, 0x0e, 0xb6, 0xcd, 0xe7, 0xc4, 0x34, 0x23, 0x79,
+	0xa9, 0xc3, 0x0a, 0xb3, 0x92, 0x24, 0x9c, 0x4d, 0xdd, 0xfd, 0xc0, 0x1a, 0x1e, 0x6c, 0xbe, 0xe8,
+	0xce, 0x68, 0x88, 0x8e, 0x35, 0xbb, 0x6a, 0x51, 0xdb, 0xaf, 0xae, 0x0d, 0x70, 0xbe, 0xd9, 0x67,
+	0x53, 0x36, 0x23, 0x0b, 0x0e, 0x18, 0xf2, 0x82, 0x89, 0x05, 0xe0, 0x8c, 0xe5, 0x69, 0x06, 0x58,
+	0xcc, 0x66, 0x8a, 0x81, 0xfb, 0x38, 0xb0, 0x86, 0xfb, 0xa3, 0x61, 0x53, 0xfb, 0x17, 0x46, 0xf2,
+	0x60, 0x3c, 0x44, 0x83, 0x8e, 0x4f, 0x0c, 0xfe, 0xa0, 0xe9, 0x27, 0x0d, 0x1d, 0xb0, 0x83, 0xfb,
+	0xd3, 0xed, 0xaf, 0x02, 0x52, 0xcc, 0xd7, 0xbe, 0xbe, 0xf6, 0xbd, 0x6e, 0x6a, 0xff, 0xf2, 0xff,
+	0xbe, 0xfb, 0x13, 0x21, 0x3a, 0xdb, 0x56, 0x4e, 0xd6, 0x81, 0xce, 0x9a, 0xd8, 0x03, 0xc8, 0x24,
+	0x63, 0x78, 0x9e, 0x11, 0xc5, 0xd6, 0x5b, 0xd2, 0xd5, 0xb9, 0x4f, 0xb4, 0xef, 0x55, 0x53, 0xfb,
+	0x2f, 0x8d, 0x6f, 0x77, 0x36, 0x44, 0x27, 0x1a, 0x8e, 0x5b, 0x66, 0x56, 0xaa, 0x53, 0x3a, 0xa9,
+	0xfd, 0x22, 0x4f, 0x28, 0x56, 0x79, 0x5a, 0x62, 0xa8, 0xb0, 0x64, 0x54, 0xc8, 0x29, 0x96, 0x0c,
+	0x58, 0xd9, 0xee, 0x83, 0x7b, 0xa0, 0x2d, 0x97, 0x4d, 0xed, 0x9f, 0x1b, 0xcb, 0x43, 0xe9, 0x10,
+	0xb9, 0x79, 0x42, 0x3f, 0xe7, 0x69, 0x39, 0xa9, 0x90, 0x66, 0x68, 0x8d, 0x46, 0x1f, 0x6f, 0xff,
+	0x7a, 0xbd, 0xdb, 0xa5, 0x67, 0xdd, 0x2d, 0x3d, 0xeb, 0xcf, 0xd2, 0xb3, 0x7e, 0xae, 0xbc, 0xde,
+	0xdd, 0xca, 0xeb, 0xfd, 0x5e, 0x79, 0xbd, 0x2f, 0x6f, 0xd2, 0x1c, 0xb2, 0x45, 0x12, 0x51, 0x51,
+	0xc4, 0x53, 0x02, 0x44, 0xaf, 0x02, 0x27, 0x49, 0x6c, 0x6e, 0xb2, 0xda, 0xbe, 0xca, 0xa4, 0xaf,
+	0x4f, 0xe5, 0xdd, 0xbf, 0x01, 0x00, 0xaf, 0x87, 0x9d, 0x41, 0xf4, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IbcSignTxRecordRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IbcSignTxRecordRetention))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ThreePhaseCommitTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ThreePhaseCommitTimeout))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DefaultTimeoutTimestampOffset != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultTimeoutTimestampOffset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DefaultTimeoutHeightOffset != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultTimeoutHeightOffset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CrossChainCallsEnabled {
+		i--
+		if m.CrossChainCallsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxCallResults != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCallResults))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxContractTransactions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxContractTransactions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedCommitProtocols) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedCommitProtocols)*10)
+		var j1 int
+		for _, num := range m.AllowedCommitProtocols {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedCommitProtocols) > 0 {
+		l = 0
+		for _, e := range m.AllowedCommitProtocols {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.MaxContractTransactions != 0 {
+		n += 1 + sovParams(uint64(m.MaxContractTransactions))
+	}
+	if m.MaxCallResults != 0 {
+		n += 1 + sovParams(uint64(m.MaxCallResults))
+	}
+	if m.CrossChainCallsEnabled {
+		n += 2
+	}
+	if m.DefaultTimeoutHeightOffset != 0 {
+		n += 1 + sovParams(uint64(m.DefaultTimeoutHeightOffset))
+	}
+	if m.DefaultTimeoutTimestampOffset != 0 {
+		n += 1 + sovParams(uint64(m.DefaultTimeoutTimestampOffset))
+	}
+	if m.ThreePhaseCommitTimeout != 0 {
+		n += 1 + sovParams(uint64(m.ThreePhaseCommitTimeout))
+	}
+	if m.IbcSignTxRecordRetention != 0 {
+		n += 1 + sovParams(uint64(m.IbcSignTxRecordRetention))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v CommitProtocol
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CommitProtocol(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedCommitProtocols = append(m.AllowedCommitProtocols, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedCommitProtocols) == 0 {
+					m.AllowedCommitProtocols = make([]CommitProtocol, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CommitProtocol
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CommitProtocol(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedCommitProtocols = append(m.AllowedCommitProtocols, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCommitProtocols", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractTransactions", wireType)
+			}
+			m.MaxContractTransactions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractTransactions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallResults", wireType)
+			}
+			m.MaxCallResults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallResults |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainCallsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CrossChainCallsEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTimeoutHeightOffset", wireType)
+			}
+			m.DefaultTimeoutHeightOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultTimeoutHeightOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTimeoutTimestampOffset", wireType)
+			}
+			m.DefaultTimeoutTimestampOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultTimeoutTimestampOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreePhaseCommitTimeout", wireType)
+			}
+			m.ThreePhaseCommitTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThreePhaseCommitTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSignTxRecordRetention", wireType)
+			}
+			m.IbcSignTxRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcSignTxRecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
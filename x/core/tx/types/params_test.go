@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams(t *testing.T) {
+	require := require.New(t)
+
+	params := DefaultParams()
+	require.NoError(params.Validate())
+	require.True(params.IsAllowedCommitProtocol(COMMIT_PROTOCOL_SIMPLE))
+	require.True(params.IsAllowedCommitProtocol(COMMIT_PROTOCOL_TPC))
+	require.True(params.IsAllowedCommitProtocol(COMMIT_PROTOCOL_3PC))
+	require.True(params.IsAllowedCommitProtocol(COMMIT_PROTOCOL_SAGA))
+	require.False(params.IsAllowedCommitProtocol(COMMIT_PROTOCOL_UNKNOWN))
+
+	require.NoError(params.ValidateContractTransactions(int(params.MaxContractTransactions)))
+	require.Error(params.ValidateContractTransactions(int(params.MaxContractTransactions) + 1))
+	require.NoError(params.ValidateCallResults(int(params.MaxCallResults)))
+	require.Error(params.ValidateCallResults(int(params.MaxCallResults) + 1))
+
+	params.CrossChainCallsEnabled = false
+	require.NoError(params.ValidateCallResults(0))
+	require.Error(params.ValidateCallResults(1))
+
+	var cases = []struct {
+		name     string
+		malleate func(params *Params)
+	}{
+		{"unknown commit protocol", func(params *Params) {
+			params.AllowedCommitProtocols = []CommitProtocol{COMMIT_PROTOCOL_UNKNOWN}
+		}},
+		{"undefined commit protocol", func(params *Params) {
+			params.AllowedCommitProtocols = []CommitProtocol{CommitProtocol(100)}
+		}},
+		{"duplicate commit protocols", func(params *Params) {
+			params.AllowedCommitProtocols = []CommitProtocol{COMMIT_PROTOCOL_SIMPLE, COMMIT_PROTOCOL_SIMPLE}
+		}},
+		{"zero max contract transactions", func(params *Params) {
+			params.MaxContractTransactions = 0
+		}},
+		{"zero max call results", func(params *Params) {
+			params.MaxCallResults = 0
+		}},
+		{"zero default timeout height offset", func(params *Params) {
+			params.DefaultTimeoutHeightOffset = 0
+		}},
+		{"zero three-phase commit timeout", func(params *Params) {
+			params.ThreePhaseCommitTimeout = 0
+		}},
+		{"zero IBCSignTx record retention", func(params *Params) {
+			params.IbcSignTxRecordRetention = 0
+		}},
+	}
+	for _, c := range cases {
+		params := DefaultParams()
+		c.malleate(&params)
+		require.Error(params.Validate(), c.name)
+	}
+}
@@ -182,6 +182,19 @@ func (tx ResolvedContractTransaction) ValidateBasic() error {
 	return nil
 }
 
+// CompensatingTransaction returns a transaction that calls the compensation of a given transaction with the same signers.
+// It returns false if the transaction doesn't need to be compensated.
+func (tx ResolvedContractTransaction) CompensatingTransaction() (ResolvedContractTransaction, bool) {
+	if len(tx.CompensationCallInfo) == 0 {
+		return ResolvedContractTransaction{}, false
+	}
+	return ResolvedContractTransaction{
+		CrossChainChannel: tx.CrossChainChannel,
+		Signers:           tx.Signers,
+		CallInfo:          tx.CompensationCallInfo,
+	}, true
+}
+
 func (tx ResolvedContractTransaction) UnpackCallResults(m codec.Codec) []CallResult {
 	results, err := UnpackCallResults(m, tx.CallResults)
 	if err != nil {
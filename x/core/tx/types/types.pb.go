@@ -34,18 +34,24 @@ const (
 	COMMIT_PROTOCOL_UNKNOWN CommitProtocol = 0
 	COMMIT_PROTOCOL_SIMPLE  CommitProtocol = 1
 	COMMIT_PROTOCOL_TPC     CommitProtocol = 2
+	COMMIT_PROTOCOL_3PC     CommitProtocol = 3
+	COMMIT_PROTOCOL_SAGA    CommitProtocol = 4
 )
 
 var CommitProtocol_name = map[int32]string{
 	0: "COMMIT_PROTOCOL_UNKNOWN",
 	1: "COMMIT_PROTOCOL_SIMPLE",
 	2: "COMMIT_PROTOCOL_TPC",
+	3: "COMMIT_PROTOCOL_3PC",
+	4: "COMMIT_PROTOCOL_SAGA",
 }
 
 var CommitProtocol_value = map[string]int32{
 	"COMMIT_PROTOCOL_UNKNOWN": 0,
 	"COMMIT_PROTOCOL_SIMPLE":  1,
 	"COMMIT_PROTOCOL_TPC":     2,
+	"COMMIT_PROTOCOL_3PC":     3,
+	"COMMIT_PROTOCOL_SAGA":    4,
 }
 
 func (x CommitProtocol) String() string {
@@ -60,10 +66,12 @@ type Tx struct {
 	Id                   github_com_datachainlab_cross_x_core_types.TxID `protobuf:"bytes,1,opt,name=id,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"id,omitempty"`
 	CommitProtocol       CommitProtocol                                  `protobuf:"varint,2,opt,name=commit_protocol,json=commitProtocol,proto3,enum=cross.core.tx.CommitProtocol" json:"commit_protocol,omitempty"`
 	ContractTransactions []ResolvedContractTransaction                   `protobuf:"bytes,3,rep,name=contract_transactions,json=contractTransactions,proto3" json:"contract_transactions"`
-	// Timeout height relative to the current block height.
+	// Timeout height of the initiator chain, which is also the coordinator chain.
+	// It is not applied to the packets sent to the participants since they are timed out with the height of the counterparty chain.
 	// The timeout is disabled when set to 0.
 	TimeoutHeight types.Height `protobuf:"bytes,4,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
-	// Timeout timestamp (in nanoseconds) relative to the current block timestamp.
+	// Timeout timestamp (in seconds since the Unix epoch).
+	// It is also applied to the packets sent to the participants after being converted to nanoseconds.
 	// The timeout is disabled when set to 0.
 	TimeoutTimestamp uint64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
 }
@@ -107,6 +115,9 @@ type ResolvedContractTransaction struct {
 	CallInfo          ContractCallInfo `protobuf:"bytes,3,opt,name=call_info,json=callInfo,proto3,casttype=ContractCallInfo" json:"call_info,omitempty"`
 	ReturnValue       *ReturnValue     `protobuf:"bytes,4,opt,name=return_value,json=returnValue,proto3" json:"return_value,omitempty"`
 	CallResults       []types1.Any     `protobuf:"bytes,5,rep,name=call_results,json=callResults,proto3" json:"call_results"`
+	// compensation_call_info is the call that undoes the effects of call_info.
+	// It is used only by the saga. An empty value indicates that the transaction can't be compensated, so it remains committed even if the saga is aborted.
+	CompensationCallInfo ContractCallInfo `protobuf:"bytes,6,opt,name=compensation_call_info,json=compensationCallInfo,proto3,casttype=ContractCallInfo" json:"compensation_call_info,omitempty"`
 }
 
 func (m *ResolvedContractTransaction) Reset()         { *m = ResolvedContractTransaction{} }
@@ -257,6 +268,48 @@ func (m *ContractCallResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractCallResult proto.InternalMessageInfo
 
+// IdentifiedContractCallResult defines a result of the prepared contract transaction with its txID and txIndex
+type IdentifiedContractCallResult struct {
+	TxId    github_com_datachainlab_cross_x_core_types.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxID" json:"tx_id,omitempty"`
+	TxIndex github_com_datachainlab_cross_x_core_types.TxIndex `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3,casttype=github.com/datachainlab/cross/x/core/types.TxIndex" json:"tx_index,omitempty"`
+	// contract_id is the ID of contract that handles the transaction. An empty ID indicates the default contract.
+	ContractId string             `protobuf:"bytes,3,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Result     ContractCallResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result"`
+}
+
+func (m *IdentifiedContractCallResult) Reset()         { *m = IdentifiedContractCallResult{} }
+func (m *IdentifiedContractCallResult) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedContractCallResult) ProtoMessage()    {}
+func (*IdentifiedContractCallResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24d7f910431c1db8, []int{5}
+}
+func (m *IdentifiedContractCallResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedContractCallResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedContractCallResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedContractCallResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedContractCallResult.Merge(m, src)
+}
+func (m *IdentifiedContractCallResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedContractCallResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedContractCallResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedContractCallResult proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cross.core.tx.CommitProtocol", CommitProtocol_name, CommitProtocol_value)
 	proto.RegisterType((*Tx)(nil), "cross.core.tx.Tx")
@@ -264,60 +317,69 @@ func init() {
 	proto.RegisterType((*ReturnValue)(nil), "cross.core.tx.ReturnValue")
 	proto.RegisterType((*ConstantValueCallResult)(nil), "cross.core.tx.ConstantValueCallResult")
 	proto.RegisterType((*ContractCallResult)(nil), "cross.core.tx.ContractCallResult")
+	proto.RegisterType((*IdentifiedContractCallResult)(nil), "cross.core.tx.IdentifiedContractCallResult")
 }
 
 func init() { proto.RegisterFile("cross/core/tx/types.proto", fileDescriptor_24d7f910431c1db8) }
 
 var fileDescriptor_24d7f910431c1db8 = []byte{
-	// 763 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0xdb, 0x38,
-	0x14, 0x96, 0x6c, 0x27, 0x77, 0x47, 0x3b, 0x3e, 0x87, 0x71, 0x12, 0xc5, 0xb9, 0xc8, 0x86, 0x6f,
-	0x31, 0x02, 0x9c, 0x84, 0x38, 0x37, 0x1c, 0x02, 0xdc, 0x10, 0xeb, 0x72, 0x38, 0x5f, 0x7e, 0xd8,
-	0x50, 0xdd, 0x16, 0xe8, 0x50, 0x95, 0xa6, 0x19, 0x5b, 0x88, 0x4c, 0x06, 0x12, 0x65, 0xd8, 0x63,
-	0xb7, 0x8e, 0xfd, 0x13, 0x0a, 0x74, 0xec, 0x3f, 0x92, 0x31, 0x63, 0xd1, 0xc1, 0x68, 0x93, 0xa5,
-	0x73, 0xc6, 0x4c, 0x85, 0x28, 0x3a, 0xb1, 0xdd, 0x1f, 0x28, 0xba, 0x48, 0x7c, 0x7c, 0xdf, 0xf7,
-	0xf1, 0xf1, 0x7b, 0x24, 0xc1, 0x06, 0xf6, 0x59, 0x10, 0x98, 0x98, 0xf9, 0xc4, 0xe4, 0x43, 0x93,
-	0x8f, 0xce, 0x49, 0x60, 0x9c, 0xfb, 0x8c, 0x33, 0xb8, 0x24, 0x52, 0x46, 0x94, 0x32, 0xf8, 0xb0,
-	0x90, 0xef, 0xb2, 0x2e, 0x13, 0x19, 0x33, 0x1a, 0xc5, 0xa0, 0xc2, 0x46, 0x97, 0xb1, 0xae, 0x47,
-	0x4c, 0x11, 0xb5, 0xc3, 0x53, 0x13, 0xd1, 0x91, 0x4c, 0x6d, 0x72, 0x42, 0x3b, 0xc4, 0xef, 0xbb,
-	0x94, 0x9b, 0xa8, 0x8d, 0xdd, 0x69, 0xf1, 0x42, 0xd1, 0x6d, 0xe3, 0x78, 0x55, 0xec, 0xb9, 0x84,
-	0x72, 0x73, 0xb0, 0x23, 0x47, 0x13, 0xf6, 0x54, 0x61, 0x28, 0xe4, 0xbd, 0x69, 0x76, 0xf9, 0x4d,
-	0x12, 0x24, 0x5a, 0x43, 0x68, 0x81, 0x84, 0xdb, 0xd1, 0xd4, 0x92, 0x5a, 0xc9, 0xd4, 0x76, 0x6f,
-	0xc7, 0x45, 0xb3, 0xeb, 0xf2, 0x5e, 0xd8, 0x36, 0x30, 0xeb, 0x9b, 0x1d, 0xc4, 0x11, 0xee, 0x21,
-	0x97, 0x7a, 0xa8, 0x6d, 0xc6, 0x5a, 0x43, 0xb9, 0x4d, 0x21, 0xd4, 0x1a, 0xd6, 0xff, 0xb1, 0x13,
-	0x6e, 0x07, 0xfe, 0x0b, 0x7e, 0xc5, 0xac, 0xdf, 0x77, 0xb9, 0x23, 0xb4, 0x31, 0xf3, 0xb4, 0x44,
-	0x49, 0xad, 0x64, 0xab, 0x5b, 0xc6, 0x8c, 0x01, 0x86, 0x25, 0x50, 0x4d, 0x09, 0xb2, 0xb3, 0x78,
-	0x26, 0x86, 0x04, 0xac, 0x62, 0x46, 0xb9, 0x8f, 0x30, 0x77, 0xb8, 0x8f, 0x68, 0x80, 0x30, 0x77,
-	0x19, 0x0d, 0xb4, 0x64, 0x29, 0x59, 0x49, 0x57, 0xb7, 0xe7, 0xd4, 0x6c, 0x12, 0x30, 0x6f, 0x40,
-	0x3a, 0x96, 0xe4, 0xb4, 0xee, 0x29, 0xb5, 0xd4, 0xc5, 0xb8, 0xa8, 0xd8, 0x79, 0xfc, 0x79, 0x2a,
-	0x80, 0xcf, 0x40, 0x96, 0xbb, 0x7d, 0xc2, 0x42, 0xee, 0xf4, 0x88, 0xdb, 0xed, 0x71, 0x2d, 0x55,
-	0x52, 0x2b, 0xe9, 0x6a, 0xc1, 0x70, 0xdb, 0x38, 0x56, 0x97, 0x3e, 0x0e, 0x76, 0x8c, 0xff, 0x04,
-	0xa2, 0xb6, 0x15, 0xe9, 0xdd, 0x8c, 0x8b, 0xab, 0x23, 0xd4, 0xf7, 0xf6, 0xca, 0xb3, 0xfc, 0xb2,
-	0xbd, 0x24, 0x27, 0x62, 0x34, 0xac, 0x83, 0xe5, 0x09, 0x22, 0xfa, 0x07, 0x1c, 0xf5, 0xcf, 0xb5,
-	0x85, 0x92, 0x5a, 0x49, 0xd5, 0x7e, 0xbb, 0x19, 0x17, 0xb5, 0x59, 0x91, 0x3b, 0x48, 0xd9, 0xce,
-	0xc9, 0xb9, 0xd6, 0x64, 0x6a, 0x2f, 0xf5, 0xf1, 0x55, 0x51, 0x29, 0xbf, 0x4b, 0x80, 0xcd, 0x6f,
-	0x6c, 0x17, 0xfe, 0x0f, 0x56, 0x84, 0x37, 0x8e, 0xe8, 0x57, 0xf4, 0xa5, 0x94, 0x78, 0xa2, 0xaf,
-	0xe9, 0x6a, 0xde, 0x88, 0x4f, 0x98, 0x31, 0x39, 0x61, 0xc6, 0x3e, 0x1d, 0x09, 0x87, 0x54, 0x7b,
-	0x59, 0xd0, 0xac, 0x88, 0x65, 0xc5, 0x24, 0xf8, 0x17, 0xf8, 0x29, 0x70, 0xbb, 0x94, 0xf8, 0x81,
-	0x96, 0x10, 0xbe, 0x6b, 0xd3, 0xbe, 0x47, 0x07, 0xc9, 0xd8, 0xc7, 0x98, 0x85, 0x94, 0x4b, 0x97,
-	0x27, 0x70, 0xb8, 0x03, 0x7e, 0xc1, 0xc8, 0xf3, 0x1c, 0x97, 0x9e, 0x32, 0x2d, 0x29, 0xce, 0x54,
-	0xfe, 0x76, 0x5c, 0xcc, 0x4d, 0x2a, 0xb6, 0x90, 0xe7, 0xd5, 0xe9, 0x29, 0xb3, 0x7f, 0xc6, 0x72,
-	0x04, 0xff, 0x06, 0x19, 0x9f, 0xf0, 0xd0, 0xa7, 0xce, 0x00, 0x79, 0x21, 0xb9, 0xeb, 0xc4, 0x7c,
-	0xa7, 0x23, 0xc8, 0xa3, 0x08, 0x61, 0xa7, 0xfd, 0xfb, 0x20, 0xa2, 0x8b, 0x15, 0x7d, 0x12, 0x84,
-	0x1e, 0x0f, 0xb4, 0x05, 0x51, 0xf0, 0xd7, 0x37, 0xac, 0xd8, 0xe9, 0x08, 0x6f, 0xc7, 0x70, 0x69,
-	0xee, 0xef, 0x20, 0x3d, 0xb5, 0x00, 0xcc, 0x83, 0x85, 0xb8, 0x16, 0x71, 0x2b, 0xec, 0x38, 0x28,
-	0x3f, 0x57, 0xc1, 0xba, 0xc5, 0x68, 0xc0, 0x11, 0xe5, 0x02, 0x67, 0xdd, 0xe9, 0xfc, 0xa8, 0xfb,
-	0xca, 0x97, 0xdc, 0xcf, 0x00, 0xf5, 0x4c, 0xdc, 0x9e, 0x8c, 0xad, 0x9e, 0x45, 0xd1, 0x20, 0x76,
-	0xd2, 0x56, 0x07, 0xe5, 0xa7, 0x00, 0x4e, 0x5b, 0x29, 0x57, 0x87, 0x20, 0x15, 0xdd, 0x54, 0x59,
-	0xae, 0x18, 0xc3, 0x3f, 0xc1, 0x22, 0x19, 0x10, 0xca, 0x27, 0x2d, 0x5c, 0x33, 0xee, 0x5f, 0x12,
-	0x23, 0x7a, 0x49, 0x8c, 0x83, 0x28, 0x2d, 0xcb, 0x90, 0xd8, 0xed, 0x1e, 0xc8, 0xce, 0xde, 0x50,
-	0xb8, 0x09, 0xd6, 0xad, 0xc6, 0xf1, 0x71, 0xbd, 0xe5, 0x34, 0xed, 0x46, 0xab, 0x61, 0x35, 0x8e,
-	0x9c, 0x87, 0x27, 0x87, 0x27, 0x8d, 0xc7, 0x27, 0x39, 0x05, 0x16, 0xc0, 0xda, 0x7c, 0xf2, 0x41,
-	0xfd, 0xb8, 0x79, 0x74, 0x90, 0x53, 0xe1, 0x3a, 0x58, 0x99, 0xcf, 0xb5, 0x9a, 0x56, 0x2e, 0x51,
-	0x48, 0xbd, 0x78, 0xad, 0x2b, 0xb5, 0xc3, 0x8b, 0x0f, 0xba, 0x72, 0x71, 0xa5, 0xab, 0x97, 0x57,
-	0xba, 0xfa, 0xfe, 0x4a, 0x57, 0x5f, 0x5e, 0xeb, 0xca, 0xe5, 0xb5, 0xae, 0xbc, 0xbd, 0xd6, 0x95,
-	0x27, 0x7f, 0x7c, 0xdf, 0x23, 0x24, 0xdf, 0xda, 0xf6, 0xa2, 0x70, 0x76, 0xf7, 0x53, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x79, 0x0e, 0x5c, 0xdc, 0x89, 0x05, 0x00, 0x00,
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xde, 0xb5, 0x9d, 0xb4, 0x1d, 0x27, 0xc1, 0x9d, 0xba, 0xc9, 0xd6, 0x69, 0xbd, 0xc6, 0x5c,
+	0xac, 0x4a, 0xec, 0x2a, 0x0e, 0x42, 0xa8, 0x12, 0x42, 0xf1, 0x52, 0xa8, 0xdb, 0x26, 0x36, 0x8b,
+	0x01, 0x89, 0x03, 0xcb, 0x78, 0x76, 0x62, 0x8f, 0xba, 0x9e, 0x89, 0x76, 0xc7, 0x96, 0x73, 0xe4,
+	0xc6, 0x91, 0x3b, 0x42, 0x42, 0x42, 0xe2, 0xc2, 0x3f, 0x92, 0x63, 0x8f, 0x9c, 0x2c, 0x48, 0x2e,
+	0x9c, 0x7b, 0xcc, 0x09, 0xcd, 0xec, 0x6c, 0x62, 0x3b, 0x51, 0xc4, 0x8f, 0x8b, 0x3d, 0x33, 0xef,
+	0xfb, 0xde, 0xbc, 0xf7, 0xbd, 0x37, 0x6f, 0xc1, 0x03, 0x1c, 0xf3, 0x24, 0x71, 0x31, 0x8f, 0x89,
+	0x2b, 0xa6, 0xae, 0x38, 0x3e, 0x22, 0x89, 0x73, 0x14, 0x73, 0xc1, 0xe1, 0xba, 0x32, 0x39, 0xd2,
+	0xe4, 0x88, 0x69, 0xa5, 0x3c, 0xe0, 0x03, 0xae, 0x2c, 0xae, 0x5c, 0xa5, 0xa0, 0xca, 0x83, 0x01,
+	0xe7, 0x83, 0x88, 0xb8, 0x6a, 0xd7, 0x1f, 0x1f, 0xba, 0x88, 0x1d, 0x6b, 0xd3, 0xb6, 0x20, 0x2c,
+	0x24, 0xf1, 0x88, 0x32, 0xe1, 0xa2, 0x3e, 0xa6, 0xf3, 0xce, 0x2b, 0x36, 0xed, 0xe3, 0xf4, 0x56,
+	0x1c, 0x51, 0xc2, 0x84, 0x3b, 0xd9, 0xd1, 0xab, 0x8c, 0x3d, 0x17, 0x18, 0x1a, 0x8b, 0xe1, 0x3c,
+	0xbb, 0xfe, 0x5b, 0x1e, 0xe4, 0x7a, 0x53, 0xe8, 0x81, 0x1c, 0x0d, 0x2d, 0xb3, 0x66, 0x36, 0xd6,
+	0x5a, 0xbb, 0xe7, 0x33, 0xdb, 0x1d, 0x50, 0x31, 0x1c, 0xf7, 0x1d, 0xcc, 0x47, 0x6e, 0x88, 0x04,
+	0xc2, 0x43, 0x44, 0x59, 0x84, 0xfa, 0x6e, 0xea, 0x6b, 0xaa, 0xd3, 0x54, 0x8e, 0x7a, 0xd3, 0xf6,
+	0xc7, 0x7e, 0x8e, 0x86, 0xf0, 0x13, 0xf0, 0x16, 0xe6, 0xa3, 0x11, 0x15, 0x81, 0xf2, 0x8d, 0x79,
+	0x64, 0xe5, 0x6a, 0x66, 0x63, 0xa3, 0xf9, 0xc8, 0x59, 0x10, 0xc0, 0xf1, 0x14, 0xaa, 0xab, 0x41,
+	0xfe, 0x06, 0x5e, 0xd8, 0x43, 0x02, 0xee, 0x63, 0xce, 0x44, 0x8c, 0xb0, 0x08, 0x44, 0x8c, 0x58,
+	0x82, 0xb0, 0xa0, 0x9c, 0x25, 0x56, 0xbe, 0x96, 0x6f, 0x14, 0x9b, 0x8f, 0x97, 0xbc, 0xf9, 0x24,
+	0xe1, 0xd1, 0x84, 0x84, 0x9e, 0xe6, 0xf4, 0x2e, 0x29, 0xad, 0xc2, 0xc9, 0xcc, 0x36, 0xfc, 0x32,
+	0xbe, 0x6a, 0x4a, 0xe0, 0xb7, 0x60, 0x43, 0xd0, 0x11, 0xe1, 0x63, 0x11, 0x0c, 0x09, 0x1d, 0x0c,
+	0x85, 0x55, 0xa8, 0x99, 0x8d, 0x62, 0xb3, 0xe2, 0xd0, 0x3e, 0x4e, 0xbd, 0x6b, 0x1d, 0x27, 0x3b,
+	0xce, 0x33, 0x85, 0x68, 0x3d, 0x92, 0xfe, 0xde, 0xcc, 0xec, 0xfb, 0xc7, 0x68, 0x14, 0x3d, 0xa9,
+	0x2f, 0xf2, 0xeb, 0xfe, 0xba, 0x3e, 0x48, 0xd1, 0xb0, 0x0d, 0xee, 0x66, 0x08, 0xf9, 0x9f, 0x08,
+	0x34, 0x3a, 0xb2, 0x56, 0x6a, 0x66, 0xa3, 0xd0, 0x7a, 0xf8, 0x66, 0x66, 0x5b, 0x8b, 0x4e, 0x2e,
+	0x20, 0x75, 0xbf, 0xa4, 0xcf, 0x7a, 0xd9, 0xd1, 0x93, 0xc2, 0x5f, 0x3f, 0xdb, 0x46, 0xfd, 0xd7,
+	0x3c, 0xd8, 0xbe, 0x21, 0x5d, 0xf8, 0x1c, 0xdc, 0x53, 0xda, 0x04, 0xaa, 0x5e, 0xf2, 0x97, 0x31,
+	0x12, 0xa9, 0xba, 0x16, 0x9b, 0x65, 0x27, 0xed, 0x30, 0x27, 0xeb, 0x30, 0x67, 0x8f, 0x1d, 0x2b,
+	0x85, 0x4c, 0xff, 0xae, 0xa2, 0x79, 0x92, 0xe5, 0xa5, 0x24, 0xf8, 0x01, 0xb8, 0x95, 0xd0, 0x01,
+	0x23, 0x71, 0x62, 0xe5, 0x94, 0xee, 0xd6, 0xbc, 0xee, 0xb2, 0x91, 0x9c, 0x3d, 0x8c, 0xf9, 0x98,
+	0x09, 0xad, 0x72, 0x06, 0x87, 0x3b, 0xe0, 0x0e, 0x46, 0x51, 0x14, 0x50, 0x76, 0xc8, 0xad, 0xbc,
+	0xea, 0xa9, 0xf2, 0xf9, 0xcc, 0x2e, 0x65, 0x11, 0x7b, 0x28, 0x8a, 0xda, 0xec, 0x90, 0xfb, 0xb7,
+	0xb1, 0x5e, 0xc1, 0x0f, 0xc1, 0x5a, 0x4c, 0xc4, 0x38, 0x66, 0xc1, 0x04, 0x45, 0x63, 0x72, 0x51,
+	0x89, 0xe5, 0x4a, 0x4b, 0xc8, 0x97, 0x12, 0xe1, 0x17, 0xe3, 0xcb, 0x8d, 0xa4, 0xab, 0x1b, 0x63,
+	0x92, 0x8c, 0x23, 0x91, 0x58, 0x2b, 0xb5, 0xfc, 0x8d, 0x09, 0x1b, 0x7e, 0x51, 0xe2, 0xfd, 0x14,
+	0x0e, 0x9f, 0x83, 0x4d, 0xcc, 0x47, 0x47, 0x84, 0x25, 0x48, 0xca, 0x18, 0x5c, 0x46, 0xbf, 0x7a,
+	0x43, 0xf4, 0xe5, 0x79, 0x4e, 0x76, 0xaa, 0x0b, 0xf5, 0x0e, 0x28, 0xce, 0x05, 0x0b, 0xcb, 0x60,
+	0x25, 0xcd, 0x4b, 0xbd, 0x30, 0x3f, 0xdd, 0xd4, 0xbf, 0x33, 0xc1, 0x96, 0xc7, 0x59, 0x22, 0x10,
+	0x13, 0x0a, 0xe7, 0x5d, 0xc4, 0xf4, 0x5f, 0x2b, 0x69, 0x5c, 0x57, 0xc9, 0x35, 0x60, 0xbe, 0x52,
+	0x2f, 0x71, 0xcd, 0x37, 0x5f, 0xc9, 0xdd, 0x24, 0xad, 0x8a, 0x6f, 0x4e, 0xea, 0xdf, 0x00, 0x38,
+	0x9f, 0x98, 0xbe, 0x1d, 0x82, 0x82, 0x7c, 0xf5, 0x3a, 0x5c, 0xb5, 0x86, 0xef, 0x81, 0x55, 0x32,
+	0x21, 0x4c, 0x64, 0xed, 0xb0, 0xe9, 0x5c, 0x4e, 0x25, 0x47, 0x4e, 0x25, 0xe7, 0xa9, 0x34, 0xeb,
+	0x30, 0x34, 0xb6, 0xfe, 0x53, 0x0e, 0x3c, 0x6c, 0x87, 0x84, 0x09, 0x7a, 0x48, 0x49, 0x78, 0xcd,
+	0x55, 0xcf, 0xc0, 0x8a, 0x98, 0x06, 0xff, 0x6f, 0xf8, 0x14, 0xc4, 0xb4, 0x1d, 0xc2, 0xcf, 0xc0,
+	0x6d, 0xe9, 0x89, 0x85, 0x64, 0xaa, 0xb2, 0x5d, 0x6f, 0xbd, 0x7f, 0x3e, 0xb3, 0x9b, 0xff, 0xce,
+	0x99, 0x64, 0xfb, 0xb7, 0x44, 0xba, 0x80, 0x36, 0x28, 0x5e, 0x4c, 0x22, 0x1a, 0x2a, 0xd5, 0xee,
+	0xf8, 0x20, 0x3b, 0x6a, 0x87, 0xf0, 0x23, 0xb0, 0x9a, 0xf6, 0x9c, 0xee, 0xd8, 0xb7, 0xaf, 0x4c,
+	0xba, 0xe5, 0x84, 0x33, 0x7d, 0x52, 0xda, 0xe3, 0x1f, 0x4d, 0xb0, 0xb1, 0x38, 0x0e, 0xe1, 0x36,
+	0xd8, 0xf2, 0x3a, 0xfb, 0xfb, 0xed, 0x5e, 0xd0, 0xf5, 0x3b, 0xbd, 0x8e, 0xd7, 0x79, 0x19, 0x7c,
+	0x71, 0xf0, 0xe2, 0xa0, 0xf3, 0xd5, 0x41, 0xc9, 0x80, 0x15, 0xb0, 0xb9, 0x6c, 0xfc, 0xbc, 0xbd,
+	0xdf, 0x7d, 0xf9, 0xb4, 0x64, 0xc2, 0x2d, 0x70, 0x6f, 0xd9, 0xd6, 0xeb, 0x7a, 0xa5, 0xdc, 0x75,
+	0x86, 0xdd, 0xae, 0x57, 0xca, 0x43, 0x0b, 0x94, 0xaf, 0x78, 0xdb, 0xfb, 0x74, 0xaf, 0x54, 0xa8,
+	0x14, 0xbe, 0xff, 0xa5, 0x6a, 0xb4, 0x5e, 0x9c, 0xfc, 0x59, 0x35, 0x4e, 0x4e, 0xab, 0xe6, 0xeb,
+	0xd3, 0xaa, 0xf9, 0xc7, 0x69, 0xd5, 0xfc, 0xe1, 0xac, 0x6a, 0xbc, 0x3e, 0xab, 0x1a, 0xbf, 0x9f,
+	0x55, 0x8d, 0xaf, 0xdf, 0xfd, 0x67, 0xd2, 0xea, 0x6f, 0x61, 0x7f, 0x55, 0x75, 0xeb, 0xee, 0xdf,
+	0x03, 0x00, 0x55, 0xb4, 0x6a, 0xe8, 0x29, 0x07, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -404,6 +466,13 @@ func (m *ResolvedContractTransaction) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.CompensationCallInfo) > 0 {
+		i -= len(m.CompensationCallInfo)
+		copy(dAtA[i:], m.CompensationCallInfo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CompensationCallInfo)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.CallResults) > 0 {
 		for iNdEx := len(m.CallResults) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -587,6 +656,58 @@ func (m *ContractCallResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IdentifiedContractCallResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedContractCallResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedContractCallResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -655,6 +776,10 @@ func (m *ResolvedContractTransaction) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = len(m.CompensationCallInfo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -709,6 +834,28 @@ func (m *ContractCallResult) Size() (n int) {
 	return n
 }
 
+func (m *IdentifiedContractCallResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1110,6 +1257,40 @@ func (m *ResolvedContractTransaction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationCallInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompensationCallInfo = append(m.CompensationCallInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompensationCallInfo == nil {
+				m.CompensationCallInfo = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1496,6 +1677,177 @@ func (m *ContractCallResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IdentifiedContractCallResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedContractCallResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedContractCallResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = append(m.TxId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxId == nil {
+				m.TxId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= github_com_datachainlab_cross_x_core_types.TxIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -36,6 +36,7 @@ var (
 	AtomicKeyPrefix        = []byte("atomic")
 	ContractManagerPrefix  = []byte("cmanager")
 	ContractStoreKeyPrefix = []byte("cstore")
+	XCCKeyPrefix           = []byte("xcc")
 )
 
 type PrefixStoreKey struct {
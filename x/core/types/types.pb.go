@@ -23,42 +23,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-type GenesisState struct {
-}
-
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_251336a8138de504, []int{0}
-}
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
-}
-func (m *GenesisState) XXX_Size() int {
-	return m.Size()
-}
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
-
 type Acknowledgement struct {
 	IsSuccess bool   `protobuf:"varint,1,opt,name=is_success,json=isSuccess,proto3" json:"is_success,omitempty"`
 	Result    []byte `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
@@ -68,7 +32,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_251336a8138de504, []int{1}
+	return fileDescriptor_251336a8138de504, []int{0}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -98,19 +62,17 @@ func (m *Acknowledgement) XXX_DiscardUnknown() {
 var xxx_messageInfo_Acknowledgement proto.InternalMessageInfo
 
 func init() {
-	proto.RegisterType((*GenesisState)(nil), "cross.core.types.GenesisState")
 	proto.RegisterType((*Acknowledgement)(nil), "cross.core.types.Acknowledgement")
 }
 
 func init() { proto.RegisterFile("cross/core/types/types.proto", fileDescriptor_251336a8138de504) }
 
 var fileDescriptor_251336a8138de504 = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
+	// 202 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2e, 0xca, 0x2f,
 	0x2e, 0xd6, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x86, 0x90, 0x7a, 0x05,
 	0x45, 0xf9, 0x25, 0xf9, 0x42, 0x02, 0x60, 0x59, 0x3d, 0x90, 0xac, 0x1e, 0x58, 0x5c, 0x4a, 0x24,
-	0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xa9, 0x0f, 0x62, 0x41, 0xd4, 0x29, 0xf1, 0x71, 0xf1, 0xb8, 0xa7,
-	0xe6, 0xa5, 0x16, 0x67, 0x16, 0x07, 0x97, 0x24, 0x96, 0xa4, 0x2a, 0x79, 0x70, 0xf1, 0x3b, 0x26,
+	0x3d, 0x3f, 0x3d, 0x1f, 0x2c, 0xa9, 0x0f, 0x62, 0x41, 0xd4, 0x29, 0x79, 0x70, 0xf1, 0x3b, 0x26,
 	0x67, 0xe7, 0xe5, 0x97, 0xe7, 0xa4, 0xa6, 0xa4, 0xa7, 0xe6, 0xa6, 0xe6, 0x95, 0x08, 0xc9, 0x72,
 	0x71, 0x65, 0x16, 0xc7, 0x17, 0x97, 0x26, 0x27, 0xa7, 0x16, 0x17, 0x4b, 0x30, 0x2a, 0x30, 0x6a,
 	0x70, 0x04, 0x71, 0x66, 0x16, 0x07, 0x43, 0x04, 0x84, 0xc4, 0xb8, 0xd8, 0x8a, 0x52, 0x8b, 0x4b,
@@ -118,31 +80,8 @@ var fileDescriptor_251336a8138de504 = []byte{
 	0x18, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
 	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2b, 0x3d, 0xb3, 0x24,
 	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x25, 0xb1, 0x24, 0x31, 0x39, 0x23, 0x31, 0x33,
-	0x2f, 0x27, 0x31, 0x49, 0x1f, 0xe2, 0xab, 0x0a, 0x24, 0x7f, 0x25, 0xb1, 0x81, 0x9d, 0x6a, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x79, 0x59, 0x04, 0xf2, 0x00, 0x00, 0x00,
-}
-
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	0x2f, 0x27, 0x31, 0x49, 0x1f, 0xe2, 0x8b, 0x0a, 0x24, 0x7f, 0x24, 0xb1, 0x81, 0x9d, 0x66, 0x0c,
+	0x18, 0x00, 0x58, 0xc7, 0x90, 0xcd, 0xe2, 0x00, 0x00, 0x00,
 }
 
 func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
@@ -196,15 +135,6 @@ func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
 func (m *Acknowledgement) Size() (n int) {
 	if m == nil {
 		return 0
@@ -227,59 +157,6 @@ func sovTypes(x uint64) (n int) {
 func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	"github.com/datachainlab/cross/x/core/xcc/types"
+)
+
+// Keeper is a resolver that supports ChainInfo in addition to ChannelInfo and ChannelPath.
+// It keeps a registry that maps the chain IDs of counterparty chains to the preferred cross channels.
+type Keeper struct {
+	types.ChannelPathResolver
+
+	m        codec.Codec
+	storeKey sdk.StoreKey
+
+	channelKeeper    types.ChannelKeeper
+	connectionKeeper types.ConnectionKeeper
+	clientKeeper     types.ClientKeeper
+}
+
+var (
+	_ types.XCCResolver          = (*Keeper)(nil)
+	_ types.ChainChannelRegistry = (*Keeper)(nil)
+)
+
+// NewKeeper creates a new instance of Keeper
+func NewKeeper(
+	m codec.Codec,
+	storeKey sdk.StoreKey,
+	channelKeeper types.ChannelKeeper,
+	connectionKeeper types.ConnectionKeeper,
+	clientKeeper types.ClientKeeper,
+) Keeper {
+	return Keeper{
+		ChannelPathResolver: types.NewChannelPathResolver(channelKeeper),
+
+		m:        m,
+		storeKey: storeKey,
+
+		channelKeeper:    channelKeeper,
+		connectionKeeper: connectionKeeper,
+		clientKeeper:     clientKeeper,
+	}
+}
+
+// ResolveCrossChainChannel implements CrossChainChannelResolver.ResolveCrossChainChannel
+// ChainInfo is resolved into the channel registered for the chain.
+func (k Keeper) ResolveCrossChainChannel(ctx sdk.Context, xcc types.XCC) (*types.ChannelInfo, error) {
+	ci, ok := xcc.(*types.ChainInfo)
+	if !ok {
+		return k.ChannelPathResolver.ResolveCrossChainChannel(ctx, xcc)
+	}
+	if ci.ChainId == ctx.ChainID() {
+		return &types.ChannelInfo{}, nil
+	}
+	channel, found := k.GetChainChannel(ctx, ci.ChainId)
+	if !found {
+		return nil, fmt.Errorf("no channel is registered for the chain '%v'", ci.ChainId)
+	}
+	return channel, nil
+}
+
+// ConvertCrossChainChannel returns a xcc of callee in caller's context
+// If either of them is ChainInfo, the callee is converted into ChainInfo unless the callee is the caller itself.
+func (k Keeper) ConvertCrossChainChannel(ctx sdk.Context, calleeXCC types.XCC, callerXCC types.XCC) (types.XCC, error) {
+	_, isChainCallee := calleeXCC.(*types.ChainInfo)
+	_, isChainCaller := callerXCC.(*types.ChainInfo)
+	if !isChainCallee && !isChainCaller {
+		return k.ChannelPathResolver.ConvertCrossChainChannel(ctx, calleeXCC, callerXCC)
+	}
+	calleeChainID, err := k.getChainID(ctx, calleeXCC)
+	if err != nil {
+		return nil, err
+	}
+	callerChainID, err := k.getChainID(ctx, callerXCC)
+	if err != nil {
+		return nil, err
+	}
+	if calleeChainID == callerChainID {
+		return k.GetSelfCrossChainChannel(ctx), nil
+	}
+	return types.NewChainInfo(calleeChainID), nil
+}
+
+// IsSelfCrossChainChannel implements CrossChainChannelResolver.IsSelfCrossChainChannel
+func (k Keeper) IsSelfCrossChainChannel(ctx sdk.Context, xcc types.XCC) bool {
+	if ci, ok := xcc.(*types.ChainInfo); ok {
+		return ci.ChainId == ctx.ChainID()
+	}
+	return k.ChannelPathResolver.IsSelfCrossChainChannel(ctx, xcc)
+}
+
+// GetCounterpartyChainID returns the chain ID of the counterparty chain of a given channel
+// The chain ID is derived from the light client state of the channel's connection.
+func (k Keeper) GetCounterpartyChainID(ctx sdk.Context, ci types.ChannelInfo) (string, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, ci.Port, ci.Channel)
+	if !found {
+		return "", fmt.Errorf("channel '%v' not found", ci.String())
+	} else if len(channel.ConnectionHops) == 0 {
+		return "", fmt.Errorf("channel '%v' has no connection hops", ci.String())
+	}
+	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return "", fmt.Errorf("connection '%v' not found", channel.ConnectionHops[0])
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.GetClientID())
+	if !found {
+		return "", fmt.Errorf("client '%v' not found", connection.GetClientID())
+	}
+	cs, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return "", fmt.Errorf("client '%v' doesn't have a chain ID: type=%T", connection.GetClientID(), clientState)
+	}
+	return cs.GetChainID(), nil
+}
+
+// RegisterChannel implements ChainChannelRegistry.RegisterChannel
+// The channel must be open and its counterparty must be the chain. If a channel is already registered for the chain, it is replaced.
+func (k Keeper) RegisterChannel(ctx sdk.Context, chainID string, ci types.ChannelInfo) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, ci.Port, ci.Channel)
+	if !found {
+		return fmt.Errorf("channel '%v' not found", ci.String())
+	} else if channel.State != channeltypes.OPEN {
+		return fmt.Errorf("channel '%v' must be open: state=%v", ci.String(), channel.State)
+	}
+	counterpartyChainID, err := k.GetCounterpartyChainID(ctx, ci)
+	if err != nil {
+		return err
+	} else if counterpartyChainID != chainID {
+		return fmt.Errorf("the counterparty chain of channel '%v' is '%v', but got '%v'", ci.String(), counterpartyChainID, chainID)
+	}
+	return k.SetChainChannel(ctx, types.NewChainChannel(chainID, ci))
+}
+
+// SetChainChannel stores a given entry of the registry
+// If the entry for the chain already exists, it is overwritten.
+func (k Keeper) SetChainChannel(ctx sdk.Context, cc types.ChainChannel) error {
+	if err := cc.ValidateBasic(); err != nil {
+		return err
+	}
+	k.store(ctx).Set(types.KeyChainChannel(cc.ChainId), k.m.MustMarshal(&cc))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterChainChannel,
+			sdk.NewAttribute(types.AttributeKeyChainID, cc.ChainId),
+			sdk.NewAttribute(types.AttributeKeyPort, cc.Channel.Port),
+			sdk.NewAttribute(types.AttributeKeyChannel, cc.Channel.Channel),
+		),
+	)
+	return nil
+}
+
+// GetChainChannel implements ChainChannelRegistry.GetChainChannel
+func (k Keeper) GetChainChannel(ctx sdk.Context, chainID string) (*types.ChannelInfo, bool) {
+	bz := k.store(ctx).Get(types.KeyChainChannel(chainID))
+	if bz == nil {
+		return nil, false
+	}
+	var cc types.ChainChannel
+	k.m.MustUnmarshal(bz, &cc)
+	return &cc.Channel, true
+}
+
+// GetChainChannels implements ChainChannelRegistry.GetChainChannels
+func (k Keeper) GetChainChannels(ctx sdk.Context) []types.ChainChannel {
+	store := prefix.NewStore(k.store(ctx), types.KeyChainChannels())
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var ccs []types.ChainChannel
+	for ; iter.Valid(); iter.Next() {
+		var cc types.ChainChannel
+		k.m.MustUnmarshal(iter.Value(), &cc)
+		ccs = append(ccs, cc)
+	}
+	return ccs
+}
+
+func (k Keeper) getChainID(ctx sdk.Context, xcc types.XCC) (string, error) {
+	if ci, ok := xcc.(*types.ChainInfo); ok {
+		return ci.ChainId, nil
+	}
+	ci, err := k.ChannelPathResolver.ResolveCrossChainChannel(ctx, xcc)
+	if err != nil {
+		return "", err
+	}
+	if k.ChannelPathResolver.IsSelfCrossChainChannel(ctx, ci) {
+		return ctx.ChainID(), nil
+	}
+	return k.GetCounterpartyChainID(ctx, *ci)
+}
+
+func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
+	switch storeKey := k.storeKey.(type) {
+	case *crosstypes.PrefixStoreKey:
+		return prefix.NewStore(ctx.KVStore(storeKey.StoreKey), storeKey.Prefix)
+	default:
+		return ctx.KVStore(k.storeKey)
+	}
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	"github.com/stretchr/testify/suite"
+
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
+	crosstypes "github.com/datachainlab/cross/x/core/types"
+	"github.com/datachainlab/cross/x/core/xcc/keeper"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+	ibctesting "github.com/datachainlab/cross/x/ibc/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func (suite *KeeperTestSuite) TestChainChannelRegistry() {
+	_, _, connAB, connBA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelAB, channelBA := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connAB, connBA, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+	chAB := xcctypes.ChannelInfo{Port: channelAB.PortID, Channel: channelAB.ID}
+	chBA := xcctypes.ChannelInfo{Port: channelBA.PortID, Channel: channelBA.ID}
+
+	_, _, connAC, connCA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainC, exported.Tendermint, ibctesting.CrossVersion)
+	channelAC, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainC, connAC, connCA, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+	chAC := xcctypes.ChannelInfo{Port: channelAC.PortID, Channel: channelAC.ID}
+
+	kA := suite.chainA.App.XCCResolver.(keeper.Keeper)
+	kB := suite.chainB.App.XCCResolver.(keeper.Keeper)
+	ctxA := suite.chainA.GetContext()
+
+	// the chain ID is derived from the light client of the channel's connection
+	chainID, err := kA.GetCounterpartyChainID(ctxA, chAB)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainB.ChainID, chainID)
+
+	// the channels aren't registered automatically when they are opened
+	_, found := kA.GetChainChannel(ctxA, suite.chainB.ChainID)
+	suite.Require().False(found)
+	suite.Require().Len(kA.GetChainChannels(ctxA), 0)
+
+	// the governance registers the channels
+	handlerA := suite.chainA.App.GovKeeper.Router().GetRoute(xcctypes.RouterKey)
+	suite.Require().NoError(handlerA(ctxA, xcctypes.NewRegisterChainChannelProposal("register", "register chainB", suite.chainB.ChainID, chAB)))
+	suite.Require().NoError(handlerA(ctxA, xcctypes.NewRegisterChainChannelProposal("register", "register chainC", suite.chainC.ChainID, chAC)))
+	ctxB := suite.chainB.GetContext()
+	handlerB := suite.chainB.App.GovKeeper.Router().GetRoute(xcctypes.RouterKey)
+	suite.Require().NoError(handlerB(ctxB, xcctypes.NewRegisterChainChannelProposal("register", "register chainA", suite.chainA.ChainID, chBA)))
+
+	// a channel whose counterparty is another chain cannot be registered
+	suite.Require().Error(handlerA(ctxA, xcctypes.NewRegisterChainChannelProposal("register", "squat chainB", suite.chainB.ChainID, chAC)))
+	// an unknown channel cannot be registered
+	suite.Require().Error(handlerA(ctxA, xcctypes.NewRegisterChainChannelProposal("register", "unknown", suite.chainB.ChainID, xcctypes.ChannelInfo{Port: crosstypes.PortID, Channel: "channel-999"})))
+
+	ch, found := kA.GetChainChannel(ctxA, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().Equal(chAB, *ch)
+	ch, found = kA.GetChainChannel(ctxA, suite.chainC.ChainID)
+	suite.Require().True(found)
+	suite.Require().Equal(chAC, *ch)
+	ch, found = kB.GetChainChannel(ctxB, suite.chainA.ChainID)
+	suite.Require().True(found)
+	suite.Require().Equal(chBA, *ch)
+	suite.Require().Len(kA.GetChainChannels(ctxA), 2)
+
+	// resolve
+	ci, err := kA.ResolveCrossChainChannel(ctxA, xcctypes.NewChainInfo(suite.chainC.ChainID))
+	suite.Require().NoError(err)
+	suite.Require().Equal(chAC, *ci)
+	ci, err = kA.ResolveCrossChainChannel(ctxA, xcctypes.NewChainInfo(suite.chainA.ChainID))
+	suite.Require().NoError(err)
+	suite.Require().True(kA.IsSelfCrossChainChannel(ctxA, ci))
+	suite.Require().True(kA.IsSelfCrossChainChannel(ctxA, xcctypes.NewChainInfo(suite.chainA.ChainID)))
+	_, err = kA.ResolveCrossChainChannel(ctxA, xcctypes.NewChainInfo("unknown"))
+	suite.Require().Error(err)
+
+	// convert
+	xcc, err := kA.ConvertCrossChainChannel(ctxA, xcctypes.NewChainInfo(suite.chainC.ChainID), &chAB)
+	suite.Require().NoError(err)
+	suite.Require().True(xcc.Equal(xcctypes.NewChainInfo(suite.chainC.ChainID)))
+	xcc, err = kA.ConvertCrossChainChannel(ctxA, kA.GetSelfCrossChainChannel(ctxA), xcctypes.NewChainInfo(suite.chainB.ChainID))
+	suite.Require().NoError(err)
+	suite.Require().True(xcc.Equal(xcctypes.NewChainInfo(suite.chainA.ChainID)))
+	xcc, err = kA.ConvertCrossChainChannel(ctxA, &chAB, xcctypes.NewChainInfo(suite.chainB.ChainID))
+	suite.Require().NoError(err)
+	suite.Require().True(kA.IsSelfCrossChainChannel(ctxA, xcc))
+
+	// another channel isn't registered when it is opened
+	channelAB2, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connAB, connBA, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+	chAB2 := xcctypes.ChannelInfo{Port: channelAB2.PortID, Channel: channelAB2.ID}
+	ctxA = suite.chainA.GetContext()
+	ch, found = kA.GetChainChannel(ctxA, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().Equal(chAB, *ch)
+
+	// the governance replaces the registered channel explicitly
+	suite.Require().NoError(handlerA(ctxA, xcctypes.NewRegisterChainChannelProposal("register", "replace chainB", suite.chainB.ChainID, chAB2)))
+	ch, found = kA.GetChainChannel(ctxA, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().Equal(chAB2, *ch)
+
+	// a closed channel cannot be registered
+	suite.Require().NoError(suite.coordinator.SetChannelClosed(suite.chainA, suite.chainB, channelAB))
+	ctxA = suite.chainA.GetContext()
+	suite.Require().Error(kA.RegisterChannel(ctxA, suite.chainB.ChainID, chAB))
+
+	// the channel handshake doesn't fail even if the chain ID cannot be derived
+	suite.Require().NotPanics(func() {
+		suite.chainA.App.CrossKeeper.OnChainChannelOpened(ctxA, crosstypes.PortID, "channel-999")
+	})
+}
+
+func (suite *KeeperTestSuite) TestChainInfoSigners() {
+	_, _, connAB, connBA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint, ibctesting.CrossVersion)
+	channelAB, _ := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connAB, connBA, crosstypes.PortID, crosstypes.PortID, channeltypes.UNORDERED)
+	chAB := xcctypes.ChannelInfo{Port: channelAB.PortID, Channel: channelAB.ID}
+
+	akA := suite.chainA.App.CrossKeeper.AuthKeeper()
+	ctxA := suite.chainA.GetContext()
+	suite.Require().NoError(suite.chainA.App.XCCResolver.(keeper.Keeper).RegisterChannel(ctxA, suite.chainB.ChainID, chAB))
+
+	accA := authtypes.NewAccount(suite.chainA.SenderAccount.GetAddress().Bytes(), authtypes.NewAuthTypeChannel(xcctypes.NewChainInfo(suite.chainA.ChainID)))
+	accB := authtypes.NewAccount(suite.chainB.SenderAccount.GetAddress().Bytes(), authtypes.NewAuthTypeChannel(xcctypes.NewChainInfo(suite.chainB.ChainID)))
+
+	txID := []byte("tx0")
+	suite.Require().NoError(akA.InitAuthState(ctxA, txID, []authtypes.Account{accA, accB}))
+
+	// the signer on chain B is authenticated by the registered channel
+	completed, err := akA.Sign(ctxA, txID, []authtypes.Account{authtypes.NewAccount(accB.Id, authtypes.NewAuthTypeChannel(&chAB))})
+	suite.Require().NoError(err)
+	suite.Require().False(completed)
+
+	// the signer on the self chain is authenticated as a local account
+	completed, err = akA.Sign(ctxA, txID, []authtypes.Account{authtypes.NewLocalAccount(accA.Id)})
+	suite.Require().NoError(err)
+	suite.Require().True(completed)
+
+	// the chain must be registered
+	suite.Require().Error(akA.InitAuthState(ctxA, []byte("tx1"), []authtypes.Account{
+		authtypes.NewAccount(accB.Id, authtypes.NewAuthTypeChannel(xcctypes.NewChainInfo("unknown"))),
+	}))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ XCC = (*ChainInfo)(nil)
+
+// NewChainInfo creates a new instance of ChainInfo
+func NewChainInfo(chainID string) *ChainInfo {
+	return &ChainInfo{ChainId: chainID}
+}
+
+// Type implements CrossChainChannel.Type
+func (ChainInfo) Type() string {
+	return "chaininfo"
+}
+
+func (ci *ChainInfo) Equal(other XCC) bool {
+	oi, ok := other.(*ChainInfo)
+	if !ok {
+		return false
+	}
+	return ci.ChainId == oi.ChainId
+}
+
+// NewChainChannel creates a new instance of ChainChannel
+func NewChainChannel(chainID string, channel ChannelInfo) ChainChannel {
+	return ChainChannel{ChainId: chainID, Channel: channel}
+}
+
+// ValidateBasic validates the entry
+func (cc ChainChannel) ValidateBasic() error {
+	if len(cc.ChainId) == 0 {
+		return fmt.Errorf("chain ID must not be empty")
+	} else if len(cc.Channel.Port) == 0 || len(cc.Channel.Channel) == 0 {
+		return fmt.Errorf("channel must not be empty")
+	}
+	return nil
+}
+
+// ChainChannelRegistry defines the interface of registry that maps chain IDs to the preferred cross channels
+// NOTE: anyone can create a light client that claims any chain ID, so the registry must be updated only by a trusted party such as the governance.
+type ChainChannelRegistry interface {
+	// GetCounterpartyChainID returns the chain ID of the counterparty chain of a given channel
+	GetCounterpartyChainID(ctx sdk.Context, channel ChannelInfo) (string, error)
+	// RegisterChannel registers a given open channel for the chain. If a channel is already registered for the chain, it is replaced.
+	RegisterChannel(ctx sdk.Context, chainID string, channel ChannelInfo) error
+	// SetChainChannel stores a given entry. If the entry for the chain already exists, it is overwritten.
+	SetChainChannel(ctx sdk.Context, cc ChainChannel) error
+	GetChainChannel(ctx sdk.Context, chainID string) (*ChannelInfo, bool)
+	GetChainChannels(ctx sdk.Context) []ChainChannel
+}
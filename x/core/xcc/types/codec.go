@@ -3,6 +3,7 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/datachainlab/cross/x/utils"
 )
 
@@ -12,6 +13,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*XCC)(nil),
 		&ChannelInfo{},
+		&ChannelPath{},
+		&ChainInfo{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&RegisterChainChannelProposal{},
 	)
 }
 
@@ -0,0 +1,11 @@
+package types
+
+// xcc module event types
+const (
+	EventTypeRegisterChainChannel = "register_chain_channel"
+	EventTypeChainChannelOpened   = "chain_channel_opened"
+
+	AttributeKeyChainID = "chain_id"
+	AttributeKeyPort    = "port"
+	AttributeKeyChannel = "channel"
+)
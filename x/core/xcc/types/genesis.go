@@ -0,0 +1,28 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new xcc GenesisState instance.
+func NewGenesisState(chainChannels []ChainChannel) *GenesisState {
+	return &GenesisState{ChainChannels: chainChannels}
+}
+
+// DefaultGenesis returns a GenesisState instance
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	chainIDs := make(map[string]bool)
+	for _, cc := range gs.ChainChannels {
+		if err := cc.ValidateBasic(); err != nil {
+			return err
+		} else if chainIDs[cc.ChainId] {
+			return fmt.Errorf("duplicate chain channel: chainID=%v", cc.ChainId)
+		}
+		chainIDs[cc.ChainId] = true
+	}
+	return nil
+}
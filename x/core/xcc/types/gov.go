@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeRegisterChainChannel defines the type for a RegisterChainChannelProposal
+	ProposalTypeRegisterChainChannel = "CrossRegisterChainChannel"
+)
+
+var _ govtypes.Content = (*RegisterChainChannelProposal)(nil)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRegisterChainChannel)
+	govtypes.RegisterProposalTypeCodec(&RegisterChainChannelProposal{}, "cross/RegisterChainChannelProposal")
+}
+
+// NewRegisterChainChannelProposal creates a new RegisterChainChannelProposal instance
+func NewRegisterChainChannelProposal(title, description, chainID string, channel ChannelInfo) *RegisterChainChannelProposal {
+	return &RegisterChainChannelProposal{
+		Title:       title,
+		Description: description,
+		ChainId:     chainID,
+		Channel:     channel,
+	}
+}
+
+// GetTitle implements govtypes.Content
+func (p *RegisterChainChannelProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements govtypes.Content
+func (p *RegisterChainChannelProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements govtypes.Content
+func (p *RegisterChainChannelProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements govtypes.Content
+func (p *RegisterChainChannelProposal) ProposalType() string { return ProposalTypeRegisterChainChannel }
+
+// ValidateBasic implements govtypes.Content
+func (p *RegisterChainChannelProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return NewChainChannel(p.ChainId, p.Channel).ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p RegisterChainChannelProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Register Chain Channel Proposal:
+  Title:       %s
+  Description: %s
+  ChainID:     %s
+  Port:        %s
+  Channel:     %s
+`, p.Title, p.Description, p.ChainId, p.Channel.Port, p.Channel.Channel))
+	return b.String()
+}
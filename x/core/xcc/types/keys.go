@@ -1,6 +1,29 @@
 package types
 
+import "fmt"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "xcc"
+
+	// RouterKey defines the routing key of the xcc proposals
+	// NOTE: the gov router only accepts alphanumeric route keys
+	RouterKey = "crossxcc"
+)
+
+const (
+	KeyChainChannelPrefix uint8 = iota
 )
+
+// KeyPrefixBytes return the key prefix bytes from a URL string format
+func KeyPrefixBytes(prefix uint8) []byte {
+	return []byte(fmt.Sprintf("%d/", prefix))
+}
+
+func KeyChainChannels() []byte {
+	return KeyPrefixBytes(KeyChainChannelPrefix)
+}
+
+func KeyChainChannel(chainID string) []byte {
+	return append(KeyChainChannels(), chainID...)
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ XCC = (*ChannelPath)(nil)
+
+// NewChannelPath creates a new instance of ChannelPath
+func NewChannelPath(hops ...ChannelInfo) *ChannelPath {
+	return &ChannelPath{Hops: hops}
+}
+
+// Type implements CrossChainChannel.Type
+func (ChannelPath) Type() string {
+	return "channelpath"
+}
+
+func (p *ChannelPath) Equal(other XCC) bool {
+	op, ok := other.(*ChannelPath)
+	if !ok || len(p.Hops) != len(op.Hops) {
+		return false
+	}
+	for i := range p.Hops {
+		if !p.Hops[i].Equal(&op.Hops[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ChannelPathResolver is a resolver that supports ChannelPath in addition to ChannelInfo.
+// It can convert a xcc of a remote chain into the context of another remote chain through the self chain.
+type ChannelPathResolver struct {
+	ChannelInfoResolver
+}
+
+// NewChannelPathResolver creates a new instance of ChannelPathResolver
+func NewChannelPathResolver(channelKeeper ChannelKeeper) ChannelPathResolver {
+	return ChannelPathResolver{ChannelInfoResolver: NewChannelInfoResolver(channelKeeper)}
+}
+
+var _ XCCResolver = (*ChannelPathResolver)(nil)
+
+// ResolveCrossChainChannel implements CrossChainChannelResolver.ResolveCrossChainChannel
+// A path that has multiple hops cannot be resolved because the chain isn't directly connected.
+func (r ChannelPathResolver) ResolveCrossChainChannel(ctx sdk.Context, xcc XCC) (*ChannelInfo, error) {
+	hops, err := getHops(xcc)
+	if err != nil {
+		return nil, err
+	}
+	switch len(hops) {
+	case 0:
+		return &ChannelInfo{}, nil
+	case 1:
+		return &hops[0], nil
+	default:
+		return nil, fmt.Errorf("cannot resolve the multi-hop path '%v'", xcc)
+	}
+}
+
+// ConvertCrossChainChannel returns a xcc of callee in caller's context
+// The caller must be the self chain or a chain that is directly connected to the self chain.
+func (r ChannelPathResolver) ConvertCrossChainChannel(ctx sdk.Context, calleeXCC XCC, callerXCC XCC) (XCC, error) {
+	calleeHops, err := getHops(calleeXCC)
+	if err != nil {
+		return nil, err
+	}
+	callerHops, err := getHops(callerXCC)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(callerHops) == 0:
+		return calleeXCC, nil
+	case len(callerHops) > 1:
+		return nil, fmt.Errorf("caller '%v' must be the self chain or a directly connected chain", callerXCC)
+	}
+
+	callerChannel, found := r.channelKeeper.GetChannel(ctx, callerHops[0].Port, callerHops[0].Channel)
+	if !found {
+		return nil, fmt.Errorf("channel '%v' not found", callerHops[0].String())
+	}
+	var hops []ChannelInfo
+	if len(calleeHops) > 0 && calleeHops[0].Equal(&callerHops[0]) {
+		// the callee is reached through the caller
+		hops = calleeHops[1:]
+	} else {
+		back := ChannelInfo{Port: callerChannel.GetCounterparty().GetPortID(), Channel: callerChannel.GetCounterparty().GetChannelID()}
+		hops = append([]ChannelInfo{back}, calleeHops...)
+	}
+	return makeXCCFromHops(hops), nil
+}
+
+// IsSelfCrossChainChannel implements CrossChainChannelResolver.IsSelfCrossChainChannel
+func (r ChannelPathResolver) IsSelfCrossChainChannel(ctx sdk.Context, xcc XCC) bool {
+	hops, err := getHops(xcc)
+	return err == nil && len(hops) == 0
+}
+
+func getHops(xcc XCC) ([]ChannelInfo, error) {
+	switch xcc := xcc.(type) {
+	case *ChannelInfo:
+		if xcc.Equal(&ChannelInfo{}) {
+			return nil, nil
+		}
+		return []ChannelInfo{*xcc}, nil
+	case *ChannelPath:
+		return xcc.Hops, nil
+	default:
+		return nil, fmt.Errorf("unexpected xcc type: %T", xcc)
+	}
+}
+
+func makeXCCFromHops(hops []ChannelInfo) XCC {
+	switch len(hops) {
+	case 0:
+		return &ChannelInfo{}
+	case 1:
+		return &ChannelInfo{Port: hops[0].Port, Channel: hops[0].Channel}
+	default:
+		return NewChannelPath(hops...)
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelPathResolver(t *testing.T) {
+	require := require.New(t)
+
+	// the hub chain A has the channels to B and C, and B and C aren't connected to each other
+	var (
+		chAB = ChannelInfo{Port: "cross", Channel: "channel-0"}
+		chBA = ChannelInfo{Port: "cross", Channel: "channel-10"}
+		chAC = ChannelInfo{Port: "cross", Channel: "channel-1"}
+		chCA = ChannelInfo{Port: "cross", Channel: "channel-20"}
+	)
+	r := NewChannelPathResolver(mockChannelKeeper{
+		chAB: chBA,
+		chAC: chCA,
+	})
+	ctx := sdk.Context{}
+	self := r.GetSelfCrossChainChannel(ctx)
+
+	// B in C's context is a path through A
+	xcc, err := r.ConvertCrossChainChannel(ctx, &chAB, &chAC)
+	require.NoError(err)
+	require.True(xcc.Equal(NewChannelPath(chCA, chAB)))
+	_, err = r.ResolveCrossChainChannel(ctx, xcc)
+	require.Error(err)
+
+	// A in B's context is the counterparty channel
+	xcc, err = r.ConvertCrossChainChannel(ctx, self, &chAB)
+	require.NoError(err)
+	require.True(xcc.Equal(&chBA))
+
+	// B in B's context is the self chain
+	xcc, err = r.ConvertCrossChainChannel(ctx, &chAB, &chAB)
+	require.NoError(err)
+	require.True(r.IsSelfCrossChainChannel(ctx, xcc))
+
+	// a path that starts with the caller's channel is shortened
+	xcc, err = r.ConvertCrossChainChannel(ctx, NewChannelPath(chAC, ChannelInfo{Port: "cross", Channel: "channel-5"}), &chAC)
+	require.NoError(err)
+	require.True(xcc.Equal(&ChannelInfo{Port: "cross", Channel: "channel-5"}))
+
+	// the caller must be directly connected
+	_, err = r.ConvertCrossChainChannel(ctx, &chAB, NewChannelPath(chAC, chCA))
+	require.Error(err)
+
+	ci, err := r.ResolveCrossChainChannel(ctx, NewChannelPath(chAB))
+	require.NoError(err)
+	require.Equal(chAB, *ci)
+}
+
+type mockChannelKeeper map[ChannelInfo]ChannelInfo
+
+var _ ChannelKeeper = (mockChannelKeeper)(nil)
+
+func (k mockChannelKeeper) GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool) {
+	counterparty, found := k[ChannelInfo{Port: srcPort, Channel: srcChan}]
+	if !found {
+		return channeltypes.Channel{}, false
+	}
+	return channeltypes.Channel{Counterparty: channeltypes.NewCounterparty(counterparty.Port, counterparty.Channel)}, true
+}
+
+func (k mockChannelKeeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	panic("not implemented")
+}
+
+func (k mockChannelKeeper) SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	panic("not implemented")
+}
+
+func (k mockChannelKeeper) ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error {
+	panic("not implemented")
+}
@@ -0,0 +1,914 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cross/core/xcc/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryChainChannelRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryChainChannelRequest) Reset()         { *m = QueryChainChannelRequest{} }
+func (m *QueryChainChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainChannelRequest) ProtoMessage()    {}
+func (*QueryChainChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a707a5de46a544b, []int{0}
+}
+func (m *QueryChainChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainChannelRequest.Merge(m, src)
+}
+func (m *QueryChainChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainChannelRequest proto.InternalMessageInfo
+
+type QueryChainChannelResponse struct {
+	ChainChannel ChainChannel `protobuf:"bytes,1,opt,name=chain_channel,json=chainChannel,proto3" json:"chain_channel"`
+}
+
+func (m *QueryChainChannelResponse) Reset()         { *m = QueryChainChannelResponse{} }
+func (m *QueryChainChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainChannelResponse) ProtoMessage()    {}
+func (*QueryChainChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a707a5de46a544b, []int{1}
+}
+func (m *QueryChainChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainChannelResponse.Merge(m, src)
+}
+func (m *QueryChainChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainChannelResponse proto.InternalMessageInfo
+
+type QueryChainChannelsRequest struct {
+}
+
+func (m *QueryChainChannelsRequest) Reset()         { *m = QueryChainChannelsRequest{} }
+func (m *QueryChainChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainChannelsRequest) ProtoMessage()    {}
+func (*QueryChainChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a707a5de46a544b, []int{2}
+}
+func (m *QueryChainChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainChannelsRequest.Merge(m, src)
+}
+func (m *QueryChainChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainChannelsRequest proto.InternalMessageInfo
+
+type QueryChainChannelsResponse struct {
+	ChainChannels []ChainChannel `protobuf:"bytes,1,rep,name=chain_channels,json=chainChannels,proto3" json:"chain_channels"`
+}
+
+func (m *QueryChainChannelsResponse) Reset()         { *m = QueryChainChannelsResponse{} }
+func (m *QueryChainChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainChannelsResponse) ProtoMessage()    {}
+func (*QueryChainChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a707a5de46a544b, []int{3}
+}
+func (m *QueryChainChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainChannelsResponse.Merge(m, src)
+}
+func (m *QueryChainChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainChannelsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryChainChannelRequest)(nil), "cross.core.xcc.QueryChainChannelRequest")
+	proto.RegisterType((*QueryChainChannelResponse)(nil), "cross.core.xcc.QueryChainChannelResponse")
+	proto.RegisterType((*QueryChainChannelsRequest)(nil), "cross.core.xcc.QueryChainChannelsRequest")
+	proto.RegisterType((*QueryChainChannelsResponse)(nil), "cross.core.xcc.QueryChainChannelsResponse")
+}
+
+func init() { proto.RegisterFile("cross/core/xcc/query.proto", fileDescriptor_7a707a5de46a544b) }
+
+var fileDescriptor_7a707a5de46a544b = []byte{
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4f, 0xfa, 0x30,
+	0x18, 0xc7, 0x57, 0x7e, 0x7f, 0xad, 0xc0, 0xa1, 0xf1, 0x00, 0x13, 0x0b, 0x59, 0xa2, 0x41, 0x13,
+	0xd7, 0x04, 0xe3, 0x1b, 0x80, 0x83, 0x21, 0xf1, 0x22, 0x47, 0x2f, 0xa6, 0x74, 0xcd, 0x58, 0x82,
+	0xeb, 0x58, 0x4b, 0x02, 0x57, 0x8f, 0x1e, 0x8c, 0x89, 0xaf, 0xc1, 0xf7, 0xc2, 0x91, 0xc4, 0x8b,
+	0x27, 0xa3, 0xe0, 0x0b, 0x31, 0xeb, 0x6a, 0x32, 0x16, 0x54, 0x6e, 0xdd, 0xf3, 0x7c, 0xbf, 0xcf,
+	0xf7, 0xf3, 0x74, 0x85, 0x36, 0x8b, 0x85, 0x94, 0x84, 0x89, 0x98, 0x93, 0x09, 0x63, 0x64, 0x34,
+	0xe6, 0xf1, 0xd4, 0x8d, 0x62, 0xa1, 0x04, 0x2a, 0xeb, 0x9e, 0x9b, 0xf4, 0xdc, 0x09, 0x63, 0x76,
+	0xcd, 0x17, 0xc2, 0x1f, 0x72, 0x42, 0xa3, 0x80, 0xd0, 0x30, 0x14, 0x8a, 0xaa, 0x40, 0x84, 0x32,
+	0x55, 0xdb, 0x3b, 0xbe, 0xf0, 0x85, 0x3e, 0x92, 0xe4, 0x64, 0xaa, 0xf9, 0xf9, 0x6a, 0x1a, 0x71,
+	0xe3, 0x70, 0x4e, 0x61, 0xe5, 0x22, 0x89, 0xeb, 0x0c, 0x68, 0x10, 0x76, 0x06, 0x34, 0x0c, 0xf9,
+	0xb0, 0xc7, 0x47, 0x63, 0x2e, 0x15, 0xaa, 0xc2, 0xff, 0x2c, 0x29, 0x5f, 0x05, 0x5e, 0x05, 0x34,
+	0x40, 0x73, 0xab, 0xf7, 0x4f, 0x7f, 0x77, 0x3d, 0xc7, 0x83, 0xd5, 0x35, 0x36, 0x19, 0x89, 0x50,
+	0x72, 0x74, 0x06, 0x4b, 0xa9, 0x8f, 0xa5, 0x0d, 0x6d, 0xde, 0x6e, 0xd5, 0xdc, 0xd5, 0x5d, 0xdc,
+	0xac, 0xb9, 0xfd, 0x7b, 0xf6, 0x52, 0xb7, 0x7a, 0x45, 0x96, 0xa9, 0x39, 0xbb, 0x6b, 0x52, 0xa4,
+	0xa1, 0x73, 0x7c, 0x68, 0xaf, 0x6b, 0x1a, 0x86, 0x2e, 0x2c, 0xaf, 0x30, 0xc8, 0x0a, 0x68, 0xfc,
+	0xda, 0x10, 0xa2, 0x94, 0x85, 0x90, 0xad, 0xc7, 0x02, 0xfc, 0xa3, 0x93, 0xd0, 0x2d, 0x80, 0xc5,
+	0xac, 0x1e, 0x35, 0xf3, 0xd3, 0xbe, 0xba, 0x4b, 0xfb, 0x70, 0x03, 0x65, 0x8a, 0xee, 0xec, 0xdf,
+	0x3c, 0xbd, 0x3f, 0x14, 0xea, 0x68, 0x8f, 0xe4, 0xfe, 0x9b, 0xc6, 0x3a, 0x36, 0x0b, 0xa1, 0x3b,
+	0x00, 0x4b, 0x2b, 0xbb, 0xa3, 0x9f, 0x33, 0x3e, 0x2f, 0xcf, 0x3e, 0xda, 0x44, 0x6a, 0x78, 0x0e,
+	0x34, 0x4f, 0x03, 0xe1, 0x6f, 0x79, 0x64, 0xfb, 0x7c, 0xf6, 0x86, 0xad, 0xd9, 0x02, 0x83, 0xf9,
+	0x02, 0x83, 0xd7, 0x05, 0x06, 0xf7, 0x4b, 0x6c, 0xcd, 0x97, 0xd8, 0x7a, 0x5e, 0x62, 0xeb, 0xd2,
+	0xf5, 0x03, 0x35, 0x18, 0xf7, 0x5d, 0x26, 0xae, 0x89, 0x47, 0x15, 0xd5, 0xe6, 0x21, 0xed, 0x9b,
+	0xa1, 0x93, 0xdc, 0xf3, 0xec, 0xff, 0xd5, 0xef, 0xf3, 0xe4, 0x63, 0x00, 0x53, 0x59, 0xb9, 0xdb,
+	0x1d, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	ChainChannel(ctx context.Context, in *QueryChainChannelRequest, opts ...grpc.CallOption) (*QueryChainChannelResponse, error)
+	ChainChannels(ctx context.Context, in *QueryChainChannelsRequest, opts ...grpc.CallOption) (*QueryChainChannelsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ChainChannel(ctx context.Context, in *QueryChainChannelRequest, opts ...grpc.CallOption) (*QueryChainChannelResponse, error) {
+	out := new(QueryChainChannelResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.xcc.Query/ChainChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainChannels(ctx context.Context, in *QueryChainChannelsRequest, opts ...grpc.CallOption) (*QueryChainChannelsResponse, error) {
+	out := new(QueryChainChannelsResponse)
+	err := c.cc.Invoke(ctx, "/cross.core.xcc.Query/ChainChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	ChainChannel(context.Context, *QueryChainChannelRequest) (*QueryChainChannelResponse, error)
+	ChainChannels(context.Context, *QueryChainChannelsRequest) (*QueryChainChannelsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ChainChannel(ctx context.Context, req *QueryChainChannelRequest) (*QueryChainChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainChannel not implemented")
+}
+func (*UnimplementedQueryServer) ChainChannels(ctx context.Context, req *QueryChainChannelsRequest) (*QueryChainChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainChannels not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ChainChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.xcc.Query/ChainChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainChannel(ctx, req.(*QueryChainChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cross.core.xcc.Query/ChainChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainChannels(ctx, req.(*QueryChainChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cross.core.xcc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChainChannel",
+			Handler:    _Query_ChainChannel_Handler,
+		},
+		{
+			MethodName: "ChainChannels",
+			Handler:    _Query_ChainChannels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cross/core/xcc/query.proto",
+}
+
+func (m *QueryChainChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChainChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainChannels) > 0 {
+		for iNdEx := len(m.ChainChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryChainChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainChannel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChainChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChainChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainChannels) > 0 {
+		for _, e := range m.ChainChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryChainChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainChannels = append(m.ChainChannels, ChainChannel{})
+			if err := m.ChainChannels[len(m.ChainChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -44,7 +44,9 @@ type CommitProtocol = uint32
 
 // XCCResolverCapabilities defines the capabilities for the ChainResolver
 type XCCResolverCapabilities interface {
-	// CrossChainCalls returns true if support for cross-chain calls is enabled.
+	// CrossChainCalls returns true if the resolver supports cross-chain calls.
+	// Whether cross-chain calls are enabled on the chain is controlled by the CrossChainCallsEnabled param,
+	// which the coordinator and the participants check in addition to this capability.
 	CrossChainCalls(ctx sdk.Context) bool
 }
 
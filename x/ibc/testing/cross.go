@@ -0,0 +1,59 @@
+package ibctesting
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/datachainlab/cross/x/core/auth/types"
+	initiatortypes "github.com/datachainlab/cross/x/core/initiator/types"
+	txtypes "github.com/datachainlab/cross/x/core/tx/types"
+	xcctypes "github.com/datachainlab/cross/x/core/xcc/types"
+)
+
+// CrossChannel is a channel on the cross port between a coordinator chain and a participant chain
+type CrossChannel struct {
+	// Participant is the participant chain
+	Participant *TestChain
+	// Channel is the end of the channel on the coordinator chain
+	Channel TestChannel
+	// Counterparty is the end of the channel on the participant chain
+	Counterparty TestChannel
+	// XCC is the packed xcc that indicates the participant chain on the coordinator chain
+	XCC *codectypes.Any
+}
+
+// SetupCrossChannel creates the clients, connections and an unordered channel on the cross port
+// between the coordinator chain and the participant chain.
+func (coord *Coordinator) SetupCrossChannel(coordinator, participant *TestChain) CrossChannel {
+	_, _, connA, connB := coord.SetupClientConnections(coordinator, participant, exported.Tendermint, CrossVersion)
+	channelA, channelB := coord.CreateChannel(coordinator, participant, connA, connB, CrossPort, CrossPort, channeltypes.UNORDERED)
+	xcc, err := xcctypes.PackCrossChainChannel(&xcctypes.ChannelInfo{Port: channelA.PortID, Channel: channelA.ID})
+	require.NoError(coord.t, err)
+	return CrossChannel{
+		Participant:  participant,
+		Channel:      channelA,
+		Counterparty: channelB,
+		XCC:          xcc,
+	}
+}
+
+// ChannelInfo returns the end of the channel on the coordinator chain
+func (ch CrossChannel) ChannelInfo() xcctypes.ChannelInfo {
+	return xcctypes.ChannelInfo{Port: ch.Channel.PortID, Channel: ch.Channel.ID}
+}
+
+// Signer returns the account of the participant's sender that is authenticated through the channel
+func (ch CrossChannel) Signer() authtypes.Account {
+	return authtypes.NewAccount(authtypes.AccountID(ch.Participant.SenderAccount.GetAddress()), authtypes.NewAuthTypeChannelWithAny(ch.XCC))
+}
+
+// ContractTransaction returns a contract transaction that runs on the participant chain and is signed by the participant's sender
+func (ch CrossChannel) ContractTransaction(callInfo txtypes.ContractCallInfo) initiatortypes.ContractTransaction {
+	return initiatortypes.ContractTransaction{
+		CrossChainChannel: ch.XCC,
+		Signers:           []authtypes.Account{ch.Signer()},
+		CallInfo:          callInfo,
+	}
+}